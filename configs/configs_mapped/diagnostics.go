@@ -0,0 +1,76 @@
+package configs_mapped
+
+import (
+	"sort"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/iniload"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
+	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
+	"github.com/darklab8/go-typelog/typelog"
+	"github.com/darklab8/go-utils/utils/utils_types"
+)
+
+/*
+ParseReport aggregates everything ini reader was not able to parse.
+Darkstat keeps going with partial data, and this report says what was lost.
+*/
+type ParseReport struct {
+	Diagnostics []inireader.Diagnostic `json:"diagnostics"`
+}
+
+func (r *ParseReport) Add(diagnostics ...inireader.Diagnostic) {
+	r.Diagnostics = append(r.Diagnostics, diagnostics...)
+}
+
+func (r *ParseReport) AddFromLoaders(loaders ...*iniload.IniLoader) {
+	for _, loader := range loaders {
+		if loader == nil || loader.INIFile == nil {
+			continue
+		}
+		r.Add(loader.Diagnostics...)
+	}
+}
+
+func (r *ParseReport) ByFile() map[utils_types.FilePath][]inireader.Diagnostic {
+	result := make(map[utils_types.FilePath][]inireader.Diagnostic)
+	for _, diag := range r.Diagnostics {
+		result[diag.Filepath] = append(result[diag.Filepath], diag)
+	}
+	return result
+}
+
+func (r *ParseReport) ByKind() map[inireader.DiagnosticKind]int {
+	result := make(map[inireader.DiagnosticKind]int)
+	for _, diag := range r.Diagnostics {
+		result[diag.Kind]++
+	}
+	return result
+}
+
+// Sort orders diagnostics by file and line, since they are gathered from goroutines in random order
+func (r *ParseReport) Sort() {
+	sort.SliceStable(r.Diagnostics, func(i, j int) bool {
+		if r.Diagnostics[i].Filepath != r.Diagnostics[j].Filepath {
+			return r.Diagnostics[i].Filepath < r.Diagnostics[j].Filepath
+		}
+		return r.Diagnostics[i].Line < r.Diagnostics[j].Line
+	})
+}
+
+func (r *ParseReport) Log() {
+	if len(r.Diagnostics) == 0 {
+		return
+	}
+	for _, diag := range r.Diagnostics {
+		logus.Log.Warn("config diagnostic", typelog.String("diagnostic", diag.String()))
+	}
+	kinds := make(map[string]any)
+	for kind, count := range r.ByKind() {
+		kinds[string(kind)] = count
+	}
+	logus.Log.Warn("configs are parsed with diagnostics",
+		typelog.Int("total", len(r.Diagnostics)),
+		typelog.Int("files", len(r.ByFile())),
+		typelog.NestedMap("by_kind", kinds),
+	)
+}
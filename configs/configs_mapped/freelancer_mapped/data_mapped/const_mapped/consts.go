@@ -4,6 +4,7 @@ import (
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/iniload"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
 )
 
@@ -50,8 +51,8 @@ func Read(input_file *iniload.IniLoader) *Config {
 	return frelconfig
 }
 
-func (frelconfig *Config) Write() *file.File {
+func (frelconfig *Config) Write(opts ...inireader.WriteOption) *file.File {
 	inifile := frelconfig.Render()
-	inifile.Write(inifile.File)
+	inifile.Write(inifile.File, opts...)
 	return inifile.File
 }
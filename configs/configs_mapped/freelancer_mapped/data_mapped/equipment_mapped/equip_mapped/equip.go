@@ -6,6 +6,7 @@ import (
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/iniload"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
 	"github.com/darklab8/go-utils/utils/utils_types"
 )
@@ -631,11 +632,11 @@ func Read(files []*iniload.IniLoader) *Config {
 	return frelconfig
 }
 
-func (frelconfig *Config) Write() []*file.File {
+func (frelconfig *Config) Write(opts ...inireader.WriteOption) []*file.File {
 	var files []*file.File
 	for _, file := range frelconfig.Files {
 		inifile := file.Render()
-		inifile.Write(inifile.File)
+		inifile.Write(inifile.File, opts...)
 		files = append(files, inifile.File)
 	}
 	return files
@@ -1,9 +1,13 @@
 package market_mapped
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/iniload"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
 
 	"github.com/darklab8/go-utils/utils/utils_types"
@@ -63,9 +67,15 @@ var (
 
 func Read(files []*iniload.IniLoader) *Config {
 	frelconfig := &Config{
-		Files:        files,
-		GoodsPerBase: make(map[cfg.BaseUniNick]*BaseGood),
+		Files: files,
 	}
+	frelconfig.remap()
+	return frelconfig
+}
+
+// remap builds models from sections again, as market goods are addressed by param index, which shifts on removal
+func (frelconfig *Config) remap() {
+	frelconfig.GoodsPerBase = make(map[cfg.BaseUniNick]*BaseGood)
 	frelconfig.BaseGoods = make([]*BaseGood, 0)
 	frelconfig.BasesPerGood = make(map[string][]*MarketGoodAtBase)
 
@@ -102,15 +112,68 @@ func Read(files []*iniload.IniLoader) *Config {
 			frelconfig.GoodsPerBase[base_nickname] = base_to_add
 		}
 	}
+}
 
-	return frelconfig
+/*
+AddMarketGood appends good to market of base, or returns already present one.
+Values are filled in as not sold buy only good, to be changed with semantic setters.
+*/
+func (frelconfig *Config) AddMarketGood(base cfg.BaseUniNick, nickname string) (*MarketGood, error) {
+	base_good, ok := frelconfig.GoodsPerBase[base]
+	if !ok {
+		return nil, fmt.Errorf("base %s has no market section", base)
+	}
+	nickname = strings.ToLower(nickname)
+	if market_good, ok := base_good.MarketGoodsMap[nickname]; ok {
+		return market_good, nil
+	}
+
+	param := &inireader.Param{OriginalKey: "MarketGood"}
+	param.AddValue(inireader.UniParseStr(nickname))
+	param.AddValue(inireader.UniParseInt(0))
+	param.AddValue(inireader.UniParseInt(-1))
+	param.AddValue(inireader.UniParseInt(0))
+	param.AddValue(inireader.UniParseInt(0))
+	param.AddValue(inireader.UniParseInt(0))
+	param.AddValue(inireader.UniParseInt(1))
+	base_good.RenderModel().AddParam(KEY_MARKET_GOOD, param)
+
+	frelconfig.remap()
+	return frelconfig.GoodsPerBase[base].MarketGoodsMap[nickname], nil
+}
+
+func (frelconfig *Config) RemoveMarketGood(base cfg.BaseUniNick, nickname string) error {
+	base_good, ok := frelconfig.GoodsPerBase[base]
+	if !ok {
+		return fmt.Errorf("base %s has no market section", base)
+	}
+	nickname = strings.ToLower(nickname)
+	section := base_good.RenderModel()
+	for _, param := range section.ParamMap[KEY_MARKET_GOOD] {
+		if strings.ToLower(param.First.AsString()) == nickname {
+			section.RemoveParam(param)
+			frelconfig.remap()
+			return nil
+		}
+	}
+	return fmt.Errorf("good %s is not found in market of base %s", nickname, base)
+}
+
+// SetBaseSells switches good between sold and only bought by base
+func (m *MarketGood) SetBaseSells(sells bool) {
+	stock := 0
+	if sells {
+		stock = 1
+	}
+	m.BaseSellsIPositiveAndDiscoSellPrice.Set(stock)
+	m.baseSellsIfAboveZero.Set(stock)
 }
 
-func (frelconfig *Config) Write() []*file.File {
+func (frelconfig *Config) Write(opts ...inireader.WriteOption) []*file.File {
 	var files []*file.File
 	for _, file := range frelconfig.Files {
 		inifile := file.Render()
-		inifile.Write(inifile.File)
+		inifile.Write(inifile.File, opts...)
 		files = append(files, inifile.File)
 	}
 	return files
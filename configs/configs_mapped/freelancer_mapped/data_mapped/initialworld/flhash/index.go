@@ -0,0 +1,139 @@
+package flhash
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type HashKind string
+
+const (
+	HashKindNickname HashKind = "nickname"
+	HashKindFaction  HashKind = "faction"
+)
+
+type HashEntry struct {
+	Nickname string   `json:"nickname"`
+	Kind     HashKind `json:"kind"`
+	Section  string   `json:"section"` // ini section nickname was found in, like [ship] or [system]
+}
+
+type HashCollision struct {
+	Hash    HashCode    `json:"hash"`
+	Entries []HashEntry `json:"entries"`
+}
+
+/*
+HashIndex maps hashes back to nicknames they were made from.
+Nickname hashes always have highest bit set and faction hashes fit into 16 bits,
+so both kinds live in the same index without clashing with each other.
+*/
+type HashIndex struct {
+	by_hash map[HashCode][]HashEntry
+	mu      sync.Mutex
+}
+
+func NewHashIndex() *HashIndex {
+	return &HashIndex{by_hash: make(map[HashCode][]HashEntry)}
+}
+
+// normalizeHash accepts hashes written as signed int32, the way they appear in save files and PoB json
+func normalizeHash(hash HashCode) HashCode {
+	return HashCode(uint32(hash))
+}
+
+func (h *HashIndex) add(hash HashCode, entry HashEntry) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	hash = normalizeHash(hash)
+	for index, existing := range h.by_hash[hash] {
+		if existing.Kind == entry.Kind && strings.EqualFold(existing.Nickname, entry.Nickname) {
+			if existing.Section == "" {
+				h.by_hash[hash][index].Section = entry.Section
+			}
+			return
+		}
+	}
+	h.by_hash[hash] = append(h.by_hash[hash], entry)
+}
+
+func (h *HashIndex) AddNickname(nickname string, section string) {
+	if nickname == "" {
+		return
+	}
+	h.add(HashNickname(nickname), HashEntry{Nickname: nickname, Kind: HashKindNickname, Section: section})
+}
+
+func (h *HashIndex) AddFaction(nickname string) {
+	if nickname == "" {
+		return
+	}
+	h.add(HashFaction(nickname), HashEntry{Nickname: nickname, Kind: HashKindFaction, Section: "[group]"})
+}
+
+func (h *HashIndex) Resolve(hash HashCode) ([]HashEntry, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	entries, ok := h.by_hash[normalizeHash(hash)]
+	return entries, ok
+}
+
+func (h *HashIndex) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.by_hash)
+}
+
+// Collisions lists hashes shared by different nicknames, sorted by hash
+func (h *HashIndex) Collisions() []HashCollision {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var result []HashCollision
+	for hash, entries := range h.by_hash {
+		if len(entries) > 1 {
+			result = append(result, HashCollision{Hash: hash, Entries: entries})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Hash < result[j].Hash })
+	return result
+}
+
+/*
+ParseHash reads hash in any form it is met in the wild:
+signed or unsigned decimal, or hex with or without 0x prefix.
+Plain decimal is preferred for strings which are valid in both forms.
+Values not fitting 32 bits are returned as error instead of being truncated.
+*/
+func ParseHash(value string) (HashCode, error) {
+	value = strings.TrimSpace(value)
+	lowered := strings.ToLower(value)
+	if strings.HasPrefix(lowered, "0x") {
+		parsed, err := strconv.ParseUint(lowered[2:], 16, 32)
+		if err != nil {
+			return 0, err
+		}
+		return HashCode(parsed), nil
+	}
+	if strings.HasPrefix(value, "-") {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return 0, err
+		}
+		return normalizeHash(HashCode(parsed)), nil
+	}
+	if parsed, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return HashCode(parsed), nil
+	} else if errors.Is(err, strconv.ErrRange) {
+		return 0, err
+	}
+	parsed, err := strconv.ParseUint(lowered, 16, 32)
+	if err != nil {
+		return 0, err
+	}
+	return HashCode(parsed), nil
+}
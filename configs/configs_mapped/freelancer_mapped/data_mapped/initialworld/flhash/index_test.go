@@ -0,0 +1,52 @@
+package flhash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashIndex(t *testing.T) {
+	index := NewHashIndex()
+	index.AddNickname("pl_ge_fighter4", "[ship]")
+	index.AddNickname("PL_GE_FIGHTER4", "[ship]")
+	index.AddFaction("fc_freelancer")
+
+	entries, ok := index.Resolve(HashCode(2339324873))
+	assert.True(t, ok)
+	assert.Equal(t, []HashEntry{{Nickname: "pl_ge_fighter4", Kind: HashKindNickname, Section: "[ship]"}}, entries)
+
+	// same hash as seen in save files written as signed int
+	entries, ok = index.Resolve(HashCode(int32(-1955642423)))
+	assert.True(t, ok)
+	assert.Len(t, entries, 1)
+
+	entries, ok = index.Resolve(HashCode(4169))
+	assert.True(t, ok)
+	assert.Equal(t, HashKindFaction, entries[0].Kind)
+
+	_, ok = index.Resolve(HashCode(1))
+	assert.False(t, ok)
+	assert.Empty(t, index.Collisions())
+
+	index.add(HashCode(4169), HashEntry{Nickname: "fc_other", Kind: HashKindFaction})
+	collisions := index.Collisions()
+	assert.Len(t, collisions, 1)
+	assert.Equal(t, HashCode(4169), collisions[0].Hash)
+	assert.Len(t, collisions[0].Entries, 2)
+}
+
+func TestParseHash(t *testing.T) {
+	for _, value := range []string{"2339324873", "-1955642423", "0x8b6f43c9", "8b6f43c9"} {
+		hash, err := ParseHash(value)
+		assert.Nil(t, err, value)
+		assert.Equal(t, HashCode(2339324873), hash, value)
+	}
+	_, err := ParseHash("not_a_hash")
+	assert.NotNil(t, err)
+
+	for _, value := range []string{"4294967296", "-2147483649", "0x100000000", "100000000a"} {
+		_, err := ParseHash(value)
+		assert.NotNil(t, err, value)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/initialworld/flhash"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/iniload"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
 	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
 )
@@ -92,8 +93,8 @@ func Read(input_file *iniload.IniLoader) *Config {
 	return config
 }
 
-func (frelconfig *Config) Write() *file.File {
+func (frelconfig *Config) Write(opts ...inireader.WriteOption) *file.File {
 	inifile := frelconfig.Render()
-	inifile.Write(inifile.File)
+	inifile.Write(inifile.File, opts...)
 	return inifile.File
 }
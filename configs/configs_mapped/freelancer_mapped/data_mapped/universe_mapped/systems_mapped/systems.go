@@ -127,6 +127,9 @@ type TradeLaneRing struct {
 	Pos      *semantic.Vect
 	NextRing *semantic.String
 	PrevRing *semantic.String
+	IdsName  *semantic.Int
+
+	System *System
 	// has next_ring, then it is tradelane
 	// or if has Trade_Lane_Ring, then trade lane too.
 }
@@ -227,10 +230,14 @@ type Config struct {
 	Systems    []*System
 
 	// it can contain more than one base meeting condition.
-	BasesByBases    map[string]*Base
-	BasesByDockWith map[string]*Base
-	BasesByNick     map[string]*Base
-	JumpholesByNick map[string]*Jumphole
+	BasesByBases     map[string]*Base
+	BasesByDockWith  map[string]*Base
+	BasesByNick      map[string]*Base
+	JumpholesByNick  map[string]*Jumphole
+	TradelanesByNick map[string]*TradeLaneRing
+
+	// Problems met while reading system and asteroid files
+	Diagnostics []inireader.Diagnostic
 }
 
 type FileRead struct {
@@ -244,20 +251,26 @@ func Read(universe_config *universe_mapped.Config, filesystem *filefind.Filesyst
 		BasesByBases:    make(map[string]*Base),
 		BasesByDockWith: make(map[string]*Base),
 
-		BasesByNick:     make(map[string]*Base),
-		JumpholesByNick: make(map[string]*Jumphole),
+		BasesByNick:      make(map[string]*Base),
+		JumpholesByNick:  make(map[string]*Jumphole),
+		TradelanesByNick: make(map[string]*TradeLaneRing),
 	}
 	var wg sync.WaitGroup
+	var diagnostics_mu sync.Mutex
 
 	var system_files map[string]*file.File = make(map[string]*file.File)
 
 	timeit.NewTimerF(func() {
 		for _, base := range universe_config.Bases {
 			base_system := base.System.Get()
-			universe_system := universe_config.SystemMap[universe_mapped.SystemNickname(base_system)]
+			universe_system, ok := universe_config.SystemMap[universe_mapped.SystemNickname(base_system)]
+			if !ok {
+				// broken reference, it is reported by configs linter
+				continue
+			}
 			filename := universe_system.File.FileName()
 			path := filesystem.GetFile(filename)
-			system_files[base.System.Get()] = file.NewFile(path.GetFilepath())
+			system_files[base.System.Get()] = file.NewFileFrom(path)
 		}
 	}, timeit.WithMsg("systems prepared files"))
 
@@ -280,6 +293,7 @@ func Read(universe_config *universe_mapped.Config, filesystem *filefind.Filesyst
 			for range system_files {
 				result := <-iniconfigs_channel
 				system_iniconfigs[result.system_key] = result.ini
+				frelconfig.Diagnostics = append(frelconfig.Diagnostics, result.ini.Diagnostics...)
 			}
 		}, timeit.WithMsg("Read system files with parallelism ^_^"))
 	}()
@@ -299,7 +313,7 @@ func Read(universe_config *universe_mapped.Config, filesystem *filefind.Filesyst
 				BasesByBases:    make(map[string]*Base),
 				BasesByDockWith: make(map[string]*Base),
 			}
-			system_to_add.Init(sysiniconf.Sections, sysiniconf.Comments, sysiniconf.File.GetFilepath())
+			system_to_add.Init(sysiniconf)
 
 			system_to_add.Nickname = system_key
 
@@ -328,6 +342,9 @@ func Read(universe_config *universe_mapped.Config, filesystem *filefind.Filesyst
 							return
 						}
 						config := inireader.Read(file_to_read)
+						diagnostics_mu.Lock()
+						frelconfig.Diagnostics = append(frelconfig.Diagnostics, config.Diagnostics...)
+						diagnostics_mu.Unlock()
 
 						if lootable_zones, ok := config.SectionMap["[lootablezone]"]; ok {
 							obj := lootable_zones[0]
@@ -442,10 +459,13 @@ func Read(universe_config *universe_mapped.Config, filesystem *filefind.Filesyst
 							Pos:      semantic.NewVector(obj, cfg.Key("pos"), semantic.Precision(0)),
 							NextRing: semantic.NewString(obj, cfg.Key("next_ring"), semantic.WithLowercaseS(), semantic.WithoutSpacesS()),
 							PrevRing: semantic.NewString(obj, cfg.Key("prev_ring"), semantic.WithLowercaseS(), semantic.WithoutSpacesS()),
+							IdsName:  semantic.NewInt(obj, cfg.Key("ids_name"), semantic.Optional()),
+							System:   system_to_add,
 						}
 
 						system_to_add.Tradelanes = append(system_to_add.Tradelanes, tradelane)
 						system_to_add.TradelaneByNick[tradelane.Nickname.Get()] = tradelane
+						frelconfig.TradelanesByNick[tradelane.Nickname.Get()] = tradelane
 					}
 
 				}
@@ -531,11 +551,11 @@ func Read(universe_config *universe_mapped.Config, filesystem *filefind.Filesyst
 	return frelconfig
 }
 
-func (frelconfig *Config) Write() []*file.File {
+func (frelconfig *Config) Write(opts ...inireader.WriteOption) []*file.File {
 	var files []*file.File = make([]*file.File, 0)
 	for _, system := range frelconfig.Systems {
 		inifile := system.Render()
-		files = append(files, inifile.Write(inifile.File))
+		files = append(files, inifile.Write(inifile.File, opts...))
 	}
 	return files
 }
@@ -93,6 +93,9 @@ type Config struct {
 	SystemMap map[SystemNickname]*System
 
 	TimeSeconds *semantic.Int
+
+	// Problems met while reading base and room files
+	Diagnostics []inireader.Diagnostic
 }
 
 type FileRead struct {
@@ -161,7 +164,7 @@ func Read(ini *iniload.IniLoader, filesystem *filefind.Filesystem) *Config {
 		for _, base := range frelconfig.Bases {
 			filename := base.File.FileName()
 			path := filesystem.GetFile(filename)
-			base_files[base.Nickname.Get()] = file.NewFile(path.GetFilepath())
+			base_files[base.Nickname.Get()] = file.NewFileFrom(path)
 		}
 	}, timeit.WithMsg("systems prepared files"))
 	var base_fileconfigs map[string]*inireader.INIFile = make(map[string]*inireader.INIFile)
@@ -182,6 +185,7 @@ func Read(ini *iniload.IniLoader, filesystem *filefind.Filesystem) *Config {
 			for range base_files {
 				result := <-iniconfigs_channel
 				base_fileconfigs[result.base_nickname] = result.ini
+				frelconfig.Diagnostics = append(frelconfig.Diagnostics, result.ini.Diagnostics...)
 			}
 		}, timeit.WithMsg("Read system files with parallelism ^_^"))
 	}()
@@ -219,7 +223,7 @@ func Read(ini *iniload.IniLoader, filesystem *filefind.Filesystem) *Config {
 			path := filesystem.GetFile(utils_types.FilePath(strings.ToLower(string(filename))))
 			go readd_room_info(&RoomInfoRead{
 				Room: room,
-				file: file.NewFile(path.GetFilepath()),
+				file: file.NewFileFrom(path),
 				Base: base,
 			})
 		}
@@ -229,6 +233,7 @@ func Read(ini *iniload.IniLoader, filesystem *filefind.Filesystem) *Config {
 	for _, base := range frelconfig.Bases {
 		for _, _ = range base.ConfigBase.Rooms {
 			room_info := <-iniconfigs_channel
+			frelconfig.Diagnostics = append(frelconfig.Diagnostics, room_info.ini.Diagnostics...)
 			if sections, ok := room_info.ini.SectionMap["[hotspot]"]; ok {
 				for _, hot_spot_section := range sections {
 					hot_spot := &HotSpot{
@@ -273,8 +278,8 @@ func Read(ini *iniload.IniLoader, filesystem *filefind.Filesystem) *Config {
 	return frelconfig
 }
 
-func (frelconfig *Config) Write() *file.File {
+func (frelconfig *Config) Write(opts ...inireader.WriteOption) *file.File {
 	inifile := frelconfig.File.Render()
-	inifile.Write(inifile.File)
+	inifile.Write(inifile.File, opts...)
 	return inifile.File
 }
@@ -11,7 +11,6 @@ import (
 	"encoding/binary"
 	"errors"
 	"math"
-	"os"
 	"strings"
 	"unicode/utf16"
 
@@ -262,7 +261,11 @@ func ParseDLLs(dll_fnames []*file.File) *infocard.Config {
 	out := infocard.NewConfig()
 
 	for idx, name := range dll_fnames {
-		data, err := os.ReadFile(name.GetFilepath().ToString())
+		if name == nil {
+			logus.Log.Error("unable to read dll, it is not found", typelog.Int("index", idx))
+			continue
+		}
+		data, err := name.ReadBytes()
 
 		if logus.Log.CheckError(err, "unable to read dll") {
 			continue
@@ -0,0 +1,296 @@
+package exe_mapped
+
+/*
+Writer of resource only DLLs, reverse of ParseDLL.
+Resulting PE has single .rsrc section with RT_STRING tables for infonames and RT_HTML entries for infocards.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"unicode/utf16"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/infocard_mapped/infocard"
+)
+
+const (
+	RT_HTML = RT_RCDATA // Freelancer infocards are stored as resource type 23
+
+	DLLIdsPerFile     = 1 << 16 // ids of every dll in freelancer.ini [Resources] are offset by its position multiplied by it
+	dll_strings_block = 16
+	dll_locale        = 1033 // en-US
+
+	pe_file_alignment    = 0x200
+	pe_section_alignment = 0x1000
+	pe_image_base        = 0x10000000
+	pe_rsrc_rva          = pe_section_alignment
+	pe_optional_hdr_size = 224
+)
+
+type dllResourceEntry struct {
+	name uint32
+	data []byte
+}
+
+type dllResourceType struct {
+	id      uint32
+	entries []dllResourceEntry
+}
+
+func encodeUTF16(value string) []uint16 {
+	return utf16.Encode([]rune(value))
+}
+
+func writeLE(buf *bytes.Buffer, values ...any) {
+	for _, value := range values {
+		binary.Write(buf, binary.LittleEndian, value)
+	}
+}
+
+func alignUp(value int, alignment int) int {
+	return (value + alignment - 1) / alignment * alignment
+}
+
+/*
+stringTables packs infonames into blocks of 16 strings, as RT_STRING expects.
+Block with name N holds local ids from (N-1)*16 to N*16-1
+*/
+func stringTables(names map[int]infocard.Infoname) ([]dllResourceEntry, error) {
+	blocks := make(map[uint32]*[dll_strings_block]string)
+	for local_id, name := range names {
+		block_id := uint32(local_id/dll_strings_block) + 1
+		if _, ok := blocks[block_id]; !ok {
+			blocks[block_id] = &[dll_strings_block]string{}
+		}
+		blocks[block_id][local_id%dll_strings_block] = string(name)
+	}
+
+	var result []dllResourceEntry
+	for block_id, block := range blocks {
+		var buf bytes.Buffer
+		for index, value := range block {
+			encoded := encodeUTF16(value)
+			if len(encoded) > math.MaxUint16 {
+				return nil, fmt.Errorf("infoname %d is too long: %d", int(block_id-1)*dll_strings_block+index, len(encoded))
+			}
+			writeLE(&buf, uint16(len(encoded)), encoded)
+		}
+		result = append(result, dllResourceEntry{name: block_id, data: buf.Bytes()})
+	}
+	return result, nil
+}
+
+func htmlResources(cards map[int]*infocard.Infocard) []dllResourceEntry {
+	var result []dllResourceEntry
+	for local_id, card := range cards {
+		var buf bytes.Buffer
+		buf.Write([]byte{0xFF, 0xFE}) // UTF-16 LE BOM
+		writeLE(&buf, encodeUTF16(card.GetContent()))
+		result = append(result, dllResourceEntry{name: uint32(local_id), data: buf.Bytes()})
+	}
+	return result
+}
+
+/*
+buildRsrc lays out .rsrc section as three levels of directories (type, name, language),
+followed by data entries and raw data. Directory offsets are relative to section start,
+data entries point with RVA.
+*/
+func buildRsrc(types []dllResourceType) []byte {
+	sort.Slice(types, func(i, j int) bool { return types[i].id < types[j].id })
+	entries_count := 0
+	for _, rtype := range types {
+		sort.Slice(rtype.entries, func(i, j int) bool { return rtype.entries[i].name < rtype.entries[j].name })
+		entries_count += len(rtype.entries)
+	}
+
+	const dir_size = 16
+	const dir_entry_size = 8
+	const data_entry_size = 16
+
+	root_size := dir_size + dir_entry_size*len(types)
+	types_size := 0
+	for _, rtype := range types {
+		types_size += dir_size + dir_entry_size*len(rtype.entries)
+	}
+	langs_size := entries_count * (dir_size + dir_entry_size)
+	data_entries_offset := root_size + types_size + langs_size
+	raw_data_offset := data_entries_offset + entries_count*data_entry_size
+
+	var dirs, data_entries, raw_data bytes.Buffer
+	writeDir := func(buf *bytes.Buffer, id_entries int) {
+		writeLE(buf, IMAGE_RESOURCE_DIRECTORY{MajorVersion: 4, NumberOfIdEntries: uint16(id_entries)})
+	}
+
+	// root
+	writeDir(&dirs, len(types))
+	type_dir_offset := root_size
+	for _, rtype := range types {
+		writeLE(&dirs, IMAGE_RESOURCE_DIRECTORY_ENTRY{Name: rtype.id, OffsetToData: IMAGE_RESOURCE_DATA_IS_DIRECTORY | uint32(type_dir_offset)})
+		type_dir_offset += dir_size + dir_entry_size*len(rtype.entries)
+	}
+
+	// names
+	lang_dir_offset := root_size + types_size
+	for _, rtype := range types {
+		writeDir(&dirs, len(rtype.entries))
+		for _, entry := range rtype.entries {
+			writeLE(&dirs, IMAGE_RESOURCE_DIRECTORY_ENTRY{Name: entry.name, OffsetToData: IMAGE_RESOURCE_DATA_IS_DIRECTORY | uint32(lang_dir_offset)})
+			lang_dir_offset += dir_size + dir_entry_size
+		}
+	}
+
+	// languages and data
+	data_entry_offset := data_entries_offset
+	for _, rtype := range types {
+		for _, entry := range rtype.entries {
+			writeDir(&dirs, 1)
+			writeLE(&dirs, IMAGE_RESOURCE_DIRECTORY_ENTRY{Name: dll_locale, OffsetToData: uint32(data_entry_offset)})
+			data_entry_offset += data_entry_size
+
+			data_offset := raw_data_offset + raw_data.Len()
+			writeLE(&data_entries, IMAGE_RESOURCE_DATA_ENTRY{OffsetToData: uint32(pe_rsrc_rva + data_offset), Size: uint32(len(entry.data))})
+			raw_data.Write(entry.data)
+			raw_data.Write(make([]byte, alignUp(raw_data.Len(), 4)-raw_data.Len()))
+		}
+	}
+
+	var result bytes.Buffer
+	result.Write(dirs.Bytes())
+	result.Write(data_entries.Bytes())
+	result.Write(raw_data.Bytes())
+	return result.Bytes()
+}
+
+// buildPE wraps resource section into minimal 32 bit DLL without code
+func buildPE(rsrc []byte) []byte {
+	const dos_header_size = 64
+	const pe_signature_size = 4
+	const coff_header_size = 20
+	const section_header_size = 40
+
+	headers_size := alignUp(dos_header_size+pe_signature_size+coff_header_size+pe_optional_hdr_size+section_header_size, pe_file_alignment)
+	raw_size := alignUp(len(rsrc), pe_file_alignment)
+	image_size := pe_rsrc_rva + alignUp(len(rsrc), pe_section_alignment)
+
+	var buf bytes.Buffer
+
+	// DOS header, only magic and offset to PE header matter
+	dos_header := make([]byte, dos_header_size)
+	copy(dos_header, "MZ")
+	binary.LittleEndian.PutUint32(dos_header[60:], dos_header_size)
+	buf.Write(dos_header)
+
+	buf.WriteString("PE\x00\x00")
+
+	// COFF header
+	writeLE(&buf,
+		uint16(0x14c), // i386
+		uint16(1),     // sections
+		uint32(0),     // timestamp
+		uint32(0),     // symbol table
+		uint32(0),     // symbols
+		uint16(pe_optional_hdr_size),
+		uint16(0x210E), // executable, dll, 32 bit, stripped
+	)
+
+	// Optional header PE32
+	writeLE(&buf,
+		uint16(0x10B), // magic
+		uint8(14), uint8(0),
+		uint32(0),        // size of code
+		uint32(raw_size), // size of initialized data
+		uint32(0),        // size of uninitialized data
+		uint32(0),        // entry point
+		uint32(pe_rsrc_rva),
+		uint32(pe_rsrc_rva),
+		uint32(pe_image_base),
+		uint32(pe_section_alignment),
+		uint32(pe_file_alignment),
+		uint16(4), uint16(0), // os version
+		uint16(0), uint16(0), // image version
+		uint16(4), uint16(0), // subsystem version
+		uint32(0), // win32 version
+		uint32(image_size),
+		uint32(headers_size),
+		uint32(0),                        // checksum
+		uint16(2),                        // windows gui subsystem
+		uint16(0),                        // dll characteristics
+		uint32(0x100000), uint32(0x1000), // stack
+		uint32(0x100000), uint32(0x1000), // heap
+		uint32(0),  // loader flags
+		uint32(16), // data directories
+	)
+	for index := 0; index < 16; index++ {
+		if index == 2 { // resource table
+			writeLE(&buf, uint32(pe_rsrc_rva), uint32(len(rsrc)))
+		} else {
+			writeLE(&buf, uint32(0), uint32(0))
+		}
+	}
+
+	// Section header
+	section_name := make([]byte, 8)
+	copy(section_name, ".rsrc")
+	buf.Write(section_name)
+	writeLE(&buf,
+		uint32(len(rsrc)),
+		uint32(pe_rsrc_rva),
+		uint32(raw_size),
+		uint32(headers_size),
+		uint32(0), uint32(0), // relocations and line numbers
+		uint16(0), uint16(0),
+		uint32(0x40000040), // initialized data, readable
+	)
+
+	buf.Write(make([]byte, headers_size-buf.Len()))
+	buf.Write(rsrc)
+	buf.Write(make([]byte, raw_size-len(rsrc)))
+	return buf.Bytes()
+}
+
+/*
+WriteDLL compiles infonames and infocards back into resource DLL. It is reverse of ParseDLL
+and takes same globalOffset, which is 65536 multiplied by dll position in freelancer.ini [Resources] list.
+Ids outside of range of this DLL are skipped, so full config from GetAllInfocards can be passed for every dll.
+*/
+func WriteDLL(config *infocard.Config, globalOffset int) ([]byte, error) {
+	names := make(map[int]infocard.Infoname)
+	for ids_id, name := range config.Infonames {
+		if local_id := ids_id - globalOffset; local_id >= 0 && local_id < DLLIdsPerFile {
+			names[local_id] = name
+		}
+	}
+	cards := make(map[int]*infocard.Infocard)
+	for ids_id, card := range config.Infocards {
+		if local_id := ids_id - globalOffset; local_id >= 0 && local_id < DLLIdsPerFile {
+			cards[local_id] = card
+		}
+	}
+
+	var types []dllResourceType
+	if len(names) > 0 {
+		tables, err := stringTables(names)
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, dllResourceType{id: RT_STRING, entries: tables})
+	}
+	if len(cards) > 0 {
+		types = append(types, dllResourceType{id: RT_HTML, entries: htmlResources(cards)})
+	}
+
+	return buildPE(buildRsrc(types)), nil
+}
+
+func WriteDLLFile(config *infocard.Config, globalOffset int, path string) error {
+	data, err := WriteDLL(config, globalOffset)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,41 @@
+package exe_mapped
+
+import (
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/infocard_mapped/infocard"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteDLLReadBack(t *testing.T) {
+	global_offset := 65536 * 2
+
+	config := infocard.NewConfig()
+	config.Infonames[global_offset+1] = "Planet Manhattan"
+	config.Infonames[global_offset+17] = "Новый Свет" // second string block, non latin text
+	config.Infonames[global_offset+65535] = "Last id of dll"
+	config.Infonames[5] = "belongs to other dll"
+	config.Infocards[global_offset+2] = infocard.NewInfocard(`<?xml version="1.0" encoding="UTF-16"?><RDL><PUSH/><TEXT>Manhattan</TEXT><PARA/><POP/></RDL>`)
+	config.Infocards[global_offset+300] = infocard.NewInfocard(`<RDL><TEXT>odd length</TEXT><PARA/></RDL>`)
+
+	data, err := WriteDLL(config, global_offset)
+	assert.Nil(t, err)
+
+	read_back := infocard.NewConfig()
+	ParseDLL(data, read_back, global_offset)
+
+	assert.Equal(t, map[int]infocard.Infoname{
+		global_offset + 1:     "Planet Manhattan",
+		global_offset + 17:    "Новый Свет",
+		global_offset + 65535: "Last id of dll",
+	}, read_back.Infonames)
+
+	assert.Len(t, read_back.Infocards, 2)
+	for _, ids_id := range []int{global_offset + 2, global_offset + 300} {
+		assert.Equal(t, config.Infocards[ids_id].GetContent(), read_back.Infocards[ids_id].GetContent())
+	}
+
+	lines, err := read_back.Infocards[global_offset+2].XmlToText()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Manhattan", ""}, lines)
+}
@@ -1,8 +1,9 @@
 package infocard
 
 type Infocard struct {
-	content string
-	Lines   []string
+	content   string
+	Lines     []string
+	RichLines []RDLLine
 }
 
 func NewInfocard(content string) *Infocard {
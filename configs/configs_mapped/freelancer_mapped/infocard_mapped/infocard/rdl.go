@@ -0,0 +1,247 @@
+package infocard
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+/*
+RDL is Freelancer xml markup for infocards.
+
+	<TRA data="1" mask="1" def="-2"/> switches text attributes. Lowest byte holds flags, upper bytes hold color as BBGGRR
+	<JUST loc="center"/> aligns paragraph
+	<TEXT>...</TEXT> is text run
+	<PARA/> ends paragraph
+	<PUSH/> and <POP/> save and restore text attributes
+*/
+
+type Justify string
+
+const (
+	JustifyLeft   Justify = "left"
+	JustifyCenter Justify = "center"
+	JustifyRight  Justify = "right"
+)
+
+type RDLPhrase struct {
+	Text      string
+	Bold      bool
+	Italic    bool
+	Underline bool
+	Color     string // in #RRGGBB format. Empty for default color
+}
+
+type RDLLine struct {
+	Phrases []RDLPhrase
+	Justify Justify
+}
+
+func (l RDLLine) ToStr() string {
+	var sb strings.Builder
+	for _, phrase := range l.Phrases {
+		sb.WriteString(phrase.Text)
+	}
+	return sb.String()
+}
+
+const (
+	traBold      uint32 = 1
+	traItalic    uint32 = 2
+	traUnderline uint32 = 4
+	traColorMask uint32 = 0xFFFFFF00
+)
+
+type rdlStyle struct {
+	flags uint32
+	color string
+}
+
+func (s rdlStyle) phrase(text string) RDLPhrase {
+	return RDLPhrase{
+		Text:      text,
+		Bold:      s.flags&traBold != 0,
+		Italic:    s.flags&traItalic != 0,
+		Underline: s.flags&traUnderline != 0,
+		Color:     s.color,
+	}
+}
+
+var rdlColors = map[string]string{
+	"white":   "#FFFFFF",
+	"gray":    "#808080",
+	"red":     "#FF0000",
+	"green":   "#00FF00",
+	"blue":    "#0000FF",
+	"yellow":  "#FFFF00",
+	"fuchsia": "#FF00FF",
+	"aqua":    "#00FFFF",
+	"lime":    "#00FF00",
+}
+
+var rdlColorHex = regexp.MustCompile(`^#[0-9A-F]{6}$`)
+
+// IsRDLColor checks color is in #RRGGBB format, so it is safe to put into style attribute
+func IsRDLColor(color string) bool {
+	return rdlColorHex.MatchString(color)
+}
+
+func parseRDLNumber(value string) (uint32, error) {
+	parsed, err := strconv.ParseInt(value, 0, 64)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(parsed), nil
+}
+
+// bgrToHex converts TRA color bytes from BBGGRR to #RRGGBB
+func bgrToHex(bgr uint32) string {
+	if bgr == 0 {
+		return ""
+	}
+	red := bgr & 0xFF
+	green := (bgr >> 8) & 0xFF
+	blue := (bgr >> 16) & 0xFF
+	return fmt.Sprintf("#%02X%02X%02X", red, green, blue)
+}
+
+func (s *rdlStyle) applyTRA(tok xml.StartElement) {
+	var data, mask uint32
+	var has_data bool
+	mask = 0xFFFFFFFF
+
+	for _, attr := range tok.Attr {
+		value := strings.ToLower(strings.TrimSpace(attr.Value))
+		switch strings.ToLower(attr.Name.Local) {
+		case "data":
+			if parsed, err := parseRDLNumber(value); err == nil {
+				data = parsed
+				has_data = true
+			}
+		case "mask":
+			if parsed, err := parseRDLNumber(value); err == nil {
+				mask = parsed
+			}
+		case "bold":
+			s.setFlag(traBold, value == "true")
+		case "italic":
+			s.setFlag(traItalic, value == "true")
+		case "underline":
+			s.setFlag(traUnderline, value == "true")
+		case "color":
+			if named, ok := rdlColors[value]; ok {
+				s.color = named
+			} else if IsRDLColor(strings.ToUpper(value)) {
+				s.color = strings.ToUpper(value)
+			} else {
+				s.color = ""
+			}
+		}
+	}
+
+	if has_data {
+		s.flags = (s.flags &^ mask) | (data & mask)
+		if mask&traColorMask != 0 {
+			s.color = bgrToHex(s.flags >> 8)
+		}
+	}
+}
+
+func (s *rdlStyle) setFlag(flag uint32, enabled bool) {
+	if enabled {
+		s.flags |= flag
+	} else {
+		s.flags &^= flag
+	}
+}
+
+func parseJustify(tok xml.StartElement) Justify {
+	for _, attr := range tok.Attr {
+		if strings.ToLower(attr.Name.Local) != "loc" {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(attr.Value)) {
+		case "c", "center":
+			return JustifyCenter
+		case "r", "right":
+			return JustifyRight
+		}
+	}
+	return JustifyLeft
+}
+
+func (i *Infocard) RDLToLines() ([]RDLLine, error) {
+	return ParseRDL(i.content)
+}
+
+/*
+ParseRDL keeps infocard formatting, unlike XmlToText.
+Lines are split at same places as in XmlToText, so their text is identical.
+*/
+func ParseRDL(raw string) ([]RDLLine, error) {
+	prepared := strings.ReplaceAll(string(raw), `<?xml version="1.0" encoding="UTF-16"?>`, "")
+	decoder := xml.NewDecoder(bytes.NewBufferString(prepared))
+
+	lines := make([]RDLLine, 0, 10)
+	var style rdlStyle
+	var style_stack []rdlStyle
+	justify := JustifyLeft
+	var current RDLLine
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				break
+			} else {
+				return nil, err
+			}
+		}
+
+		switch tok := token.(type) {
+		case xml.StartElement:
+			switch tok.Name.Local {
+			case "TRA":
+				style.applyTRA(tok)
+			case "JUST":
+				justify = parseJustify(tok)
+			case "PUSH":
+				style_stack = append(style_stack, style)
+			}
+		case xml.EndElement:
+			name := tok.Name.Local
+			if name == "POP" && len(style_stack) > 0 {
+				style = style_stack[len(style_stack)-1]
+				style_stack = style_stack[:len(style_stack)-1]
+			}
+			if name == "PARA" || name == "POP" {
+				current.Justify = justify
+				lines = append(lines, current)
+				current = RDLLine{}
+			}
+		case xml.CharData:
+			text := string(tok)
+			if text == "" {
+				continue
+			}
+			phrase := style.phrase(text)
+			if last := len(current.Phrases) - 1; last >= 0 && sameStyle(current.Phrases[last], phrase) {
+				current.Phrases[last].Text += text
+				continue
+			}
+			current.Phrases = append(current.Phrases, phrase)
+		default:
+			continue
+		}
+	}
+
+	return lines, nil
+}
+
+func sameStyle(a RDLPhrase, b RDLPhrase) bool {
+	return a.Bold == b.Bold && a.Italic == b.Italic && a.Underline == b.Underline && a.Color == b.Color
+}
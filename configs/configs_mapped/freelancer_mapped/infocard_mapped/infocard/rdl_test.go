@@ -0,0 +1,42 @@
+package infocard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRDL(t *testing.T) {
+	raw := `<?xml version="1.0" encoding="UTF-16"?><RDL><PUSH/>` +
+		`<JUST loc="center"/><TRA data="1" mask="1" def="-2"/><TEXT>MANHATTAN</TEXT><TRA data="0" mask="1" def="-1"/><PARA/>` +
+		`<JUST loc="left"/><TEXT>Class: </TEXT><TRA data="0x0000FF05" mask="-1" def="0"/><TEXT>Planet</TEXT><PARA/>` +
+		`<TRA bold="false" color="default"/><TEXT>Plain &amp; simple</TEXT><PARA/>` +
+		`<POP/></RDL>`
+
+	lines, err := ParseRDL(raw)
+	assert.Nil(t, err)
+
+	text_lines, err := XmlToText(raw)
+	assert.Nil(t, err)
+	assert.Equal(t, len(text_lines), len(lines))
+	for index := range lines {
+		assert.Equal(t, text_lines[index], lines[index].ToStr())
+	}
+
+	assert.Equal(t, RDLLine{Justify: JustifyCenter, Phrases: []RDLPhrase{{Text: "MANHATTAN", Bold: true}}}, lines[0])
+	assert.Equal(t, RDLLine{Justify: JustifyLeft, Phrases: []RDLPhrase{
+		{Text: "Class: "},
+		{Text: "Planet", Bold: true, Underline: true, Color: "#FF0000"},
+	}}, lines[1])
+	assert.Equal(t, []RDLPhrase{{Text: "Plain & simple", Underline: true}}, lines[2].Phrases)
+}
+
+func TestParseRDLDropsInvalidColor(t *testing.T) {
+	lines, err := ParseRDL(`<RDL><TRA color="#00ff80"/><TEXT>valid</TEXT><PARA/>` +
+		`<TRA color="#F00;background:url(x)"/><TEXT>injected</TEXT><PARA/>` +
+		`<TRA color="#12345"/><TEXT>short</TEXT><PARA/></RDL>`)
+	assert.Nil(t, err)
+	assert.Equal(t, "#00FF80", lines[0].Phrases[0].Color)
+	assert.Equal(t, "", lines[1].Phrases[0].Color)
+	assert.Equal(t, "", lines[2].Phrases[0].Color)
+}
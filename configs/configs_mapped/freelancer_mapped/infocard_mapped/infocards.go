@@ -140,6 +140,7 @@ func Read(filesystem *filefind.Filesystem, freelancer_ini *exe_mapped.Config, in
 		wg.Add(1)
 		go func(parsed_infocard *infocard.Infocard) {
 			card.Lines, _ = card.XmlToText()
+			card.RichLines, _ = card.RDLToLines()
 			wg.Done()
 		}(card)
 	}
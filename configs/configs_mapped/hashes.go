@@ -0,0 +1,55 @@
+package configs_mapped
+
+import (
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/initialworld/flhash"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/iniload"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
+	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
+	"github.com/darklab8/go-typelog/typelog"
+)
+
+func addSectionsToHashIndex(index *flhash.HashIndex, sections []*inireader.Section) {
+	for _, section := range sections {
+		if values, ok := section.ParamMap["nickname"]; ok && len(values) > 0 {
+			index.AddNickname(values[0].First.AsString(), string(section.Type))
+		}
+	}
+}
+
+/*
+buildHashIndex collects every nickname met in loaded configs, so hashes from save files,
+server logs and PoB data could be resolved back to items, bases, systems and factions.
+*/
+func (m *MappedConfigs) buildHashIndex(loaders []*iniload.IniLoader) *flhash.HashIndex {
+	index := flhash.NewHashIndex()
+	for _, loader := range loaders {
+		if loader == nil || loader.INIFile == nil {
+			continue
+		}
+		addSectionsToHashIndex(index, loader.Sections)
+	}
+	if m.Systems != nil {
+		for _, system := range m.Systems.Systems {
+			index.AddNickname(system.Nickname, "[system]")
+			addSectionsToHashIndex(index, system.Render().Sections)
+		}
+	}
+	if m.InitialWorld != nil {
+		for _, group := range m.InitialWorld.Groups {
+			index.AddFaction(group.Nickname.Get())
+		}
+	}
+
+	for _, collision := range index.Collisions() {
+		nicknames := make([]string, 0, len(collision.Entries))
+		for _, entry := range collision.Entries {
+			nicknames = append(nicknames, entry.Nickname)
+		}
+		logus.Log.Warn("hash collision",
+			typelog.String("hash", collision.Hash.ToHexStr()),
+			typelog.Items("nicknames", nicknames),
+		)
+	}
+	logus.Log.Info("built hash index", typelog.Int("hashes", index.Len()))
+	return index
+}
@@ -5,14 +5,15 @@ package configs_mapped
 
 import (
 	"encoding/json"
-	"fmt"
-	"os"
+	"io/fs"
+	"strings"
 	"sync"
 
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/flsr/flsr_recipes"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/const_mapped"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/equipment_mapped"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/initialworld"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/initialworld/flhash"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/interface_mapped"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/missions_mapped/empathy_mapped"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/missions_mapped/faction_props_mapped"
@@ -26,12 +27,13 @@ import (
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/universe_mapped"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/universe_mapped/systems_mapped"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/exe_mapped"
-	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/infocard_mapped"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/infocard_mapped/infocard"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/iniload"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
+	"github.com/darklab8/fl-darkstat/configs/configs_settings"
 	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
 	"github.com/darklab8/fl-darkstat/configs/overrides"
 	"github.com/darklab8/fl-data-discovery/autopatcher"
@@ -45,8 +47,8 @@ import (
 	"github.com/darklab8/fl-darkstat/configs/discovery/pob_goods"
 	"github.com/darklab8/fl-darkstat/configs/discovery/techcompat"
 
+	"github.com/darklab8/go-typelog/typelog"
 	"github.com/darklab8/go-utils/utils"
-	"github.com/darklab8/go-utils/utils/timeit"
 	"github.com/darklab8/go-utils/utils/utils_logus"
 	"github.com/darklab8/go-utils/utils/utils_types"
 )
@@ -96,6 +98,17 @@ type MappedConfigs struct {
 	FLSR      *SiriusRevivalConfig
 
 	Overrides overrides.Overrides
+
+	// Non fatal problems met during parsing
+	ParseReport *ParseReport
+
+	// Reverse lookup of nickname and faction hashes
+	Hashes *flhash.HashIndex
+
+	// ini files of every component, kept to reread only changed components in Reload()
+	loaders map[Component][]*iniload.IniLoader
+	// finds files anew, nil if configs were given already found filesystem
+	rescan func() *filefind.Filesystem
 }
 
 // Market() is RAM hungry, so we are going to deallocate it when it is no longer necessary in Clean()
@@ -127,6 +140,7 @@ func (m *MappedConfigs) Clean() {
 	m.Systems.Systems = nil
 	m.Systems.BasesByBases = nil
 	m.Systems.BasesByNick = nil
+	m.Close()
 	m.filesystem = nil
 	m.FreelancerINI = nil
 	m.InfocardmapINI = nil
@@ -151,6 +165,14 @@ func (m *MappedConfigs) Clean() {
 	m.Goods.ShipsMapByHull = nil
 	m.Goods.ShipHulls = nil
 	m.Goods.ShipHullsMapByShip = nil
+
+	// loaders hold parsed ini files of everything above
+	m.loaders = nil
+}
+
+// Close releases archives configs were read from. Already mapped configs stay usable
+func (m *MappedConfigs) Close() {
+	logus.Log.CheckWarn(m.filesystem.Close(), "failed to close configs filesystem")
 }
 
 func NewMappedConfigs() *MappedConfigs {
@@ -158,12 +180,7 @@ func NewMappedConfigs() *MappedConfigs {
 }
 
 func (configs *MappedConfigs) GetAvgTradeLaneSpeed() int {
-	average_trade_lane_speed := 2250
-	if configs.FLSR != nil {
-		// make this value part of config files some day
-		average_trade_lane_speed = 5000
-	}
-	return average_trade_lane_speed
+	return configs.Overrides.GetTradeLaneSpeed(2250)
 }
 
 func getConfigs(filesystem *filefind.Filesystem, paths []*semantic.Path) []*iniload.IniLoader {
@@ -172,55 +189,65 @@ func getConfigs(filesystem *filefind.Filesystem, paths []*semantic.Path) []*inil
 	})
 }
 
-func (m *MappedConfigs) Read(file1path utils_types.FilePath) *MappedConfigs {
+type readOptions struct {
+	layers     []utils_types.FilePath
+	merge_mode filefind.MergeMode
+}
+
+type ReadOption func(r *readOptions)
+
+// WithLayers applies mod folders or archives on top of base install, later layers override earlier ones
+func WithLayers(layers ...utils_types.FilePath) ReadOption {
+	return func(r *readOptions) { r.layers = append(r.layers, layers...) }
+}
+
+// WithSectionMerge merges ini files of layers section by section, instead of replacing whole files
+func WithSectionMerge() ReadOption {
+	return func(r *readOptions) { r.merge_mode = filefind.MergeSections }
+}
+
+// WithEnvLayers applies layers configured in env vars
+func WithEnvLayers() ReadOption {
+	return func(r *readOptions) {
+		r.layers = append(r.layers, configs_settings.Env.FreelancerLayers...)
+		if configs_settings.Env.FreelancerLayersMerge {
+			r.merge_mode = filefind.MergeSections
+		}
+	}
+}
+
+func (m *MappedConfigs) Read(file1path utils_types.FilePath, opts ...ReadOption) *MappedConfigs {
+	options := readOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	logus.Log.Info("Parse START for FreelancerFolderLocation=", utils_logus.FilePath(file1path))
-	filesystem := filefind.FindConfigs(file1path)
+	m.rescan = func() *filefind.Filesystem {
+		if len(options.layers) > 0 {
+			layers := append([]utils_types.FilePath{file1path}, options.layers...)
+			return filefind.FindConfigsLayers(options.merge_mode, layers...)
+		}
+		return filefind.FindConfigs(file1path)
+	}
+	m.ReadFilesystem(m.rescan())
+	logus.Log.Info("Parse OK for FreelancerFolderLocation=", utils_logus.FilePath(file1path))
+	return m
+}
+
+// ReadFS parses configs from any filesystem, like opened mod archive or test fixture
+func (m *MappedConfigs) ReadFS(fsys fs.FS) *MappedConfigs {
+	m.rescan = func() *filefind.Filesystem { return filefind.FindConfigsFS(fsys) }
+	return m.ReadFilesystem(m.rescan())
+}
+
+func (m *MappedConfigs) ReadFilesystem(filesystem *filefind.Filesystem) *MappedConfigs {
 	m.filesystem = filesystem
-	m.FreelancerINI = exe_mapped.Read(iniload.NewLoader(filesystem.GetFile(exe_mapped.FILENAME_FL_INI)).Scan())
-
-	files_goods := getConfigs(filesystem, m.FreelancerINI.Goods)
-	files_market := getConfigs(filesystem, m.FreelancerINI.Markets)
-	files_equip := getConfigs(filesystem, m.FreelancerINI.Equips)
-	files_shiparch := getConfigs(filesystem, m.FreelancerINI.Ships)
-	files_loadouts := getConfigs(filesystem, m.FreelancerINI.Loadouts)
-	file_universe := iniload.NewLoader(filesystem.GetFile(universe_mapped.FILENAME))
-	file_interface := iniload.NewLoader(filesystem.GetFile(interface_mapped.FILENAME_FL_INI))
-	file_initialworld := iniload.NewLoader(filesystem.GetFile(initialworld.FILENAME))
-	file_empathy := iniload.NewLoader(filesystem.GetFile(empathy_mapped.FILENAME))
-	file_mbases := iniload.NewLoader(filesystem.GetFile(mbases_mapped.FILENAME))
-	file_consts := iniload.NewLoader(filesystem.GetFile(const_mapped.FILENAME))
-	file_weaponmoddb := iniload.NewLoader(filesystem.GetFile(weaponmoddb.FILENAME))
-
-	file_diff2money := iniload.NewLoader(filesystem.GetFile(diff2money.FILENAME))
-	file_npcranktodiff := iniload.NewLoader(filesystem.GetFile(npcranktodiff.FILENAME))
-
-	file_faction_props := iniload.NewLoader(filesystem.GetFile(faction_props_mapped.FILENAME))
-	file_npc_ships := iniload.NewLoader(filesystem.GetFile(npc_ships.FILENAME))
-	file_solararch := iniload.NewLoader(filesystem.GetFile(solararch_mapped.FILENAME))
-
-	all_files := append(files_goods, files_market...)
-	all_files = append(all_files, files_equip...)
-	all_files = append(all_files, files_shiparch...)
-	all_files = append(all_files, files_loadouts...)
-	all_files = append(all_files,
-		file_universe,
-		file_interface,
-		file_initialworld,
-		file_empathy,
-		file_mbases,
-		file_consts,
-		file_weaponmoddb,
-		file_diff2money,
-		file_npcranktodiff,
-		file_faction_props,
-		file_npc_ships,
-		file_solararch,
-	)
+	m.loaders = make(map[Component][]*iniload.IniLoader)
+	file_freelancer_ini := iniload.NewLoader(filesystem.GetFile(exe_mapped.FILENAME_FL_INI)).Scan()
+	m.loaders[ComponentFreelancer] = []*iniload.IniLoader{file_freelancer_ini}
+	m.FreelancerINI = exe_mapped.Read(file_freelancer_ini)
 
-	var file_techcompat *iniload.IniLoader
-	var file_prices *iniload.IniLoader
-	var file_base_recipe_items *iniload.IniLoader
-	var file_playercntl_rephacks *iniload.IniLoader
 	if filesystem.GetFile("flsr-launcher.ini") != nil ||
 		filesystem.GetFile("flsr-texts.dll") != nil ||
 		filesystem.GetFile("flsr-dialogs.dll") != nil {
@@ -230,195 +257,108 @@ func (m *MappedConfigs) Read(file1path utils_types.FilePath) *MappedConfigs {
 			m.FLSR.FLSRRecipes = flsr_recipes.Read(iniload.NewLoader(flsr_recipes_file).Scan())
 		}
 	}
+
+	var wg sync.WaitGroup
+	var discovery_files []*iniload.IniLoader
 	if techcom := filesystem.GetFile("launcherconfig.xml"); techcom != nil {
 		m.Discovery = &DiscoveryConfig{}
-		file_techcompat = iniload.NewLoader(file.NewWebFile("https://discoverygc.com/gameconfigpublic/techcompat.cfg"))
-		file_prices = iniload.NewLoader(file.NewWebFile("https://discoverygc.com/gameconfigpublic/prices.cfg"))
-		file_base_recipe_items = iniload.NewLoader(file.NewWebFile("https://discoverygc.com/gameconfigpublic/base_recipe_items.cfg"))
-		file_playercntl_rephacks = iniload.NewLoader(file.NewWebFile("https://discoverygc.com/gameconfigpublic/playercntl_rephacks.cfg"))
-
-		all_files = append(
-			all_files,
-			file_techcompat,
-			file_prices,
-			file_base_recipe_items,
-			file_playercntl_rephacks,
-		)
 
 		if latest_patch_file := filesystem.GetFile(autopatcher.AutopatherFilename); latest_patch_file != nil {
-			fmt.Println("latest_patch_file=", latest_patch_file)
-			latest_patch_file_fp := latest_patch_file.GetFilepath()
-			patch_data, err := os.ReadFile(latest_patch_file_fp.ToString())
+			logus.Log.Debug("reading latest patch", utils_logus.FilePath(latest_patch_file.GetFilepath()))
+			patch_data, err := latest_patch_file.ReadBytes()
 			if !logus.Log.CheckError(err, "failed to unmarshal patch") {
 				json.Unmarshal(patch_data, &m.Discovery.LatestPatch)
 			}
-			fmt.Println("p.Discovery.LatestPatch=", m.Discovery.LatestPatch)
-		}
-	}
-
-	var infocards_override *file.File
-	if m.Discovery != nil {
-		infocards_override = file.NewWebFile("https://discoverygc.com/gameconfigpublic/infocard_overrides.cfg")
-	}
-
-	timeit.NewTimerF(func() {
-		var wg sync.WaitGroup
-		wg.Add(len(all_files))
-		for _, file := range all_files {
-			go func(file *iniload.IniLoader) {
-				file.Scan()
-				wg.Done()
-			}(file)
+			logus.Log.Debug("read latest patch", typelog.Any("patch", m.Discovery.LatestPatch))
 		}
-		wg.Wait()
-	}, timeit.WithMsg("Scanned ini loaders"))
-
-	overrides_file := filesystem.GetFile(overrides.FILENAME)
-	if overrides_file != nil {
-		logus.Log.Info("found overrides file")
-		m.Overrides = overrides.Read(overrides_file.GetFilepath())
-	}
-
-	timeit.NewTimerF(func() {
-		var wg sync.WaitGroup
-
-		wg.Add(1)
-		go func() {
-			timeit.NewTimerF(func() {
-				m.Universe = universe_mapped.Read(file_universe, filesystem)
-				m.Systems = systems_mapped.Read(m.Universe, filesystem)
-			}, timeit.WithMsg("map systems"))
-			wg.Done()
-		}()
-
-		wg.Add(1)
-		go func() {
-			m.market = market_mapped.Read(files_market)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.equip = equip_mapped.Read(files_equip)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.Goods = equipment_mapped.Read(files_goods)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.Shiparch = ship_mapped.Read(files_shiparch)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.InfocardmapINI = interface_mapped.Read(file_interface)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.Infocards, _ = infocard_mapped.Read(filesystem, m.FreelancerINI, infocards_override)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.InitialWorld = initialworld.Read(file_initialworld)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.Empathy = empathy_mapped.Read(file_empathy)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.MBases = mbases_mapped.Read(file_mbases)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.Consts = const_mapped.Read(file_consts)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.WeaponMods = weaponmoddb.Read(file_weaponmoddb)
-			wg.Done()
-		}()
-		wg.Add(1)
-		go func() {
-			m.NpcRankToDiff = npcranktodiff.Read(file_npcranktodiff)
-			m.DiffToMoney = diff2money.Read(file_diff2money)
-			wg.Done()
-		}()
 
+		// web configs are read alongside of game folder ones
 		wg.Add(1)
 		go func() {
-			m.FactionProps = faction_props_mapped.Read(file_faction_props)
-			m.NpcShips = npc_ships.Read(file_npc_ships)
+			discovery_files = m.readDiscoveryWebConfigs()
 			wg.Done()
 		}()
+	}
 
-		wg.Add(1)
-		go func() {
-			m.Solararch = solararch_mapped.Read(file_solararch)
-			wg.Done()
-		}()
+	m.readComponents(filesystem, components)
+	wg.Wait()
+	m.loaders[ComponentDiscovery] = discovery_files
+	m.finishReading()
 
-		wg.Add(1)
-		go func() {
-			m.Loadouts = loadouts_mapped.Read(files_loadouts)
-			wg.Done()
-		}()
+	return m
+}
 
-		if m.Discovery != nil {
-			wg.Add(4)
-			go func() {
-				m.Discovery.Techcompat = techcompat.Read(file_techcompat)
-				wg.Done()
-			}()
-			go func() {
-				m.Discovery.Prices = discoprices.Read(file_prices)
-				wg.Done()
-			}()
-			go func() {
-				m.Discovery.BaseRecipeItems = base_recipe_items.Read(file_base_recipe_items)
-				wg.Done()
-			}()
-			go func() {
-				m.Discovery.PlayercntlRephacks = playercntl_rephacks.Read(file_playercntl_rephacks)
-				wg.Done()
-			}()
-			file_public_bases := file.NewWebFile("https://discoverygc.com/forums/base_admin.php?action=getjson")
-			m.Discovery.PlayerOwnedBases = pob_goods.Read(file_public_bases)
-		}
-		wg.Wait()
-	}, timeit.WithMsg("Mapped stuff"))
+func (m *MappedConfigs) readDiscoveryWebConfigs() []*iniload.IniLoader {
+	file_techcompat := iniload.NewLoader(discoveryWebFile(discoveryConfigUrl("techcompat.cfg")))
+	file_prices := iniload.NewLoader(discoveryWebFile(discoveryConfigUrl("prices.cfg")))
+	file_base_recipe_items := iniload.NewLoader(discoveryWebFile(discoveryConfigUrl("base_recipe_items.cfg")))
+	file_playercntl_rephacks := iniload.NewLoader(discoveryWebFile(discoveryConfigUrl("playercntl_rephacks.cfg")))
+
+	var wg sync.WaitGroup
+	wg.Add(4)
+	go func() {
+		m.Discovery.Techcompat = techcompat.Read(file_techcompat.Scan())
+		wg.Done()
+	}()
+	go func() {
+		m.Discovery.Prices = discoprices.Read(file_prices.Scan())
+		wg.Done()
+	}()
+	go func() {
+		m.Discovery.BaseRecipeItems = base_recipe_items.Read(file_base_recipe_items.Scan())
+		wg.Done()
+	}()
+	go func() {
+		m.Discovery.PlayercntlRephacks = playercntl_rephacks.Read(file_playercntl_rephacks.Scan())
+		wg.Done()
+	}()
+	file_public_bases := discoveryWebFile(configs_settings.Env.DiscoveryPobsUrl)
+	m.Discovery.PlayerOwnedBases = pob_goods.Read(file_public_bases)
+	wg.Wait()
+
+	return []*iniload.IniLoader{
+		file_techcompat,
+		file_prices,
+		file_base_recipe_items,
+		file_playercntl_rephacks,
+	}
+}
 
-	logus.Log.Info("Parse OK for FreelancerFolderLocation=", utils_logus.FilePath(file1path))
+// discoveryWebFile reads public Discovery config with timeout, falling back to cached copy when offline
+func discoveryWebFile(url string) *file.File {
+	return file.NewWebFile(url,
+		file.WithTimeout(configs_settings.Env.WebTimeout),
+		file.WithCache(configs_settings.Env.WebCacheFolder),
+	)
+}
 
-	return m
+func discoveryConfigUrl(filename string) string {
+	return strings.TrimRight(configs_settings.Env.DiscoveryConfigsUrl, "/\\") + "/" + filename
 }
 
 type IsDruRun bool
 
-func (p *MappedConfigs) Write(is_dry_run IsDruRun) {
-	// write
+// Render returns files with current state of configs, without writing them to disk
+func (p *MappedConfigs) Render(opts ...inireader.WriteOption) []*file.File {
 	files := []*file.File{}
 
-	files = append(files, p.Universe.Write())
-	files = append(files, p.Systems.Write()...)
-	files = append(files, p.market.Write()...)
-	files = append(files, p.equip.Write()...)
-	files = append(files, p.Goods.Write()...)
-	files = append(files, p.Shiparch.Write()...)
-	files = append(files, p.InfocardmapINI.Write())
-	files = append(files, p.InitialWorld.Write())
-	files = append(files, p.Empathy.Write())
-	files = append(files, p.MBases.Write())
-	files = append(files, p.Consts.Write())
-	files = append(files, p.WeaponMods.Write())
+	files = append(files, p.Universe.Write(opts...))
+	files = append(files, p.Systems.Write(opts...)...)
+	files = append(files, p.market.Write(opts...)...)
+	files = append(files, p.equip.Write(opts...)...)
+	files = append(files, p.Goods.Write(opts...)...)
+	files = append(files, p.Shiparch.Write(opts...)...)
+	files = append(files, p.InfocardmapINI.Write(opts...))
+	files = append(files, p.InitialWorld.Write(opts...))
+	files = append(files, p.Empathy.Write(opts...))
+	files = append(files, p.MBases.Write(opts...))
+	files = append(files, p.Consts.Write(opts...))
+	files = append(files, p.WeaponMods.Write(opts...))
+	return files
+}
+
+func (p *MappedConfigs) Write(is_dry_run IsDruRun, opts ...inireader.WriteOption) {
+	files := p.Render(opts...)
 
 	if is_dry_run {
 		return
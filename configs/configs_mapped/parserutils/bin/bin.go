@@ -6,6 +6,7 @@ Code simplifying interactions with go-binary-pack
 
 import (
 	"bytes"
+	"io"
 
 	gbp "github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/exe_mapped/go-binary-pack"
 	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
@@ -43,6 +44,9 @@ func Read(
 	format []string,
 ) ([]interface{}, int, error) {
 	returned_n, err := fh.Read(byte_data)
+	if err == nil && returned_n < len(byte_data) {
+		err = io.ErrUnexpectedEOF
+	}
 
 	if err != nil {
 		var UnpackErrValue []interface{}
@@ -11,7 +11,6 @@ import (
 	gbp "github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/exe_mapped/go-binary-pack"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/bin"
 	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
-	"github.com/darklab8/go-typelog/typelog"
 	"github.com/darklab8/go-utils/utils/utils_logus"
 	"github.com/darklab8/go-utils/utils/utils_types"
 	"golang.org/x/text/encoding/charmap"
@@ -37,15 +36,25 @@ var VALUE_TYPES map[int]string = map[int]string{
 
 // maps a byte value type to a struct format string
 
-func parse_file(path utils_types.FilePath, FoldValues FoldValues) []Section {
+func parse_file(path utils_types.FilePath, FoldValues FoldValues) ([]Section, error) {
+	data, err := os.ReadFile(path.ToString())
+	if err != nil {
+		return nil, err
+	}
+	return parse_data(data, FoldValues)
+}
+
+// parse_data decodes already read file content. Corrupted content is returned as error instead of panic
+func parse_data(data []byte, FoldValues FoldValues) ([]Section, error) {
 	mem := bin.NewBDatas()
 	var result []Section = make([]Section, 0, 100)
 
 	var string_table map[int]string = make(map[int]string)
-	data, err := os.ReadFile(path.ToString())
-	logus.Log.CheckPanic(err, "uunable to open file", utils_logus.FilePath(path))
 
 	file_size := len(data)
+	if file_size < 12 {
+		return nil, fmt.Errorf("bini header is truncated, file size=%d", file_size)
+	}
 
 	fh := bytes.NewReader(data)
 
@@ -55,20 +64,28 @@ func parse_file(path utils_types.FilePath, FoldValues FoldValues) []Section {
 	format := []string{"4s", "I", "I"}
 
 	packed_values, err := bp.UnPack(format, bdata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack bini header: %w", err)
+	}
 	magic := packed_values[0].(string)
 	version := packed_values[1].(int)
 	str_table_offset := packed_values[2].(int)
 
 	if magic != "BINI" || version != 1 {
-		logus.Log.Panic("Expected finding BINI. Found smth else", typelog.String("magic", magic), typelog.Int("version", version))
+		return nil, fmt.Errorf("expected finding BINI version 1, found magic=%q version=%d", magic, version)
+	}
+	if str_table_offset < 12 || str_table_offset > file_size {
+		return nil, fmt.Errorf("bini string table offset=%d is out of file size=%d", str_table_offset, file_size)
 	}
 
-	fh.Seek(int64(str_table_offset), SEEK_SET)
+	if _, err := fh.Seek(int64(str_table_offset), SEEK_SET); err != nil {
+		return nil, err
+	}
 
 	var raw_table []byte
 	raw_table_length := file_size - str_table_offset - 1
 	if raw_table_length <= 0 {
-		return result
+		return result, nil
 	}
 	raw_table = make([]byte, raw_table_length)
 	fh.Read(raw_table)
@@ -80,7 +97,9 @@ func parse_file(path utils_types.FilePath, FoldValues FoldValues) []Section {
 
 		tr := charmap.Windows1252.NewDecoder().Reader(strings.NewReader(string(table)))
 		windows_decoded, err := io.ReadAll(tr)
-		logus.Log.CheckPanic(err, "failed decoding to 1252", utils_logus.FilePath(path))
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding to 1252: %w", err)
+		}
 
 		string_table[count] = string(windows_decoded) // to lower
 		count += len(table) + 1
@@ -89,10 +108,16 @@ func parse_file(path utils_types.FilePath, FoldValues FoldValues) []Section {
 	// return to end of header to read sections
 	var position int
 	pos, err := fh.Seek(12, SEEK_SET)
+	if err != nil {
+		return nil, err
+	}
 	position = int(pos)
 
 	for position < str_table_offset {
-		packed_values, offset := bin.Read2(fh, mem.GetBData(4), []string{"h", "h"})
+		packed_values, offset, err := bin.Read(fh, mem.GetBData(4), []string{"h", "h"})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bini section at position=%d: %w", position, err)
+		}
 		position += offset
 		section_name_ptr := packed_values[0].(int)
 		entry_count := packed_values[1].(int)
@@ -101,7 +126,10 @@ func parse_file(path utils_types.FilePath, FoldValues FoldValues) []Section {
 
 		var section []Row
 		for e := 0; e < entry_count; e++ {
-			packed_values, offset := bin.Read2(fh, mem.GetBData(3), []string{"h", "b"})
+			packed_values, offset, err := bin.Read(fh, mem.GetBData(3), []string{"h", "b"})
+			if err != nil {
+				return nil, fmt.Errorf("failed to read bini entry at position=%d: %w", position, err)
+			}
 			position += offset
 			entry_name_ptr := packed_values[0].(int)
 			value_count := packed_values[1].(int)
@@ -111,11 +139,22 @@ func parse_file(path utils_types.FilePath, FoldValues FoldValues) []Section {
 			row[EntryName(entry_name)] = make([]interface{}, 0, 10)
 
 			for v := 0; v < value_count; v++ {
-				packed_values, offset := bin.Read2(fh, mem.GetBData(1), []string{"b"})
+				packed_values, offset, err := bin.Read(fh, mem.GetBData(1), []string{"b"})
+				if err != nil {
+					return nil, fmt.Errorf("failed to read bini value type at position=%d: %w", position, err)
+				}
 				position += offset
 				value_type := packed_values[0].(int)
 
-				packed_values, offset = bin.Read2(fh, mem.GetBData(4), []string{VALUE_TYPES[value_type]})
+				value_format, ok := VALUE_TYPES[value_type]
+				if !ok {
+					return nil, fmt.Errorf("unknown bini value type=%d at position=%d", value_type, position)
+				}
+
+				packed_values, offset, err = bin.Read(fh, mem.GetBData(4), []string{value_format})
+				if err != nil {
+					return nil, fmt.Errorf("failed to read bini value at position=%d: %w", position, err)
+				}
 				position += offset
 
 				var value_data interface{}
@@ -125,11 +164,11 @@ func parse_file(path utils_types.FilePath, FoldValues FoldValues) []Section {
 				case 0:
 					//pass
 				default:
-					logus.Log.Panic("expected 1 or 0 packed values", typelog.Any("values", packed_values))
+					return nil, fmt.Errorf("expected 1 or 0 packed values, got %d", len(packed_values))
 				}
 
 				if value_type == 3 {
-					ptr := value_data.(int)
+					ptr, _ := value_data.(int)
 					value_data = string_table[ptr]
 				}
 
@@ -145,14 +184,45 @@ func parse_file(path utils_types.FilePath, FoldValues FoldValues) []Section {
 
 	}
 
-	return result
+	return result, nil
 }
 
 type FoldValues bool
 
+// Parse decodes BINI file into typed values: int, float32 and string
+func Parse(path utils_types.FilePath) []*Section {
+	parsed, err := parse_file(path, FoldValues(false))
+	logus.Log.CheckPanic(err, "failed to parse bini", utils_logus.FilePath(path))
+	return toSectionRefs(parsed)
+}
+
+// ParseBytes acts as Parse for content which is not located at os filesystem. Corrupted content is returned as error
+func ParseBytes(data []byte) ([]*Section, error) {
+	parsed, err := parse_data(data, FoldValues(false))
+	if err != nil {
+		return nil, err
+	}
+	return toSectionRefs(parsed), nil
+}
+
+func toSectionRefs(parsed []Section) []*Section {
+	sections := make([]*Section, 0, len(parsed))
+	for index := range parsed {
+		sections = append(sections, &parsed[index])
+	}
+	return sections
+}
+
+// Rows returns entries in order of file. Each row holds single entry
+func (s *Section) Rows() []Row { return s.rows }
+
 func Dump(path utils_types.FilePath) []string {
-	bini := parse_file(path, FoldValues(false))
+	parsed, err := parse_file(path, FoldValues(false))
+	logus.Log.CheckPanic(err, "failed to parse bini", utils_logus.FilePath(path))
+	return dumpSections(parsed)
+}
 
+func dumpSections(bini []Section) []string {
 	var lines []string = make([]string, 0, 100)
 
 	for _, section := range bini {
@@ -193,3 +263,7 @@ func IsBini(filepath utils_types.FilePath) bool {
 
 	return string(bytes) == "BINI"
 }
+
+func IsBiniBytes(data []byte) bool {
+	return len(data) >= 4 && string(data[:4]) == "BINI"
+}
@@ -0,0 +1,164 @@
+package bini
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+const (
+	value_type_int    = 1
+	value_type_float  = 2
+	value_type_string = 3
+
+	header_size = 12
+)
+
+func NewSection(section_name string) *Section {
+	return &Section{section_name: section_name}
+}
+
+func (s *Section) Name() string { return s.section_name }
+
+// AddRow accepts values of int, float32, float64 and string types
+func (s *Section) AddRow(entry_name EntryName, values ...interface{}) {
+	s.rows = append(s.rows, Row{entry_name: values})
+}
+
+/*
+stringTable deduplicates strings and remembers their offsets.
+Strings keep their original case, so round trip of BINI file is byte faithful.
+*/
+type stringTable struct {
+	data    bytes.Buffer
+	offsets map[string]int
+}
+
+func newStringTable() *stringTable {
+	return &stringTable{offsets: make(map[string]int)}
+}
+
+func (t *stringTable) Add(value string) (int, error) {
+	if offset, ok := t.offsets[value]; ok {
+		return offset, nil
+	}
+	encoded, err := charmap.Windows1252.NewEncoder().String(value)
+	if err != nil {
+		return 0, fmt.Errorf("string %q is not encodable to windows-1252: %w", value, err)
+	}
+	offset := t.data.Len()
+	t.data.WriteString(encoded)
+	t.data.WriteByte(0)
+	t.offsets[value] = offset
+	return offset, nil
+}
+
+var (
+	ErrStringTableOverflow = errors.New("bini string table does not fit 16 bit pointers")
+	ErrIntOverflow         = errors.New("bini int value does not fit 32 bits")
+)
+
+// AddName is for section and entry names, which are referenced by signed 16 bit pointers unlike values
+func (t *stringTable) AddName(value string) (int, error) {
+	offset, err := t.Add(value)
+	if err != nil {
+		return 0, err
+	}
+	if offset > math.MaxInt16 {
+		return 0, fmt.Errorf("%w: name %q", ErrStringTableOverflow, value)
+	}
+	return offset, nil
+}
+
+/*
+Encode produces BINI file content, which is reversible back with Dump.
+
+Layout is: header (magic, version, string table offset),
+sections of (name ptr, entry count), entries of (name ptr, value count),
+values of (type byte, 4 bytes of int32/float32/string ptr), and string table at the end.
+Pointers and counts are signed, same as they are decoded.
+*/
+func Encode(sections []*Section) ([]byte, error) {
+	table := newStringTable()
+	var body bytes.Buffer
+
+	pack := func(format []string, values ...interface{}) error {
+		packed, err := bp.Pack(format, values)
+		if err != nil {
+			return err
+		}
+		body.Write(packed)
+		return nil
+	}
+
+	for _, section := range sections {
+		section_ptr, err := table.AddName(section.section_name)
+		if err != nil {
+			return nil, err
+		}
+		if len(section.rows) > math.MaxInt16 {
+			return nil, fmt.Errorf("section [%s] has too many entries: %d", section.section_name, len(section.rows))
+		}
+		if err := pack([]string{"h", "h"}, section_ptr, len(section.rows)); err != nil {
+			return nil, err
+		}
+
+		for _, row := range section.rows {
+			for entry_name, values := range row {
+				entry_ptr, err := table.AddName(string(entry_name))
+				if err != nil {
+					return nil, err
+				}
+				if len(values) > math.MaxInt8 {
+					return nil, fmt.Errorf("entry %s in [%s] has too many values: %d", entry_name, section.section_name, len(values))
+				}
+				if err := pack([]string{"h", "b"}, entry_ptr, len(values)); err != nil {
+					return nil, err
+				}
+
+				for _, value := range values {
+					switch v := value.(type) {
+					case int:
+						if v < math.MinInt32 || v > math.MaxInt32 {
+							err = fmt.Errorf("%w: %d for entry %s in [%s]", ErrIntOverflow, v, entry_name, section.section_name)
+							break
+						}
+						err = pack([]string{"b", "i"}, value_type_int, v)
+					case float32:
+						err = pack([]string{"b", "f"}, value_type_float, v)
+					case float64:
+						err = pack([]string{"b", "f"}, value_type_float, float32(v))
+					case string:
+						var value_ptr int
+						value_ptr, err = table.Add(v)
+						if err == nil && value_ptr > math.MaxInt32 {
+							err = fmt.Errorf("string table offset=%d of %q does not fit 32 bits", value_ptr, v)
+						}
+						if err == nil {
+							err = pack([]string{"b", "i"}, value_type_string, value_ptr)
+						}
+					default:
+						err = fmt.Errorf("unsupported bini value type %T for entry %s in [%s]", value, entry_name, section.section_name)
+					}
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	header, err := bp.Pack([]string{"4s", "I", "I"}, []interface{}{"BINI", 1, header_size + body.Len()})
+	if err != nil {
+		return nil, err
+	}
+
+	var result bytes.Buffer
+	result.Write(header)
+	result.Write(body.Bytes())
+	result.Write(table.data.Bytes())
+	return result.Bytes(), nil
+}
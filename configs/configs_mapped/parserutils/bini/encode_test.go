@@ -0,0 +1,91 @@
+package bini
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/darklab8/go-utils/utils/utils_types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeDumpRoundTrip(t *testing.T) {
+	ship := NewSection("Ship")
+	ship.AddRow("nickname", "li_elite")
+	ship.AddRow("ids_name", 237033)
+	ship.AddRow("mass", float32(100.5))
+	ship.AddRow("hold_size", float32(50))
+	ship.AddRow("hp_type", "hp_gun_special_1", "HpWeapon01", "HpWeapon02")
+	ship.AddRow("empty")
+	good := NewSection("Good")
+	good.AddRow("nickname", "li_elite")
+	good.AddRow("price", -5)
+
+	data, err := Encode([]*Section{ship, good})
+	assert.Nil(t, err)
+
+	path := filepath.Join(t.TempDir(), "encoded.ini")
+	assert.Nil(t, os.WriteFile(path, data, 0644))
+	assert.True(t, IsBini(utils_types.FilePath(path)))
+
+	lines := Dump(utils_types.FilePath(path))
+	assert.Equal(t, []string{
+		"[Ship]",
+		"nickname = li_elite",
+		"ids_name = 237033",
+		"mass = 100.5",
+		"hold_size = 50",
+		"hp_type = hp_gun_special_1, HpWeapon01, HpWeapon02",
+		"empty = ",
+		"",
+		"[Good]",
+		"nickname = li_elite",
+		"price = -5",
+		"",
+	}, lines)
+}
+
+func TestEncodeUnsupportedValue(t *testing.T) {
+	section := NewSection("Ship")
+	section.AddRow("broken", true)
+
+	_, err := Encode([]*Section{section})
+	assert.NotNil(t, err)
+}
+
+func TestEncodeIntOverflow(t *testing.T) {
+	section := NewSection("Ship")
+	section.AddRow("ids_name", math.MaxInt32+1)
+
+	_, err := Encode([]*Section{section})
+	assert.ErrorIs(t, err, ErrIntOverflow)
+}
+
+func TestParseCorruptedBytes(t *testing.T) {
+	ship := NewSection("Ship")
+	ship.AddRow("nickname", "li_elite")
+	ship.AddRow("mass", float32(100.5))
+	data, err := Encode([]*Section{ship})
+	assert.Nil(t, err)
+
+	_, err = ParseBytes(data)
+	assert.Nil(t, err)
+
+	for name, corrupted := range map[string][]byte{
+		"truncated header":   data[:8],
+		"wrong version":      withByte(data, 4, 2),
+		"table out of file":  append(append([]byte{}, data[:8]...), 0xff, 0xff, 0x00, 0x00),
+		"too many entries":   withByte(data, 14, 0xff),
+		"unknown value type": append(append(append([]byte{}, data[:8]...), 20, 0, 0, 0), 0, 0, 1, 0, 0, 0, 9, 0, 0, 0, 0, 0),
+	} {
+		_, err := ParseBytes(corrupted)
+		assert.NotNil(t, err, name)
+	}
+}
+
+func withByte(data []byte, index int, value byte) []byte {
+	result := append([]byte{}, data...)
+	result[index] = value
+	return result
+}
@@ -1,15 +1,14 @@
 /*
 # File handling functions
 
-F in OpenToReadF stands for... Do succesfully, or log to Fatal level and exit
+Reading returns errors to caller.
+F in createToWriteF and writeF stands for... Do succesfully, or panic
 */
 package file
 
 import (
-	"bufio"
-	"fmt"
-	"io"
-	"net/http"
+	"bytes"
+	"io/fs"
 	"os"
 	"strings"
 
@@ -21,10 +20,6 @@ import (
 	"github.com/darklab8/go-utils/utils/utils_types"
 )
 
-type WebFile struct {
-	url string
-}
-
 type File struct {
 	filepath utils_types.FilePath
 	file     *os.File
@@ -34,6 +29,22 @@ type File struct {
 	IsFailback bool
 
 	webfile *WebFile
+
+	// When set, file is read from it instead of os filesystem. Such files are read only
+	fsys fs.FS
+
+	// File of lower mod layer, which this file is merged with section by section
+	lower *File
+
+	// Written lines are ended with it. Defaults to \n
+	LineEnding     string
+	NoFinalNewline bool
+
+	read_line_ending      string
+	read_no_final_newline bool
+
+	// When set, it is written instead of lines
+	binary []byte
 }
 
 func NewMemoryFile(lines []string) *File {
@@ -44,21 +55,55 @@ func NewFile(filepath utils_types.FilePath) *File {
 	return &File{filepath: filepath}
 }
 
-func NewWebFile(url string) *File {
-	return &File{webfile: &WebFile{
-		url: url,
-	}}
+// NewFileFS makes file located at fsys, filepath is slash separated path within it
+func NewFileFS(fsys fs.FS, filepath utils_types.FilePath) *File {
+	return &File{filepath: filepath, fsys: fsys}
 }
 
-func (f *File) GetFilepath() utils_types.FilePath { return f.filepath }
+// NewFileFrom makes not yet read file pointing to same location as given one
+func NewFileFrom(f *File) *File {
+	if f == nil {
+		return &File{}
+	}
+	result := &File{filepath: f.filepath, fsys: f.fsys, webfile: f.webfile}
+	if f.lower != nil {
+		result.lower = NewFileFrom(f.lower)
+	}
+	return result
+}
 
-func (f *File) openToReadF() *File {
+// MergeOver makes file to be read as section level merge on top of file from lower layer
+func (f *File) MergeOver(lower *File) {
+	f.lower = lower
+}
+
+// Lower returns file of lower layer if file is to be merged with it
+func (f *File) Lower() *File {
+	if f == nil {
+		return nil
+	}
+	return f.lower
+}
+
+func (f *File) GetFilepath() utils_types.FilePath {
+	if f == nil {
+		return ""
+	}
+	return f.filepath
+}
+
+func (f *File) readAll() ([]byte, error) {
 	logus.Log.Debug("opening file", utils_logus.FilePath(f.GetFilepath()))
-	file, err := os.Open(string(f.filepath))
-	f.file = file
+	var data []byte
+	var err error
+	if f.fsys != nil {
+		data, err = fs.ReadFile(f.fsys, string(f.filepath))
+	} else {
+		data, err = os.ReadFile(string(f.filepath))
+	}
 
-	logus.Log.CheckPanic(err, "failed to open ", utils_logus.FilePath(f.filepath))
-	return f
+	logus.Log.CheckError(err, "failed to open ", utils_logus.FilePath(f.filepath))
+	return data, err
 }
 
 func (f *File) close() {
@@ -74,44 +119,87 @@ func (f *File) ReadLines() ([]string, error) {
 	}
 
 	if f.webfile != nil {
-		res, err := http.Get(f.webfile.url)
+		data, err := f.webfile.fetch()
 		if err != nil {
-			logus.Log.Error("error making http request: %s\n", typelog.OptError(err))
 			return []string{}, err
 		}
+		return strings.Split(string(data), "\n"), nil
+	}
 
-		resBody, err := io.ReadAll(res.Body)
-		if err != nil {
-			logus.Log.Error("client: could not read response body: %s\n", typelog.OptError(err))
-			return []string{}, err
-		}
-		// fmt.Printf("client: response body: %s\n", resBody)
+	data, err := f.readAll()
+	if err != nil {
+		return []string{}, err
+	}
 
-		str := string(resBody)
-		return strings.Split(str, "\n"), nil
+	return f.splitLines(data), nil
+}
+
+/*
+ReadIni reads file content once. Binary ini is decoded directly into typed bini sections, skipping text representation.
+Text files are split into lines. Memory and web files are always returned as lines.
+*/
+func (f *File) ReadIni() (lines []string, bini_sections []*bini.Section, is_bini bool, err error) {
+	if len(f.lines) > 0 || f.webfile != nil {
+		lines, err = f.ReadLines()
+		return lines, nil, false, err
 	}
 
-	if bini.IsBini(f.filepath) {
-		bini_lines := bini.Dump(f.filepath)
-		return bini_lines, nil
+	data, err := f.readAll()
+	if err != nil {
+		return nil, nil, false, err
 	}
 
-	f.openToReadF()
-	defer f.close()
+	if bini.IsBiniBytes(data) {
+		bini_sections, err = bini.ParseBytes(data)
+		return nil, bini_sections, true, err
+	}
+	return f.splitLines(data), nil, false, nil
+}
 
-	scanner := bufio.NewScanner(f.file)
+// splitLines acts as bufio.ScanLines, but remembers line ending format of file
+func (f *File) splitLines(data []byte) []string {
+	f.read_line_ending = "\n"
+	if bytes.Contains(data, []byte("\r\n")) {
+		f.read_line_ending = "\r\n"
+	}
+	f.read_no_final_newline = len(data) > 0 && data[len(data)-1] != '\n'
+
+	lines := []string{}
+	if len(data) == 0 {
+		return lines
+	}
+	str := string(data)
+	if !f.read_no_final_newline {
+		str = str[:len(str)-1]
+	}
+	for _, line := range strings.Split(str, "\n") {
+		lines = append(lines, strings.TrimSuffix(line, "\r"))
+	}
+	return lines
+}
 
-	bufio_lines := []string{}
-	for scanner.Scan() {
-		bufio_lines = append(bufio_lines, scanner.Text())
+// GetLineEnding returns line ending format met during last ReadLines
+func (f *File) GetLineEnding() (line_ending string, no_final_newline bool) {
+	if f.read_line_ending == "" {
+		return "\n", false
 	}
-	return bufio_lines, nil
+	return f.read_line_ending, f.read_no_final_newline
 }
 
 func (f *File) ScheduleToWrite(value ...string) {
 	f.lines = append(f.lines, value...)
 }
 
+// ScheduledLines returns lines which are going to be written by WriteLines
+func (f *File) ScheduledLines() []string {
+	return f.lines
+}
+
+// ScheduleToWriteBinary overrides scheduled lines with raw content
+func (f *File) ScheduleToWriteBinary(data []byte) {
+	f.binary = data
+}
+
 func (f *File) WriteLines() {
 	if f.IsFailback {
 		// This feature is not working in full capacity for some reason ;) not getting skipped for some reason
@@ -120,12 +208,32 @@ func (f *File) WriteLines() {
 		)
 		return
 	}
+	if f.fsys != nil {
+		logus.Log.Warn("file is taken from read only filesystem, writing is skipped",
+			typelog.Any("filename", f.filepath.ToString()),
+		)
+		return
+	}
 
 	f.createToWriteF()
 	defer f.close()
 
-	for _, line := range f.lines {
-		f.writelnF(line)
+	if f.binary != nil {
+		_, err := f.file.Write(f.binary)
+		logus.Log.CheckPanic(err, "failed to write binary to file")
+		return
+	}
+
+	line_ending := f.LineEnding
+	if line_ending == "" {
+		line_ending = "\n"
+	}
+	for index, line := range f.lines {
+		if f.NoFinalNewline && index == len(f.lines)-1 {
+			f.writeF(line)
+			continue
+		}
+		f.writeF(line + line_ending)
 	}
 }
 
@@ -136,8 +244,8 @@ func (f *File) createToWriteF() *File {
 
 	return f
 }
-func (f *File) writelnF(msg string) {
-	_, err := f.file.WriteString(fmt.Sprintf("%v\n", msg))
+func (f *File) writeF(msg string) {
+	_, err := f.file.WriteString(msg)
 
 	logus.Log.CheckPanic(err, "failed to write string to file")
 }
@@ -0,0 +1,170 @@
+package file
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
+	"github.com/darklab8/go-typelog/typelog"
+	"github.com/darklab8/go-utils/utils/utils_types"
+)
+
+const DefaultWebTimeout = 30 * time.Second
+
+type WebFile struct {
+	url       string
+	timeout   time.Duration
+	cache_dir utils_types.FilePath
+
+	meta *WebMeta
+}
+
+// WebMeta is stored next to cached copy of web file
+type WebMeta struct {
+	Url       string    `json:"url"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Size      int       `json:"size"`
+
+	FromCache bool `json:"-"` // web file was not reachable and cached copy was used
+}
+
+type WebOption func(w *WebFile)
+
+func WithTimeout(timeout time.Duration) WebOption {
+	return func(w *WebFile) { w.timeout = timeout }
+}
+
+// WithCache saves successful downloads to folder and reuses them when source is not reachable
+func WithCache(folder utils_types.FilePath) WebOption {
+	return func(w *WebFile) { w.cache_dir = folder }
+}
+
+/*
+NewWebFile reads file from http(s) url.
+Local file path or file:// url is accepted as well, for offline stand-ins of web sources.
+*/
+func NewWebFile(url string, opts ...WebOption) *File {
+	webfile := &WebFile{
+		url:     url,
+		timeout: DefaultWebTimeout,
+	}
+	for _, opt := range opts {
+		opt(webfile)
+	}
+	return &File{webfile: webfile}
+}
+
+// WebMeta returns where content of web file came from after it was read. Nil for not web files
+func (f *File) WebMeta() *WebMeta {
+	if f.webfile == nil {
+		return nil
+	}
+	return f.webfile.meta
+}
+
+func (w *WebFile) isRemote() bool {
+	return strings.HasPrefix(w.url, "http://") || strings.HasPrefix(w.url, "https://")
+}
+
+// cache key keeps url readable for humans, and hash makes it unique for same named files from different sources
+func (w *WebFile) cachePath() utils_types.FilePath {
+	hash := sha1.Sum([]byte(w.url))
+	name := path.Base(strings.SplitN(w.url, "?", 2)[0])
+	return w.cache_dir.Join(fmt.Sprintf("%s-%s", name, hex.EncodeToString(hash[:4])))
+}
+
+func (w *WebFile) download() ([]byte, error) {
+	client := &http.Client{Timeout: w.timeout}
+	res, err := client.Get(w.url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status %s for %s", res.Status, w.url)
+	}
+	return io.ReadAll(res.Body)
+}
+
+func (w *WebFile) saveCache(data []byte, meta *WebMeta) error {
+	if err := os.MkdirAll(w.cache_dir.ToString(), 0755); err != nil {
+		return err
+	}
+	cache_path := w.cachePath().ToString()
+	meta_data, _ := json.MarshalIndent(meta, "", "  ")
+
+	// written through temporary file, so interrupted write does not corrupt previous copy
+	for target, content := range map[string][]byte{cache_path: data, cache_path + ".meta.json": meta_data} {
+		tmp := target + ".tmp"
+		if err := os.WriteFile(tmp, content, 0644); err != nil {
+			return err
+		}
+		if err := os.Rename(tmp, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *WebFile) loadCache() ([]byte, *WebMeta, error) {
+	cache_path := w.cachePath().ToString()
+	data, err := os.ReadFile(cache_path)
+	if err != nil {
+		return nil, nil, err
+	}
+	meta := &WebMeta{Url: w.url}
+	if meta_data, err := os.ReadFile(cache_path + ".meta.json"); err == nil {
+		json.Unmarshal(meta_data, meta)
+	}
+	meta.FromCache = true
+	return data, meta, nil
+}
+
+func (w *WebFile) fetch() ([]byte, error) {
+	if !w.isRemote() {
+		local_path := strings.TrimPrefix(w.url, "file://")
+		data, err := os.ReadFile(filepath.FromSlash(local_path))
+		if err == nil {
+			w.meta = &WebMeta{Url: w.url, Size: len(data)}
+		}
+		return data, err
+	}
+
+	data, err := w.download()
+	if err == nil {
+		w.meta = &WebMeta{Url: w.url, FetchedAt: time.Now().UTC(), Size: len(data)}
+		if w.cache_dir != "" {
+			logus.Log.CheckWarn(w.saveCache(data, w.meta), "failed to cache web file", typelog.String("url", w.url))
+		}
+		return data, nil
+	}
+
+	if w.cache_dir == "" {
+		logus.Log.Error("failed to fetch web file, and cache is not configured", typelog.String("url", w.url), typelog.OptError(err))
+		return nil, err
+	}
+	cached, meta, cache_err := w.loadCache()
+	if cache_err != nil {
+		logus.Log.Error("failed to fetch web file, and it has no cached copy",
+			typelog.String("url", w.url),
+			typelog.OptError(err),
+		)
+		return nil, err
+	}
+	logus.Log.Warn("failed to fetch web file, using cached copy",
+		typelog.String("url", w.url),
+		typelog.String("fetched_at", meta.FetchedAt.Format(time.RFC3339)),
+		typelog.OptError(err),
+	)
+	w.meta = meta
+	return cached, nil
+}
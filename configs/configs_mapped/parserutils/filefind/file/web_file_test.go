@@ -0,0 +1,60 @@
+package file
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/darklab8/go-utils/utils/utils_types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebFileCache(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing.cfg" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("[Tech]\nname = li_guns"))
+	}))
+	cache := utils_types.FilePath(t.TempDir())
+	url := server.URL + "/techcompat.cfg"
+
+	lines, err := NewWebFile(url, WithCache(cache)).ReadLines()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"[Tech]", "name = li_guns"}, lines)
+
+	_, err = NewWebFile(server.URL+"/missing.cfg", WithCache(cache)).ReadBytes()
+	assert.Error(t, err)
+
+	server.Close()
+
+	offline := NewWebFile(url, WithCache(cache), WithTimeout(time.Second))
+	data, err := offline.ReadBytes()
+	assert.Nil(t, err)
+	assert.Equal(t, "[Tech]\nname = li_guns", string(data))
+	if assert.NotNil(t, offline.WebMeta()) {
+		assert.True(t, offline.WebMeta().FromCache)
+		assert.Equal(t, url, offline.WebMeta().Url)
+		assert.False(t, offline.WebMeta().FetchedAt.IsZero())
+	}
+
+	_, err = NewWebFile(url, WithTimeout(time.Second)).ReadBytes()
+	assert.Error(t, err, "without cache offline source is an error")
+}
+
+func TestWebFileLocalPath(t *testing.T) {
+	local := filepath.Join(t.TempDir(), "prices.cfg")
+	os.WriteFile(local, []byte("[Price]"), 0644)
+
+	data, err := NewWebFile(local).ReadBytes()
+	assert.Nil(t, err)
+	assert.Equal(t, "[Price]", string(data))
+
+	data, err = NewWebFile("file://" + filepath.ToSlash(local)).ReadBytes()
+	assert.Nil(t, err)
+	assert.Equal(t, "[Price]", string(data))
+}
@@ -4,7 +4,9 @@ Package with reusable code for discovery of files and other reusable stuff like
 package filefind
 
 import (
-	"fmt"
+	"archive/zip"
+	"errors"
+	"io"
 	"io/fs"
 	"path/filepath"
 	"strings"
@@ -21,10 +23,98 @@ import (
 type Filesystem struct {
 	Files   []*file.File
 	Hashmap map[utils_types.FilePath]*file.File
+
+	// opened archives, which files are read from lazily
+	closers []io.Closer
+}
+
+// Close releases opened archives. Files of filesystem can not be read after it
+func (filesystem *Filesystem) Close() error {
+	if filesystem == nil {
+		return nil
+	}
+	var errs []error
+	for _, closer := range filesystem.closers {
+		errs = append(errs, closer.Close())
+	}
+	filesystem.closers = nil
+	return errors.Join(errs...)
 }
 
 var FreelancerFolder Filesystem
 
+// isConfigPath filters out files which are not of interest for configs parsing
+func isConfigPath(path string) bool {
+	// Disco dev files
+	if strings.Contains(path, "SERVICE") {
+		return false
+	}
+	// https://github.com/darklab8/fl-darkstat/issues/107
+	if strings.Contains(strings.ToLower(path), "flmmbak") {
+		return false
+	}
+
+	if !strings.Contains(path, ".ini") &&
+		!strings.Contains(path, ".txt") &&
+		!strings.Contains(path, ".cfg") &&
+		!strings.Contains(path, ".xml") &&
+		!strings.Contains(path, ".dll") &&
+		!strings.Contains(path, ".yml") &&
+		!strings.Contains(path, ".json") {
+		return false
+	}
+	return true
+}
+
+func (filesystem *Filesystem) add(file *file.File) {
+	filesystem.Files = append(filesystem.Files, file)
+
+	key := utils_types.FilePath(strings.ToLower(filepath.Base(file.GetFilepath().ToString())))
+	filesystem.Hashmap[key] = file
+}
+
+func IsArchive(path utils_types.FilePath) bool {
+	return strings.HasSuffix(strings.ToLower(path.ToString()), ".zip")
+}
+
+/*
+OpenArchive opens zip of mod release as filesystem.
+Archive stays open until Filesystem found in it is closed, as files are read from it lazily.
+Files are searched by their names, so it does not matter if archive has game folder at its root or nested.
+*/
+func OpenArchive(path utils_types.FilePath) (*zip.ReadCloser, error) {
+	return zip.OpenReader(path.ToString())
+}
+
+/*
+FindConfigsFS discovers configs in any filesystem, like zip archive, embedded test fixture or in memory overlay.
+Failback folder is not applied to it.
+*/
+func FindConfigsFS(fsys fs.FS) *Filesystem {
+	var filesystem *Filesystem = &Filesystem{}
+	filesystem.Hashmap = make(map[utils_types.FilePath]*file.File)
+	filesystem.addFS(fsys)
+	return filesystem
+}
+
+func (filesystem *Filesystem) addFS(fsys fs.FS) {
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		logus.Log.CheckPanic(err, "unable to read file")
+		if d.IsDir() || !isConfigPath(path) {
+			return nil
+		}
+
+		filesystem.add(file.NewFileFS(fsys, utils_types.FilePath(path)))
+		return nil
+	})
+
+	logus.Log.CheckPanic(err, "unable to read files")
+}
+
+/*
+FindConfigs discovers configs in game folder.
+Path to zip archive of mod release is accepted as well.
+*/
 func FindConfigs(folderpath utils_types.FilePath) *Filesystem {
 	var filesystem *Filesystem = &Filesystem{}
 	filesystem.Hashmap = make(map[utils_types.FilePath]*file.File)
@@ -33,6 +123,7 @@ func FindConfigs(folderpath utils_types.FilePath) *Filesystem {
 		fs := FindConfigs(configs_settings.Env.FreelancerFolderFailback)
 		filesystem.Hashmap = fs.Hashmap
 		filesystem.Files = fs.Files
+		filesystem.closers = fs.closers
 		for _, file := range filesystem.Files {
 			file.IsFailback = true
 		}
@@ -41,41 +132,32 @@ func FindConfigs(folderpath utils_types.FilePath) *Filesystem {
 		}
 	}
 
-	err := filepath.WalkDir(string(folderpath), func(path string, d fs.DirEntry, err error) error {
+	if IsArchive(folderpath) {
+		archive, err := OpenArchive(folderpath)
+		logus.Log.CheckPanic(err, "unable to open archive", utils_logus.FilePath(folderpath))
+		filesystem.closers = append(filesystem.closers, archive)
+		filesystem.addFS(archive)
+		return filesystem
+	}
 
-		// Disco dev files
-		if strings.Contains(path, "SERVICE") {
-			return nil
-		}
-		// https://github.com/darklab8/fl-darkstat/issues/107
-		if strings.Contains(strings.ToLower(path), "flmmbak") {
-			fmt.Println()
-			return nil
-		}
+	filesystem.addFolder(folderpath)
+	return filesystem
+}
 
-		if !strings.Contains(path, ".ini") &&
-			!strings.Contains(path, ".txt") &&
-			!strings.Contains(path, ".cfg") &&
-			!strings.Contains(path, ".xml") &&
-			!strings.Contains(path, ".dll") &&
-			!strings.Contains(path, ".yml") &&
-			!strings.Contains(path, ".json") {
+// addFolder adds files of os folder by their os paths, so they stay writable
+func (filesystem *Filesystem) addFolder(folderpath utils_types.FilePath) {
+	err := filepath.WalkDir(string(folderpath), func(path string, d fs.DirEntry, err error) error {
+		if !isConfigPath(path) {
 			return nil
 		}
 
 		logus.Log.CheckPanic(err, "unable to read file")
 
-		file := file.NewFile(utils_types.FilePath(path))
-		filesystem.Files = append(filesystem.Files, file)
-
-		key := utils_types.FilePath(strings.ToLower(filepath.Base(path)))
-		filesystem.Hashmap[key] = file
-
+		filesystem.add(file.NewFile(utils_types.FilePath(path)))
 		return nil
 	})
 
 	logus.Log.CheckPanic(err, "unable to read files")
-	return filesystem
 }
 
 func (file1system Filesystem) GetFile(file1names ...utils_types.FilePath) *file.File {
@@ -86,10 +168,79 @@ func (file1system Filesystem) GetFile(file1names ...utils_types.FilePath) *file.
 			continue
 		}
 		logus.Log.Info("Filesystem.GetFile, found filepath=", utils_logus.FilePath(file_.GetFilepath()))
-		result_file := file.NewFile(file_.GetFilepath())
+		result_file := file.NewFileFrom(file_)
 		return result_file
 	}
 
 	logus.Log.Warn("failed to get file", typelog.Items[utils_types.FilePath]("filenames", file1names))
 	return nil
 }
+
+type MergeMode int
+
+const (
+	// Files of upper layer fully replace files with same name from lower layers
+	MergeFiles MergeMode = iota
+	// Ini files of upper layer are merged with lower ones section by section, keyed by nickname.
+	// Sections without nickname replace lower ones of same type
+	MergeSections
+)
+
+func isMergeableIni(path utils_types.FilePath) bool {
+	return strings.HasSuffix(strings.ToLower(path.ToString()), ".ini")
+}
+
+// Overlay applies files of layer on top of current filesystem, same way as FLMM applies mods
+func (filesystem *Filesystem) Overlay(layer *Filesystem, mode MergeMode) {
+	filesystem.closers = append(filesystem.closers, layer.closers...)
+	for _, layer_file := range layer.Files {
+		key := utils_types.FilePath(strings.ToLower(filepath.Base(layer_file.GetFilepath().ToString())))
+		existing, ok := filesystem.Hashmap[key]
+		if !ok {
+			filesystem.add(layer_file)
+			continue
+		}
+
+		if mode == MergeSections && isMergeableIni(key) {
+			layer_file.MergeOver(existing)
+		}
+		for index, file := range filesystem.Files {
+			if file == existing {
+				filesystem.Files[index] = layer_file
+			}
+		}
+		filesystem.Hashmap[key] = layer_file
+	}
+}
+
+/*
+FindConfigsLayers discovers configs of base install with mods applied on top of it.
+Each layer is folder or archive, later layers override earlier ones.
+*/
+func FindConfigsLayers(mode MergeMode, layers ...utils_types.FilePath) *Filesystem {
+	var filesystem *Filesystem = &Filesystem{}
+	filesystem.Hashmap = make(map[utils_types.FilePath]*file.File)
+	for index, layer := range layers {
+		logus.Log.Info("applying config layer", typelog.Int("index", index), utils_logus.FilePath(layer))
+		if index == 0 {
+			filesystem = FindConfigs(layer)
+			continue
+		}
+		filesystem.Overlay(findLayer(layer), mode)
+	}
+	return filesystem
+}
+
+// findLayer acts as FindConfigs, except failback folder is not applied to mod layers
+func findLayer(layerpath utils_types.FilePath) *Filesystem {
+	if IsArchive(layerpath) {
+		archive, err := OpenArchive(layerpath)
+		logus.Log.CheckPanic(err, "unable to open archive", utils_logus.FilePath(layerpath))
+		layer := FindConfigsFS(archive)
+		layer.closers = append(layer.closers, archive)
+		return layer
+	}
+	layer := &Filesystem{Hashmap: make(map[utils_types.FilePath]*file.File)}
+	layer.addFolder(layerpath)
+	return layer
+}
@@ -0,0 +1,101 @@
+package filefind
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/bini"
+	"github.com/darklab8/go-utils/utils/utils_types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindConfigsFS(t *testing.T) {
+	ship := bini.NewSection("Ship")
+	ship.AddRow("nickname", "li_elite")
+	ship_data, err := bini.Encode([]*bini.Section{ship})
+	assert.Nil(t, err)
+
+	fsys := fstest.MapFS{
+		"DATA/UNIVERSE/universe.ini": {Data: []byte("[System]\nnickname = li01\n")},
+		"DATA/SHIPS/shiparch.ini":    {Data: ship_data},
+		"DATA/SERVICE/dev.ini":       {Data: []byte("[Dev]\n")},
+		"readme.md":                  {Data: []byte("# mod\n")},
+	}
+
+	filesystem := FindConfigsFS(fsys)
+	assert.Len(t, filesystem.Files, 2)
+
+	universe := filesystem.GetFile("universe.ini")
+	if assert.NotNil(t, universe) {
+		lines, err := universe.ReadLines()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"[System]", "nickname = li01"}, lines)
+	}
+
+	shiparch := filesystem.GetFile("shiparch.ini")
+	if assert.NotNil(t, shiparch) {
+		lines, sections, is_bini, err := shiparch.ReadIni()
+		assert.Nil(t, err)
+		assert.True(t, is_bini)
+		assert.Len(t, lines, 0)
+		assert.Len(t, sections, 1)
+	}
+}
+
+func TestFindConfigsArchive(t *testing.T) {
+	archive_path := filepath.Join(t.TempDir(), "mod.zip")
+	archive, err := os.Create(archive_path)
+	assert.Nil(t, err)
+
+	writer := zip.NewWriter(archive)
+	entry, err := writer.Create("Freelancer/DATA/UNIVERSE/universe.ini")
+	assert.Nil(t, err)
+	_, err = entry.Write([]byte("[System]\r\nnickname = li01\r\n"))
+	assert.Nil(t, err)
+	assert.Nil(t, writer.Close())
+	assert.Nil(t, archive.Close())
+
+	filesystem := FindConfigs(utils_types.FilePath(archive_path))
+	universe := filesystem.GetFile("universe.ini")
+	if assert.NotNil(t, universe) {
+		lines, err := universe.ReadLines()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"[System]", "nickname = li01"}, lines)
+		line_ending, _ := universe.GetLineEnding()
+		assert.Equal(t, "\r\n", line_ending)
+	}
+
+	// archive is released together with filesystem found in it
+	assert.Nil(t, filesystem.Close())
+	_, err = filesystem.GetFile("universe.ini").ReadLines()
+	assert.NotNil(t, err)
+	assert.Nil(t, filesystem.Close(), "closing twice is harmless")
+}
+
+func TestFindConfigsLayers(t *testing.T) {
+	base_folder := t.TempDir()
+	mod_folder := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(base_folder, "universe.ini"), []byte("[System]\n"), 0644))
+	assert.Nil(t, os.WriteFile(filepath.Join(base_folder, "goods.ini"), []byte("[Good]\n"), 0644))
+	assert.Nil(t, os.MkdirAll(filepath.Join(mod_folder, "DATA"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(mod_folder, "DATA", "Goods.ini"), []byte("[Good]\nnickname = mod\n"), 0644))
+
+	filesystem := FindConfigsLayers(MergeFiles, utils_types.FilePath(base_folder), utils_types.FilePath(mod_folder))
+	assert.Len(t, filesystem.Files, 2)
+
+	lines, err := filesystem.GetFile("goods.ini").ReadLines()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"[Good]", "nickname = mod"}, lines)
+	assert.Nil(t, filesystem.GetFile("goods.ini").Lower())
+
+	// files of folder layers stay writable
+	goods := filesystem.GetFile("goods.ini")
+	goods.ScheduleToWrite("[Good]", "nickname = written")
+	goods.WriteLines()
+	written, err := os.ReadFile(filepath.Join(mod_folder, "DATA", "Goods.ini"))
+	assert.Nil(t, err)
+	assert.Equal(t, "[Good]\nnickname = written\n", string(written))
+}
@@ -0,0 +1,111 @@
+package filefind
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
+	"github.com/darklab8/go-typelog/typelog"
+	"github.com/darklab8/go-utils/utils/utils_types"
+)
+
+type fileState struct {
+	mod_time time.Time
+	size     int64
+}
+
+/*
+Watcher finds changed configs by polling modification times,
+as game folders are often mounted volumes, which do not deliver file system events.
+Archives are not watched.
+*/
+type Watcher struct {
+	folders  []utils_types.FilePath
+	interval time.Duration
+	state    map[utils_types.FilePath]fileState
+}
+
+func NewWatcher(interval time.Duration, folders ...utils_types.FilePath) *Watcher {
+	w := &Watcher{interval: interval}
+	for _, folder := range folders {
+		if !IsArchive(folder) {
+			w.folders = append(w.folders, folder)
+		}
+	}
+	w.state = w.snapshot()
+	return w
+}
+
+func (w *Watcher) snapshot() map[utils_types.FilePath]fileState {
+	state := make(map[utils_types.FilePath]fileState)
+	for _, folder := range w.folders {
+		filepath.WalkDir(folder.ToString(), func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || !isConfigPath(path) {
+				return nil
+			}
+			if info, err := d.Info(); err == nil {
+				state[utils_types.FilePath(path)] = fileState{mod_time: info.ModTime(), size: info.Size()}
+			}
+			return nil
+		})
+	}
+	return state
+}
+
+// Poll returns files changed, created or removed since previous poll
+func (w *Watcher) Poll() []utils_types.FilePath {
+	state := w.snapshot()
+	var changed []utils_types.FilePath
+	for path, current := range state {
+		if previous, ok := w.state[path]; !ok || previous != current {
+			changed = append(changed, path)
+		}
+	}
+	for path := range w.state {
+		if _, ok := state[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	w.state = state
+	sort.Slice(changed, func(i, j int) bool { return changed[i] < changed[j] })
+	return changed
+}
+
+/*
+Run calls on_change with changed files until context is done.
+Mod tools write many files at once, so changes are collected until folder stays quiet for one interval.
+*/
+func (w *Watcher) Run(ctx context.Context, on_change func(changed []utils_types.FilePath)) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	pending := make(map[utils_types.FilePath]bool)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		changed := w.Poll()
+		for _, path := range changed {
+			pending[path] = true
+		}
+		if len(changed) > 0 || len(pending) == 0 {
+			continue
+		}
+
+		result := make([]utils_types.FilePath, 0, len(pending))
+		for path := range pending {
+			result = append(result, path)
+		}
+		sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+		pending = make(map[utils_types.FilePath]bool)
+
+		logus.Log.Info("configs changed", typelog.Int("files", len(result)))
+		on_change(result)
+	}
+}
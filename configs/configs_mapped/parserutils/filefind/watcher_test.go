@@ -0,0 +1,60 @@
+package filefind
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/darklab8/go-utils/utils/utils_types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcherPoll(t *testing.T) {
+	folder := t.TempDir()
+	goods := filepath.Join(folder, "goods.ini")
+	market := filepath.Join(folder, "market.ini")
+	assert.Nil(t, os.WriteFile(goods, []byte("[Good]\n"), 0644))
+	assert.Nil(t, os.WriteFile(market, []byte("[BaseGood]\n"), 0644))
+
+	watcher := NewWatcher(time.Millisecond, utils_types.FilePath(folder))
+	assert.Len(t, watcher.Poll(), 0)
+
+	assert.Nil(t, os.WriteFile(goods, []byte("[Good]\nnickname = gold\n"), 0644))
+	assert.Nil(t, os.Remove(market))
+	assert.Nil(t, os.WriteFile(filepath.Join(folder, "readme.md"), []byte("# mod\n"), 0644))
+	systems := filepath.Join(folder, "li01.ini")
+	assert.Nil(t, os.WriteFile(systems, []byte("[Object]\n"), 0644))
+
+	assert.Equal(t, []utils_types.FilePath{
+		utils_types.FilePath(goods),
+		utils_types.FilePath(systems),
+		utils_types.FilePath(market),
+	}, watcher.Poll())
+	assert.Len(t, watcher.Poll(), 0)
+}
+
+func TestWatcherRun(t *testing.T) {
+	folder := t.TempDir()
+	goods := filepath.Join(folder, "goods.ini")
+	assert.Nil(t, os.WriteFile(goods, []byte("[Good]\n"), 0644))
+
+	watcher := NewWatcher(10*time.Millisecond, utils_types.FilePath(folder))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result := make(chan []utils_types.FilePath, 1)
+	go watcher.Run(ctx, func(changed []utils_types.FilePath) {
+		result <- changed
+		cancel()
+	})
+	assert.Nil(t, os.WriteFile(goods, []byte("[Good]\nnickname = gold\n"), 0644))
+
+	select {
+	case changed := <-result:
+		assert.Equal(t, []utils_types.FilePath{utils_types.FilePath(goods)}, changed)
+	case <-ctx.Done():
+		t.Fatal("change was not noticed")
+	}
+}
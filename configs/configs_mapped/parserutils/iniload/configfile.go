@@ -25,7 +25,7 @@ func (fileconfig *IniLoader) Scan() *IniLoader {
 	}
 
 	iniconfig := inireader.Read(fileconfig.input_file)
-	fileconfig.Init(iniconfig.Sections, iniconfig.Comments, iniconfig.File.GetFilepath())
+	fileconfig.Init(iniconfig)
 	fileconfig.INIFile = iniconfig
 	return fileconfig
 }
@@ -0,0 +1,113 @@
+package inireader
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/bini"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader/inireader_types"
+)
+
+/*
+ToBini encodes config into binary ini format.
+Numbers without fractional part become BINI integers, the rest become floats.
+Comments have no place in BINI and are dropped.
+*/
+func (config *INIFile) ToBini() ([]byte, error) {
+	var sections []*bini.Section
+	for _, section := range config.Sections {
+		section_name := strings.TrimSuffix(strings.TrimPrefix(string(section.OriginalType), "["), "]")
+		bini_section := bini.NewSection(section_name)
+
+		for _, param := range section.Params {
+			if param.IsParamAsComment {
+				continue
+			}
+
+			values := make([]interface{}, 0, len(param.Values))
+			for _, value := range param.Values {
+				switch v := value.(type) {
+				case ValueNumber:
+					if v.Precision == 0 {
+						values = append(values, int(v.Value))
+					} else {
+						values = append(values, float32(v.Value))
+					}
+				case ValueString:
+					values = append(values, string(v))
+				case ValueBool:
+					values = append(values, v.AsString())
+				default:
+					return nil, fmt.Errorf("unsupported value %T in %s %s", value, section.OriginalType, param.Key)
+				}
+			}
+			// key = with nothing after it is read as single empty string
+			if len(values) == 1 && values[0] == "" {
+				values = values[:0]
+			}
+			bini_section.AddRow(bini.EntryName(param.GetOriginalKey()), values...)
+		}
+		sections = append(sections, bini_section)
+	}
+	return bini.Encode(sections)
+}
+
+/*
+readBini fills config from decoded bini sections directly, without formatting them to text lines and parsing back.
+Ints, floats and strings keep their BINI types, even if string looks like a number.
+*/
+func (config *INIFile) readBini(bini_sections []*bini.Section) {
+	config.IsBini = true
+	config.LineEnding = "\n"
+
+	for _, bini_section := range bini_sections {
+		section := &Section{INIFile: config}
+		section.OriginalType = inireader_types.IniHeader(fmt.Sprintf("[%s]", bini_section.Name()))
+		section.Type = inireader_types.IniHeader(strings.ToLower(string(section.OriginalType)))
+		config.AddSection(section.Type, section)
+
+		for _, row := range bini_section.Rows() {
+			for entry_name, entry_values := range row {
+				key := string(entry_name)
+				if !isKeyCaseSensetive(key) {
+					key = strings.ToLower(key)
+				}
+
+				values := make([]UniValue, 0, len(entry_values))
+				for _, entry_value := range entry_values {
+					values = append(values, biniToUniValue(entry_value))
+				}
+				if len(values) == 0 {
+					values = append(values, ValueString(""))
+				}
+
+				param := &Param{Key: cfg.Key(key), First: values[0], Values: values}
+				if key != string(entry_name) {
+					param.OriginalKey = string(entry_name)
+				}
+				section.AddParam(cfg.Key(key), param)
+			}
+		}
+	}
+}
+
+func biniToUniValue(value interface{}) UniValue {
+	switch v := value.(type) {
+	case int:
+		return ValueNumber{Value: float64(v), Precision: 0}
+	case float32:
+		// Shortest representation restores float32 to value it was typed as, instead of 0.100000001490116
+		formatted := strconv.FormatFloat(float64(v), 'f', -1, 32)
+		parsed, _ := strconv.ParseFloat(formatted, 64)
+		precision := 1
+		if split := strings.Split(formatted, "."); len(split) == 2 {
+			precision = len(split[1])
+		}
+		return ValueNumber{Value: parsed, Precision: precision}
+	case string:
+		return ValueString(v)
+	}
+	return ValueString(fmt.Sprintf("%v", value))
+}
@@ -0,0 +1,64 @@
+package inireader
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader/inireader_types"
+	"github.com/darklab8/go-utils/utils/utils_types"
+)
+
+type DiagnosticKind string
+
+const (
+	// File could not be found or opened at all
+	DiagReadFailed DiagnosticKind = "read_failed"
+	// key = value line met before any [Section] header
+	DiagParamOutsideSection DiagnosticKind = "param_outside_section"
+	// value looked like a number, but could not be parsed as one. It is kept as string
+	DiagNumberAsString DiagnosticKind = "number_as_string"
+	// line is not a section, not a param and not a comment
+	DiagUnrecognizedLine DiagnosticKind = "unrecognized_line"
+	// param was requested as integer but holds something else
+	DiagInvalidInt DiagnosticKind = "invalid_int"
+)
+
+/*
+Diagnostic is non fatal problem met during reading of ini file.
+Reader skips broken parts and continues, so the rest of data stays usable.
+*/
+type Diagnostic struct {
+	Filepath utils_types.FilePath      `json:"filepath"`
+	Line     int                       `json:"line"` // starts from 1. 0 if problem is not bound to specific line
+	Section  inireader_types.IniHeader `json:"section"`
+	Raw      string                    `json:"raw"`
+	Kind     DiagnosticKind            `json:"kind"`
+	Message  string                    `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	location := d.Filepath.ToString()
+	if d.Line > 0 {
+		location = fmt.Sprintf("%s:%d", location, d.Line)
+	}
+	if d.Section != "" {
+		location = fmt.Sprintf("%s %s", location, d.Section)
+	}
+	result := fmt.Sprintf("%s: %s: %s", location, d.Kind, d.Message)
+	if d.Raw != "" {
+		result = fmt.Sprintf("%s (line=%q)", result, d.Raw)
+	}
+	return result
+}
+
+type diagnostics struct {
+	Diagnostics []Diagnostic
+	diag_mu     sync.Mutex
+}
+
+// AddDiagnostic is safe to call from different goroutines reading sections of same file
+func (d *diagnostics) AddDiagnostic(diag Diagnostic) {
+	d.diag_mu.Lock()
+	defer d.diag_mu.Unlock()
+	d.Diagnostics = append(d.Diagnostics, diag)
+}
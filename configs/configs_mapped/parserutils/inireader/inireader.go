@@ -22,11 +22,21 @@ type INIFile struct {
 	File     *file.File
 	Comments []string
 
+	// Raw lines before first section, used for lossless writing
+	Preamble []string
+	// Line ending format of original file, used for lossless writing
+	LineEnding     string
+	NoFinalNewline bool
+	// File was read from binary ini format and is written back in it
+	IsBini bool
+
 	Sections []*Section
 
 	// denormalization
 	SectionMap       map[inireader_types.IniHeader][]*Section
 	SectionMapByNick map[string]*Section
+
+	diagnostics
 }
 
 func (config *INIFile) AddSection(key inireader_types.IniHeader, section *Section) {
@@ -55,6 +65,9 @@ type Section struct {
 
 	INIFile *INIFile
 	Comment string
+
+	raw          string   // original header line
+	raw_trailing []string // not parsable lines after last param
 }
 
 func (s Section) ToString(with_comments WithComments) string {
@@ -101,6 +114,26 @@ func (section *Section) AddParamToStart(key cfg.ParamKey, param *Param) {
 	section.ParamMap[key] = append(section.ParamMap[key], param)
 }
 
+// RemoveParam removes exactly this param, keeping other params with the same key
+func (section *Section) RemoveParam(param *Param) {
+	for index, existing := range section.Params {
+		if existing == param {
+			section.Params = append(section.Params[:index], section.Params[index+1:]...)
+			break
+		}
+	}
+	params := section.ParamMap[param.Key]
+	for index, existing := range params {
+		if existing == param {
+			section.ParamMap[param.Key] = append(params[:index], params[index+1:]...)
+			break
+		}
+	}
+	if len(section.ParamMap[param.Key]) == 0 {
+		delete(section.ParamMap, param.Key)
+	}
+}
+
 func (section *Section) GetParamStr(key cfg.ParamKey, optional bool) string {
 	if optional && len(section.ParamMap[key]) == 0 {
 		return ""
@@ -115,11 +148,21 @@ func (section *Section) GetParamInt(key cfg.ParamKey, optional bool) int {
 		return 0
 	}
 
-	integer, err := strconv.Atoi(section.GetParamStr(key, false))
+	value := section.GetParamStr(key, false)
+	integer, err := strconv.Atoi(value)
 	if err != nil {
-		logus.Log.Fatal("failed to parse strid in universe.ini",
+		logus.Log.Error("failed to parse int param",
 			typelog.Any("key", key),
-			typelog.Any("section", section))
+			typelog.Any("section", section.OriginalType))
+		if section.INIFile != nil {
+			section.INIFile.AddDiagnostic(Diagnostic{
+				Filepath: section.INIFile.File.GetFilepath(),
+				Section:  section.OriginalType,
+				Raw:      fmt.Sprintf("%s = %s", key, value),
+				Kind:     DiagInvalidInt,
+				Message:  err.Error(),
+			})
+		}
 	}
 	return integer
 }
@@ -153,10 +196,22 @@ func (section *Section) GetParamBool(key_v string, optional bool, default_value
 // ;abc = qwe, 1, 2, 3 is Comment
 type Param struct {
 	Key              cfg.ParamKey
+	OriginalKey      string // key as written in file, if it differs from lowercased Key
 	Values           []UniValue
 	IsParamAsComment bool     // if special param as comment for autogenerated comments
 	First            UniValue // denormalization due to very often being needed
 	Comment          string
+
+	raw        string   // original line. Cleared once value is changed
+	raw_before []string // not parsable lines preceding param
+}
+
+// GetOriginalKey returns key in spelling it is written to file with
+func (p Param) GetOriginalKey() string {
+	if p.OriginalKey != "" {
+		return p.OriginalKey
+	}
+	return string(p.Key)
 }
 
 func (p *Param) AddValue(value UniValue) *Param {
@@ -164,9 +219,51 @@ func (p *Param) AddValue(value UniValue) *Param {
 		p.First = value
 	}
 	p.Values = append(p.Values, value)
+	p.raw = ""
 	return p
 }
 
+// SetValue replaces value at order position.
+// Param stops being written back as original line if value changed.
+func (p *Param) SetValue(order int, value UniValue) {
+	for len(p.Values) <= order {
+		p.Values = append(p.Values, UniParseStr(""))
+	}
+	if p.Values[order].AsString() != value.AsString() {
+		p.raw = ""
+	}
+	p.Values[order] = value
+	if order == 0 {
+		p.First = value
+	}
+}
+
+// IsModified is true for params not read from file or changed after reading
+func (p *Param) IsModified() bool {
+	return p.raw == ""
+}
+
+/*
+RawValue is everything after = as it was written in file.
+Numbers lose leading zeros once parsed, which breaks hex encoded strings like player names in save files.
+*/
+func (p *Param) RawValue() string {
+	if p.raw == "" {
+		values := make([]string, 0, len(p.Values))
+		for _, value := range p.Values {
+			values = append(values, value.AsString())
+		}
+		return strings.Join(values, ", ")
+	}
+	_, value, _ := strings.Cut(p.raw, "=")
+	if p.Comment != "" {
+		if index := strings.LastIndex(value, ";"); index >= 0 {
+			value = value[:index]
+		}
+	}
+	return strings.TrimSpace(value)
+}
+
 type WithComments bool
 
 func (p Param) ToString(with_comments WithComments) string {
@@ -185,7 +282,7 @@ func (p Param) ToString(with_comments WithComments) string {
 		sb.WriteString(";%")
 	}
 
-	sb.WriteString(fmt.Sprintf("%v = ", p.Key))
+	sb.WriteString(fmt.Sprintf("%v = ", p.GetOriginalKey()))
 
 	for index, value := range p.Values {
 		str_to_write := value.AsString()
@@ -231,6 +328,15 @@ func (v ValueNumber) AsString() string {
 }
 
 func UniParse(input string) (UniValue, error) {
+	value, err := uniParse(input)
+	if err != nil {
+		logus.Log.Warn("failed to read number. Converting to string", typelog.Any("input", input))
+	}
+	return value, nil
+}
+
+// uniParse returns error when number looking value had to be fallen back to string
+func uniParse(input string) (UniValue, error) {
 	letterMatch := regexLetter.FindAllString(input, -1)
 	if len(letterMatch) == 0 {
 		input = strings.ReplaceAll(input, " ", "")
@@ -241,8 +347,7 @@ func UniParse(input string) (UniValue, error) {
 		parsed_number, err := strconv.ParseFloat(input, 64)
 
 		if err != nil {
-			logus.Log.Warn("failed to read number. Converting to string", typelog.Any("input", input))
-			return ValueString(input), nil
+			return ValueString(input), err
 		}
 
 		var precision int
@@ -319,21 +424,70 @@ func isKeyCaseSensetive(key string) bool {
 	return false
 }
 
+/*
+Read never stops on malformed input.
+Lines it could not make sense of are skipped and reported in INIFile.Diagnostics
+Files of mod layers are merged with files they are applied over.
+*/
 func Read(fileref *file.File) *INIFile {
-	logus.Log.Debug("started reading INIFileRead for", utils_logus.FilePath(fileref.GetFilepath()))
+	if lower := fileref.Lower(); lower != nil {
+		return mergeSections(Read(lower), readFile(fileref))
+	}
+	return readFile(fileref)
+}
+
+func readFile(fileref *file.File) *INIFile {
 	config := &INIFile{}
 	config.File = fileref
+	config.SectionMapByNick = make(map[string]*Section)
+
+	if fileref == nil {
+		logus.Log.Error("ini reader received no file to read")
+		config.AddDiagnostic(Diagnostic{Kind: DiagReadFailed, Message: "file is not found"})
+		return config
+	}
+	logus.Log.Debug("started reading INIFileRead for", utils_logus.FilePath(fileref.GetFilepath()))
 
-	logus.Log.Debug("reading lines")
-	lines, err := fileref.ReadLines()
+	lines, bini_sections, is_bini, err := fileref.ReadIni()
 
 	if logus.Log.CheckError(err, "unable to read ini with error", typelog.OptError(err)) {
+		config.AddDiagnostic(Diagnostic{Filepath: fileref.GetFilepath(), Kind: DiagReadFailed, Message: err.Error()})
+		return config
+	}
+
+	if is_bini {
+		config.readBini(bini_sections)
+		config.mapSectionsByNick()
 		return config
 	}
 
+	config.LineEnding, config.NoFinalNewline = fileref.GetLineEnding()
+
 	logus.Log.Debug("setting current section")
 	var cur_section *Section
-	for _, line := range lines {
+	var unparsed_lines []string
+	keep_unparsed := func(line string) {
+		if cur_section == nil {
+			config.Preamble = append(config.Preamble, line)
+		} else {
+			unparsed_lines = append(unparsed_lines, line)
+		}
+	}
+	for line_index, line := range lines {
+		new_diagnostic := func(kind DiagnosticKind, msg string) Diagnostic {
+			diag := Diagnostic{
+				Filepath: fileref.GetFilepath(),
+				Line:     line_index + 1,
+				Raw:      line,
+				Kind:     kind,
+				Message:  msg,
+			}
+			if cur_section != nil {
+				diag.Section = cur_section.OriginalType
+			}
+			return diag
+		}
+
 		comment_match := regexComment.FindStringSubmatch(line)
 		section_match := regexSection.FindStringSubmatch(line)
 		param_match := regexParam.FindStringSubmatch(line)
@@ -345,6 +499,12 @@ func Read(fileref *file.File) *INIFile {
 				key = strings.ToLower(key)
 			}
 
+			if cur_section == nil {
+				config.AddDiagnostic(new_diagnostic(DiagParamOutsideSection, "param is not belonging to any section. skipped"))
+				keep_unparsed(line)
+				continue
+			}
+
 			line_to_read := param_match[3]
 			if strings.Contains(line_to_read, ",") {
 				line_to_read = strings.ReplaceAll(line_to_read, " ", "")
@@ -356,12 +516,11 @@ func Read(fileref *file.File) *INIFile {
 			var first_value UniValue
 
 			var values []UniValue
-			if len(splitted_values) < 1 {
-				panic("expected some splitted values, as formerly accessed splitted_values[0] here")
-			}
 			for index, value := range splitted_values {
-				univalue, err := UniParse(value)
-				logus.Log.CheckFatal(err, "ini reader, failing to parse line because of UniParse #2, line="+line, utils_logus.FilePath(fileref.GetFilepath()))
+				univalue, err := uniParse(value)
+				if err != nil {
+					config.AddDiagnostic(new_diagnostic(DiagNumberAsString, fmt.Sprintf("value %q is read as string: %s", value, err.Error())))
+				}
 
 				if index == 0 {
 					first_value = univalue
@@ -370,12 +529,22 @@ func Read(fileref *file.File) *INIFile {
 				values = append(values, univalue)
 			}
 
-			param := Param{Key: cfg.Key(key), First: first_value, Values: values, IsParamAsComment: isComment, Comment: param_match[4]}
+			param := Param{Key: cfg.Key(key), First: first_value, Values: values, IsParamAsComment: isComment, Comment: param_match[4],
+				raw: line, raw_before: unparsed_lines}
+			if key != param_match[2] {
+				param.OriginalKey = param_match[2]
+			}
+			unparsed_lines = nil
 			cur_section.AddParam(cfg.Key(key), &param)
 		} else if len(section_match) > 0 {
+			if cur_section != nil {
+				cur_section.raw_trailing = unparsed_lines
+				unparsed_lines = nil
+			}
 			cur_section = &Section{
 				INIFile: config,
 				Comment: section_match[2],
+				raw:     line,
 			} // create new
 			cur_section.OriginalType = inireader_types.IniHeader(section_match[1])
 			cur_section.Type = inireader_types.IniHeader(strings.ToLower(string(cur_section.OriginalType)))
@@ -387,28 +556,59 @@ func Read(fileref *file.File) *INIFile {
 			}
 			if cur_section == nil {
 				config.Comments = append(config.Comments, comment_value)
+				config.Preamble = append(config.Preamble, line)
 			} else {
 				comment := UniParseStr(comment_value)
-				cur_section.AddParam(KEY_COMMENT, &Param{Key: KEY_COMMENT, First: comment, Values: []UniValue{comment}, IsParamAsComment: true})
+				cur_section.AddParam(KEY_COMMENT, &Param{Key: KEY_COMMENT, First: comment, Values: []UniValue{comment}, IsParamAsComment: true,
+					raw: line, raw_before: unparsed_lines})
+				unparsed_lines = nil
+			}
+		} else {
+			if strings.TrimSpace(line) != "" {
+				config.AddDiagnostic(new_diagnostic(DiagUnrecognizedLine, "line is not section, param or comment. skipped"))
 			}
+			keep_unparsed(line)
 		}
 
 	}
+	if cur_section != nil {
+		cur_section.raw_trailing = unparsed_lines
+	}
 
-	config.SectionMapByNick = make(map[string]*Section)
+	config.mapSectionsByNick()
+	return config
+}
+
+func (config *INIFile) mapSectionsByNick() {
 	for _, section := range config.Sections {
 		if value, ok := section.ParamMap[cfg.Key("nickname")]; ok {
 			nickname := value[0].First.AsString()
 			config.SectionMapByNick[nickname] = section
 		}
 	}
-
-	return config
 }
 
 var KEY_COMMENT cfg.ParamKey = cfg.Key("00e0fc91e00300ed") // random hash
 
-func (config INIFile) Write(fileref *file.File) *file.File {
+func (config *INIFile) Write(fileref *file.File, opts ...WriteOption) *file.File {
+	options := writeOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if config.IsBini && !options.as_text {
+		data, err := config.ToBini()
+		if err == nil {
+			fileref.ScheduleToWriteBinary(data)
+			return fileref
+		}
+		logus.Log.Error("failed to encode bini, writing as text",
+			typelog.Any("filepath", fileref.GetFilepath()),
+			typelog.OptError(err),
+		)
+	}
+	if options.lossless {
+		return config.writeLossless(fileref)
+	}
 
 	for _, comment := range config.Comments {
 		if comment == "" {
@@ -1,12 +1,20 @@
 package inireader
 
 import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"testing/fstest"
 
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/bini"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader/inireader_types"
 	"github.com/darklab8/fl-darkstat/configs/tests"
 	"github.com/darklab8/go-utils/utils/utils_os"
+	"github.com/darklab8/go-utils/utils/utils_types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,22 +30,62 @@ func TestReaderWithBOMFails(t *testing.T) {
 	defer func() {
 		InitRegexExpression(&regexSection, regexSectionRegExp)
 	}()
-	InitRegexExpression(&regexSection, `^\[.*\]`)
+	InitRegexExpression(&regexSection, `^(\[.*\])`+optionalComment)
 
 	fs := filefind.FindConfigs(utils_os.GetCurrrentTestFolder())
 	fileref := fs.GetFile("li05_with_bom.ini")
 
-	var crashed bool = false
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				crashed = true
-			}
-		}()
-		Read(fileref)
-	}()
+	config := Read(fileref)
+
+	var outside_section int
+	for _, diag := range config.Diagnostics {
+		if diag.Kind == DiagParamOutsideSection {
+			outside_section++
+		}
+	}
+	assert.Greater(t, outside_section, 0, "with BOM we lose first section params, and report it.")
+}
+
+func TestReaderDiagnostics(t *testing.T) {
+	fs := filefind.FindConfigs(utils_os.GetCurrrentTestFolder())
+	fileref := fs.GetFile("diagnostics.ini")
+	config := Read(fileref)
 
-	assert.True(t, crashed, "with BOM we Crash.")
+	assert.Len(t, config.Sections, 2, "broken lines should not stop reading of other sections")
+	assert.Equal(t, "good_two", config.SectionMapByNick["good_two"].GetParamStr("nickname", REQUIRED_p))
+
+	kinds := make(map[DiagnosticKind][]Diagnostic)
+	for _, diag := range config.Diagnostics {
+		kinds[diag.Kind] = append(kinds[diag.Kind], diag)
+	}
+	if assert.Len(t, kinds[DiagParamOutsideSection], 1) {
+		assert.Equal(t, 1, kinds[DiagParamOutsideSection][0].Line)
+	}
+	if assert.Len(t, kinds[DiagNumberAsString], 1) {
+		assert.Equal(t, 4, kinds[DiagNumberAsString][0].Line)
+		assert.Equal(t, "[Good]", string(kinds[DiagNumberAsString][0].Section))
+	}
+	if assert.Len(t, kinds[DiagUnrecognizedLine], 1) {
+		assert.Equal(t, "this line is garbage", kinds[DiagUnrecognizedLine][0].Raw)
+	}
+
+	good_one := config.SectionMapByNick["good_one"]
+	assert.Equal(t, 0, good_one.GetParamInt("price", REQUIRED_p))
+	assert.Equal(t, 123, good_one.GetParamInt("ids_name", REQUIRED_p))
+	assert.Len(t, config.Diagnostics, 4, "invalid int getter is reported too")
+}
+
+func TestReaderMissingFile(t *testing.T) {
+	config := Read(nil)
+	assert.Len(t, config.Sections, 0)
+	if assert.Len(t, config.Diagnostics, 1) {
+		assert.Equal(t, DiagReadFailed, config.Diagnostics[0].Kind)
+	}
+
+	config = Read(file.NewFile(utils_os.GetCurrrentTestFolder().Join("not_existing.ini")))
+	if assert.Len(t, config.Diagnostics, 1) {
+		assert.Equal(t, DiagReadFailed, config.Diagnostics[0].Kind)
+	}
 }
 
 func TestReaderWithBOMPasses(t *testing.T) {
@@ -121,3 +169,193 @@ func TestCommentsHandling6(t *testing.T) {
 	write_file.WriteLines()
 	assert.Greater(t, len(config.Sections), 0, "expected not zero section")
 }
+
+func TestLosslessRoundTrip(t *testing.T) {
+	fs := filefind.FindConfigs(utils_os.GetCurrrentTestFolder())
+	fileref := fs.GetFile("lossless.ini")
+	original, err := os.ReadFile(fileref.GetFilepath().ToString())
+	assert.Nil(t, err)
+
+	config := Read(fileref)
+	assert.Equal(t, "\r\n", config.LineEnding)
+	assert.True(t, config.NoFinalNewline)
+
+	write_path := filepath.Join(t.TempDir(), "lossless.ini")
+	write_file := file.NewFile(utils_types.FilePath(write_path))
+	config.Write(write_file, Lossless())
+	write_file.WriteLines()
+
+	written, err := os.ReadFile(write_path)
+	assert.Nil(t, err)
+	assert.Equal(t, string(original), string(written), "unchanged file must be written byte for byte")
+
+	config = Read(fs.GetFile("lossless.ini"))
+	mass := config.SectionMap["[ship]"][0].ParamMap["mass"][0]
+	mass.SetValue(0, UniParseFloat(200, 2))
+
+	write_file = file.NewFile(utils_types.FilePath(write_path))
+	config.Write(write_file, Lossless())
+	write_file.WriteLines()
+
+	written, err = os.ReadFile(write_path)
+	assert.Nil(t, err)
+	original_lines := strings.Split(string(original), "\r\n")
+	written_lines := strings.Split(string(written), "\r\n")
+	assert.Equal(t, len(original_lines), len(written_lines))
+	for index := range original_lines {
+		if strings.HasPrefix(original_lines[index], "mass") {
+			assert.NotEqual(t, original_lines[index], written_lines[index])
+			assert.Contains(t, written_lines[index], "200")
+			continue
+		}
+		assert.Equal(t, original_lines[index], written_lines[index], "only modified param should change")
+	}
+}
+
+func TestBiniRoundTrip(t *testing.T) {
+	fs := filefind.FindConfigs(utils_os.GetCurrrentTestFolder())
+	config := Read(fs.GetFile("lossless.ini"))
+	assert.False(t, config.IsBini)
+
+	encoded, err := config.ToBini()
+	assert.Nil(t, err)
+	bini_path := filepath.Join(t.TempDir(), "lossless_bini.ini")
+	assert.Nil(t, os.WriteFile(bini_path, encoded, 0644))
+
+	bini_config := Read(file.NewFile(utils_types.FilePath(bini_path)))
+	assert.True(t, bini_config.IsBini)
+	assert.Equal(t, len(config.Sections), len(bini_config.Sections))
+
+	ship := bini_config.SectionMap["[ship]"][0]
+	assert.Equal(t, "li_elite", ship.ParamMap["nickname"][0].First.AsString())
+	assert.Equal(t, 123, ship.GetParamInt("ids_name", false))
+	assert.Equal(t, 100.5, ship.GetParamNumber("mass", false).Value)
+	assert.Len(t, ship.ParamMap["hp_type"][0].Values, 3)
+
+	write_path := filepath.Join(t.TempDir(), "written.ini")
+	write_file := file.NewFile(utils_types.FilePath(write_path))
+	bini_config.Write(write_file)
+	write_file.WriteLines()
+	written, err := os.ReadFile(write_path)
+	assert.Nil(t, err)
+	assert.Equal(t, encoded, written, "file read as bini must be written back as same bini")
+}
+
+func TestBiniReadTypedValues(t *testing.T) {
+	section := bini.NewSection("Ship")
+	section.AddRow("Nickname", "li_elite")
+	section.AddRow("linear_drag", float32(0.1))
+	section.AddRow("hold_size", 50)
+	section.AddRow("ids_info", "12345")
+	section.AddRow("steering_torque", float32(25000), float32(25000), float32(92000.25))
+	section.AddRow("empty")
+	encoded, err := bini.Encode([]*bini.Section{section})
+	assert.Nil(t, err)
+	bini_path := filepath.Join(t.TempDir(), "typed.ini")
+	assert.Nil(t, os.WriteFile(bini_path, encoded, 0644))
+
+	config := Read(file.NewFile(utils_types.FilePath(bini_path)))
+	assert.Len(t, config.Diagnostics, 0)
+	ship := config.SectionMap["[ship]"][0]
+	assert.Equal(t, inireader_types.IniHeader("[Ship]"), ship.OriginalType)
+	assert.Equal(t, ship, config.SectionMapByNick["li_elite"])
+
+	assert.Equal(t, ValueNumber{Value: 0.1, Precision: 1}, ship.ParamMap["linear_drag"][0].First)
+	assert.Equal(t, ValueNumber{Value: 50, Precision: 0}, ship.ParamMap["hold_size"][0].First)
+	assert.Equal(t, ValueString("12345"), ship.ParamMap["ids_info"][0].First, "bini strings are kept as strings")
+	assert.Equal(t, []UniValue{
+		ValueNumber{Value: 25000, Precision: 1},
+		ValueNumber{Value: 25000, Precision: 1},
+		ValueNumber{Value: 92000.25, Precision: 2},
+	}, ship.ParamMap["steering_torque"][0].Values)
+	assert.Equal(t, ValueString(""), ship.ParamMap["empty"][0].First)
+}
+
+func TestReadMergedLayers(t *testing.T) {
+	base := filefind.FindConfigsFS(fstest.MapFS{
+		"DATA/EQUIPMENT/engine_equip.ini": {Data: []byte(strings.Join([]string{
+			"[Engine]",
+			"nickname = ge_le_engine_01",
+			"max_force = 100",
+			"[Engine]",
+			"nickname = ge_le_engine_02",
+			"max_force = 200",
+			"[Motor]",
+			"delay = 1",
+			"",
+		}, "\n"))},
+		"DATA/goods.ini": {Data: []byte("[Good]\nnickname = base_good\n")},
+	})
+	mod := filefind.FindConfigsFS(fstest.MapFS{
+		"DATA/EQUIPMENT/engine_equip.ini": {Data: []byte(strings.Join([]string{
+			"[Engine]",
+			"nickname = GE_LE_ENGINE_02",
+			"max_force = 250",
+			"[Engine]",
+			"nickname = mod_engine",
+			"max_force = 300",
+			"[Motor]",
+			"delay = 2",
+			"",
+		}, "\n"))},
+		"DATA/goods.ini": {Data: []byte("[Good]\nnickname = mod_good\n")},
+	})
+	base.Overlay(mod, filefind.MergeSections)
+
+	config := Read(base.GetFile("engine_equip.ini"))
+	var forces []string
+	for _, section := range config.Sections {
+		if section.Type != "[engine]" {
+			continue
+		}
+		forces = append(forces, section.GetParamStr("max_force", false))
+	}
+	assert.Equal(t, []string{"100", "250", "300"}, forces)
+	// sections without nickname are replaced, not duplicated
+	if assert.Len(t, config.SectionMap["[motor]"], 1) {
+		assert.Equal(t, "2", config.SectionMap["[motor]"][0].GetParamStr("delay", false))
+	}
+	assert.Equal(t, inireader_types.IniHeader("[motor]"), config.Sections[2].Type, "replaced at place of lower layer section")
+	assert.Equal(t, config, config.SectionMapByNick["mod_engine"].INIFile)
+
+	// in files mode upper layer replaces whole file
+	replaced := filefind.FindConfigsFS(fstest.MapFS{"DATA/goods.ini": {Data: []byte("[Good]\nnickname = base_good\n")}})
+	replaced.Overlay(filefind.FindConfigsFS(fstest.MapFS{"DATA/goods.ini": {Data: []byte("[Good]\nnickname = mod_good\n")}}), filefind.MergeFiles)
+	goods := Read(replaced.GetFile("goods.ini"))
+	assert.Len(t, goods.Sections, 1)
+	assert.Contains(t, goods.SectionMapByNick, "mod_good")
+
+	goods = Read(base.GetFile("goods.ini"))
+	assert.Len(t, goods.Sections, 2)
+}
+
+func TestBiniCorruptedIsDiagnosed(t *testing.T) {
+	bini_path := filepath.Join(t.TempDir(), "corrupted.ini")
+	assert.Nil(t, os.WriteFile(bini_path, []byte("BINI\x01\x00\x00\x00\xff\xff\x00\x00"), 0644))
+
+	config := Read(file.NewFile(utils_types.FilePath(bini_path)))
+	assert.Len(t, config.Diagnostics, 1)
+	assert.Equal(t, DiagReadFailed, config.Diagnostics[0].Kind)
+	assert.Len(t, config.Sections, 0)
+}
+
+type countingFS struct {
+	fs.FS
+	opened int
+}
+
+func (c *countingFS) Open(name string) (fs.File, error) {
+	c.opened++
+	return c.FS.Open(name)
+}
+
+func TestReadOpensFileOnce(t *testing.T) {
+	fsys := &countingFS{FS: fstest.MapFS{
+		"text.ini": {Data: []byte("[Ship]\nnickname = li_elite\n")},
+	}}
+
+	config := Read(file.NewFileFS(fsys, "text.ini"))
+	assert.Len(t, config.Diagnostics, 0)
+	assert.NotNil(t, config.SectionMapByNick["li_elite"])
+	assert.Equal(t, 1, fsys.opened, "content is read once for both bini sniffing and line splitting")
+}
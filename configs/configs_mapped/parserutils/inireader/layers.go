@@ -0,0 +1,85 @@
+package inireader
+
+import (
+	"strings"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader/inireader_types"
+)
+
+type sectionKey struct {
+	Type     inireader_types.IniHeader
+	Nickname string
+}
+
+func getSectionKey(section *Section) (sectionKey, bool) {
+	nicknames, ok := section.ParamMap[cfg.Key("nickname")]
+	if !ok || len(nicknames) == 0 {
+		return sectionKey{}, false
+	}
+	return sectionKey{Type: section.Type, Nickname: strings.ToLower(nicknames[0].First.AsString())}, true
+}
+
+/*
+mergeSections applies upper layer file over lower one.
+Sections of upper layer replace lower layer sections of same type and nickname at their place.
+Sections without nickname, like [Time] or [Motor], replace all lower layer sections of same type without nickname,
+at place of the first of them.
+Sections without match in lower layer are appended to the end.
+File level data like preamble and line endings is taken from upper layer.
+*/
+func mergeSections(lower *INIFile, upper *INIFile) *INIFile {
+	upper_by_key := make(map[sectionKey]*Section)
+	upper_unnamed := make(map[inireader_types.IniHeader][]*Section)
+	for _, section := range upper.Sections {
+		if key, ok := getSectionKey(section); ok {
+			upper_by_key[key] = section
+		} else {
+			upper_unnamed[section.Type] = append(upper_unnamed[section.Type], section)
+		}
+	}
+
+	merged := make([]*Section, 0, len(lower.Sections)+len(upper.Sections))
+	used := make(map[*Section]bool)
+	for _, section := range lower.Sections {
+		key, ok := getSectionKey(section)
+		if replacement, found := upper_by_key[key]; ok && found {
+			if !used[replacement] {
+				merged = append(merged, replacement)
+				used[replacement] = true
+			}
+			continue
+		}
+		if replacements, found := upper_unnamed[section.Type]; !ok && found {
+			for _, replacement := range replacements {
+				if !used[replacement] {
+					merged = append(merged, replacement)
+					used[replacement] = true
+				}
+			}
+			continue
+		}
+		merged = append(merged, section)
+	}
+	for _, section := range upper.Sections {
+		if !used[section] {
+			merged = append(merged, section)
+		}
+	}
+
+	result := upper
+	result.Sections = nil
+	result.SectionMap = nil
+	result.SectionMapByNick = make(map[string]*Section)
+	for _, section := range merged {
+		section.INIFile = result
+		result.AddSection(section.Type, section)
+	}
+	result.mapSectionsByNick()
+
+	lower_diagnostics := lower.Diagnostics
+	result.diag_mu.Lock()
+	result.Diagnostics = append(lower_diagnostics, result.Diagnostics...)
+	result.diag_mu.Unlock()
+	return result
+}
@@ -0,0 +1,62 @@
+package inireader
+
+import (
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
+)
+
+type writeOptions struct {
+	lossless bool
+	as_text  bool
+}
+
+type WriteOption func(o *writeOptions)
+
+/*
+Lossless makes Write to output untouched sections and params byte for byte as they were read.
+Only params changed after reading (for example with semantic setters) and newly added ones are rendered anew.
+*/
+func Lossless() WriteOption {
+	return func(o *writeOptions) { o.lossless = true }
+}
+
+func (config *INIFile) writeLossless(fileref *file.File) *file.File {
+	fileref.LineEnding = config.LineEnding
+	fileref.NoFinalNewline = config.NoFinalNewline
+
+	if config.Preamble != nil {
+		fileref.ScheduleToWrite(config.Preamble...)
+	} else {
+		for _, comment := range config.Comments {
+			if comment == "" {
+				fileref.ScheduleToWrite("")
+				continue
+			}
+			fileref.ScheduleToWrite(";" + comment)
+		}
+	}
+
+	for _, section := range config.Sections {
+		if section.raw != "" {
+			fileref.ScheduleToWrite(section.raw)
+		} else {
+			fileref.ScheduleToWrite(section.ToString(WithComments(true)))
+		}
+
+		for _, param := range section.Params {
+			fileref.ScheduleToWrite(param.raw_before...)
+			if !param.IsModified() {
+				fileref.ScheduleToWrite(param.raw)
+			} else {
+				fileref.ScheduleToWrite(param.ToString(WithComments(true)))
+			}
+		}
+		fileref.ScheduleToWrite(section.raw_trailing...)
+	}
+
+	return fileref
+}
+
+// AsText writes files read from BINI format as plain text ini
+func AsText() WriteOption {
+	return func(o *writeOptions) { o.as_text = true }
+}
@@ -5,8 +5,6 @@ import (
 
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
-	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
-	"github.com/darklab8/go-typelog/typelog"
 )
 
 type Bool struct {
@@ -34,38 +32,45 @@ func NewBool(section *inireader.Section, key cfg.ParamKey, bool_type BoolType, o
 	return s
 }
 
-func (s *Bool) get() bool {
-	if s.optional && len(s.section.ParamMap[s.key]) == 0 {
-		return false
-	}
+// Lookup returns value or ValueError explaining why it is not found
+func (s *Bool) Lookup() (bool, error) {
 	switch s.bool_type {
 	case IntBool:
-		return int(s.section.ParamMap[s.key][s.index].Values[s.order].(inireader.ValueNumber).Value) == 1
+		value, err := s.lookupNumber(s.section)
+		return int(value) == 1, err
 	case StrBool:
-		return strings.Contains(s.section.ParamMap[s.key][s.index].Values[s.order].AsString(), "true")
+		raw, err := s.lookup(s.section)
+		if err != nil || raw == nil {
+			return false, err
+		}
+		return strings.Contains(raw.AsString(), "true"), nil
+	}
+	return false, s.newError(s.section, ErrWrongType)
+}
+
+func (s *Bool) Describe() Descriptor {
+	if s.bool_type == IntBool {
+		return s.describe(KindIntBool)
 	}
-	panic("not expected bool type")
+	return s.describe(KindBool)
+}
+
+func (s *Bool) Check() error {
+	_, err := s.Lookup()
+	return err
 }
 
 func (s *Bool) Get() bool {
-	defer handleGetCrashReporting(s.Value)
-	return s.get()
+	value, err := s.Lookup()
+	if err != nil {
+		reportGetError(s.Value, err)
+	}
+	return value
 }
 
 func (s *Bool) GetValue() (bool, bool) {
-	var value bool
-	var ok bool = true
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				logus.Log.Debug("Recovered from int GetValue Error:\n", typelog.Any("recover", r))
-				ok = false
-			}
-		}()
-		value = s.get()
-	}()
-
-	return value, ok
+	value, err := s.Lookup()
+	return value, err == nil
 }
 
 func (s *Bool) Set(value bool) {
@@ -89,12 +94,7 @@ func (s *Bool) Set(value bool) {
 		}
 	}
 
-	if len(s.section.ParamMap[s.key]) == 0 {
-		s.section.AddParamToStart(s.key, (&inireader.Param{IsParamAsComment: s.isComment()}).AddValue(processed_value))
-	}
-	// implement SetValue in Section
-	s.section.ParamMap[s.key][0].First = processed_value
-	s.section.ParamMap[s.key][0].Values[0] = processed_value
+	s.setValue(processed_value)
 }
 
 func (s *Bool) Delete() {
@@ -3,8 +3,6 @@ package semantic
 import (
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
-	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
-	"github.com/darklab8/go-typelog/typelog"
 )
 
 type Precision int
@@ -42,42 +40,35 @@ func NewFloat(section *inireader.Section, key cfg.ParamKey, precision Precision,
 	return s
 }
 
-func (s *Float) get() float64 {
-	var section *inireader.Section = s.section
-	if _, ok := s.section.ParamMap[s.key]; !ok {
-		if inherit_value, ok := s.section.ParamMap[InheritKey]; ok {
-			inherit_nick := inherit_value[0].First.AsString()
-			if found_section, ok := s.section.INIFile.SectionMapByNick[inherit_nick]; ok {
-				section = found_section
-			}
-		}
-	}
+// Lookup returns value or ValueError explaining why it is not found
+func (s *Float) Lookup() (float64, error) {
+	return s.lookupNumber(s.inheritedSection())
+}
 
-	if s.optional && len(section.ParamMap[s.key]) == 0 {
-		return 0
-	}
-	return section.ParamMap[s.key][s.index].Values[s.order].(inireader.ValueNumber).Value
+func (s *Float) Describe() Descriptor {
+	return s.describe(KindFloat)
+}
+
+func (s *Float) Check() error {
+	_, err := s.Lookup()
+	return err
 }
 
 func (s *Float) Get() float64 {
-	defer handleGetCrashReporting(s.Value)
-	return s.get()
+	value, err := s.Lookup()
+	if err != nil {
+		reportGetError(s.Value, err)
+	}
+	return value
 }
 
+// GetValue returns default value if value is not found
 func (s *Float) GetValue() (float64, bool) {
-	var value float64 = s.default_value
-	var ok bool = true
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				logus.Log.Debug("Recovered from int GetValue Error:\n", typelog.Any("recover", r))
-				ok = false
-			}
-		}()
-		value = s.get()
-	}()
-
-	return value, ok
+	value, err := s.Lookup()
+	if err != nil {
+		return s.default_value, false
+	}
+	return value, true
 }
 
 func (s *Float) Set(value float64) {
@@ -86,12 +77,7 @@ func (s *Float) Set(value float64) {
 	}
 
 	processed_value := inireader.UniParseFloat(value, int(s.precision))
-	if len(s.section.ParamMap[s.key]) == 0 {
-		s.section.AddParamToStart(s.key, (&inireader.Param{IsParamAsComment: s.isComment()}).AddValue(processed_value))
-	}
-	// implement SetValue in Section
-	s.section.ParamMap[s.key][0].First = processed_value
-	s.section.ParamMap[s.key][0].Values[0] = processed_value
+	s.setValue(processed_value)
 }
 
 func (s *Float) Delete() {
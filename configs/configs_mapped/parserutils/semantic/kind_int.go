@@ -3,8 +3,6 @@ package semantic
 import (
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
-	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
-	"github.com/darklab8/go-typelog/typelog"
 )
 
 type Int struct {
@@ -23,42 +21,32 @@ func NewInt(section *inireader.Section, key cfg.ParamKey, opts ...ValueOption) *
 
 var InheritKey = cfg.Key("inherit")
 
-func (s *Int) get() int {
-	var section *inireader.Section = s.section
-	if _, ok := s.section.ParamMap[s.key]; !ok {
-		if inherit_value, ok := s.section.ParamMap[InheritKey]; ok {
-			inherit_nick := inherit_value[0].First.AsString()
-			if found_section, ok := s.section.INIFile.SectionMapByNick[inherit_nick]; ok {
-				section = found_section
-			}
-		}
-	}
+// Lookup returns value or ValueError explaining why it is not found
+func (s *Int) Lookup() (int, error) {
+	value, err := s.lookupNumber(s.inheritedSection())
+	return int(value), err
+}
 
-	if s.optional && len(section.ParamMap[s.key]) == 0 {
-		return 0
-	}
-	return int(section.ParamMap[s.key][s.index].Values[s.order].(inireader.ValueNumber).Value)
+func (s *Int) Describe() Descriptor {
+	return s.describe(KindInt)
+}
+
+func (s *Int) Check() error {
+	_, err := s.Lookup()
+	return err
 }
 
 func (s *Int) Get() int {
-	defer handleGetCrashReporting(s.Value)
-	return s.get()
+	value, err := s.Lookup()
+	if err != nil {
+		reportGetError(s.Value, err)
+	}
+	return value
 }
 
 func (s *Int) GetValue() (int, bool) {
-	var value int
-	var ok bool = true
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				logus.Log.Debug("Recovered from int GetValue Error:\n", typelog.Any("recover", r))
-				ok = false
-			}
-		}()
-		value = s.get()
-	}()
-
-	return value, ok
+	value, err := s.Lookup()
+	return value, err == nil
 }
 
 func (s *Int) Set(value int) {
@@ -67,12 +55,7 @@ func (s *Int) Set(value int) {
 	}
 
 	processed_value := inireader.UniParseInt(value)
-	if len(s.section.ParamMap[s.key]) == 0 {
-		s.section.AddParamToStart(s.key, (&inireader.Param{IsParamAsComment: s.isComment()}).AddValue(processed_value))
-	}
-	// implement SetValue in Section
-	s.section.ParamMap[s.key][0].First = processed_value
-	s.section.ParamMap[s.key][0].Values[0] = processed_value
+	s.setValue(processed_value)
 }
 
 func (s *Int) Delete() {
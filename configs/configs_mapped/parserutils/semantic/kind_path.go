@@ -6,8 +6,6 @@ import (
 
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
-	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
-	"github.com/darklab8/go-typelog/typelog"
 	"github.com/darklab8/go-utils/utils/utils_types"
 )
 
@@ -46,26 +44,20 @@ func NewPath(section *inireader.Section, key cfg.ParamKey, opts ...PathOption) *
 }
 
 func (s *Path) FileName() utils_types.FilePath {
-	if s.optional && len(s.section.ParamMap[s.key]) == 0 {
+	value := s.Get()
+	if value == "" {
 		return ""
 	}
-	value := s.section.ParamMap[s.key][s.index].Values[s.order].AsString()
-	value = strings.ReplaceAll(value, "\\", PATH_SEPARATOR)
-	value = filepath.Base(value)
-	if s.remove_spaces {
-		value = strings.ReplaceAll(value, " ", "")
-	}
-	if s.lowercase {
-		value = strings.ToLower(value)
-	}
-	return utils_types.FilePath(value)
+	return utils_types.FilePath(filepath.Base(value.ToString()))
 }
 
-func (s *Path) get() utils_types.FilePath {
-	if s.optional && len(s.section.ParamMap[s.key]) == 0 {
-		return ""
+// Lookup returns value or ValueError explaining why it is not found
+func (s *Path) Lookup() (utils_types.FilePath, error) {
+	raw, err := s.lookup(s.section)
+	if err != nil || raw == nil {
+		return "", err
 	}
-	value := s.section.ParamMap[s.key][s.index].Values[s.order].AsString()
+	value := raw.AsString()
 	value = strings.ReplaceAll(value, "\\", PATH_SEPARATOR)
 	if s.remove_spaces {
 		value = strings.ReplaceAll(value, " ", "")
@@ -73,28 +65,29 @@ func (s *Path) get() utils_types.FilePath {
 	if s.lowercase {
 		value = strings.ToLower(value)
 	}
-	return utils_types.FilePath(value)
+	return utils_types.FilePath(value), nil
+}
+
+func (s *Path) Describe() Descriptor {
+	return s.describe(KindPath)
+}
+
+func (s *Path) Check() error {
+	_, err := s.Lookup()
+	return err
 }
 
 func (s *Path) Get() utils_types.FilePath {
-	defer handleGetCrashReporting(s.Value)
-	return s.get()
+	value, err := s.Lookup()
+	if err != nil {
+		reportGetError(s.Value, err)
+	}
+	return value
 }
 
 func (s *Path) GetValue() (utils_types.FilePath, bool) {
-	var value utils_types.FilePath
-	var ok bool = true
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				logus.Log.Debug("Recovered from int GetValue Error:\n", typelog.Any("recover", r))
-				ok = false
-			}
-		}()
-		value = s.get()
-	}()
-
-	return value, ok
+	value, err := s.Lookup()
+	return value, err == nil
 }
 
 func (s *Path) Set(value utils_types.FilePath) {
@@ -103,12 +96,7 @@ func (s *Path) Set(value utils_types.FilePath) {
 	}
 
 	processed_value := inireader.UniParseStr(string(value))
-	if len(s.section.ParamMap[s.key]) == 0 {
-		s.section.AddParamToStart(s.key, (&inireader.Param{IsParamAsComment: s.isComment()}).AddValue(processed_value))
-	}
-	// implement SetValue in Section
-	s.section.ParamMap[s.key][0].First = processed_value
-	s.section.ParamMap[s.key][0].Values[0] = processed_value
+	s.setValue(processed_value)
 }
 
 func (s *Path) Delete() {
@@ -5,8 +5,6 @@ import (
 
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
-	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
-	"github.com/darklab8/go-typelog/typelog"
 )
 
 type String struct {
@@ -43,51 +41,42 @@ func NewString(section *inireader.Section, key cfg.ParamKey, opts ...StringOptio
 	return s
 }
 
-func (s *String) get() string {
-	var section *inireader.Section = s.section
-	if _, ok := s.section.ParamMap[s.key]; !ok {
-		if inherit_value, ok := s.section.ParamMap[InheritKey]; ok {
-			inherit_nick := inherit_value[0].First.AsString()
-			if found_section, ok := s.section.INIFile.SectionMapByNick[inherit_nick]; ok {
-				section = found_section
-			}
-		}
-	}
-
-	if s.optional && len(section.ParamMap[s.key]) == 0 {
-		return ""
+// Lookup returns value or ValueError explaining why it is not found
+func (s *String) Lookup() (string, error) {
+	raw, err := s.lookup(s.inheritedSection())
+	if err != nil || raw == nil {
+		return "", err
 	}
-	value := section.ParamMap[s.key][s.index].Values[s.order].AsString()
+	value := raw.AsString()
 	if s.remove_spaces {
 		value = strings.ReplaceAll(value, " ", "")
 	}
 	if s.lowercase {
 		value = strings.ToLower(value)
 	}
-	return value
+	return value, nil
+}
 
+func (s *String) Describe() Descriptor {
+	return s.describe(KindString)
 }
 
-func (s *String) Get() string {
+func (s *String) Check() error {
+	_, err := s.Lookup()
+	return err
+}
 
-	defer handleGetCrashReporting(s.Value)
-	return s.get()
+func (s *String) Get() string {
+	value, err := s.Lookup()
+	if err != nil {
+		reportGetError(s.Value, err)
+	}
+	return value
 }
 
 func (s *String) GetValue() (string, bool) {
-	var value string
-	var ok bool = true
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				logus.Log.Debug("Recovered from int GetValue Error:\n", typelog.Any("recover", r))
-				ok = false
-			}
-		}()
-		value = s.get()
-	}()
-
-	return value, ok
+	value, err := s.Lookup()
+	return value, err == nil
 }
 
 func (s *String) Set(value string) {
@@ -96,12 +85,7 @@ func (s *String) Set(value string) {
 	}
 
 	processed_value := inireader.UniParseStr(value)
-	if len(s.section.ParamMap[s.key]) == 0 {
-		s.section.AddParamToStart(s.key, (&inireader.Param{IsParamAsComment: s.isComment()}).AddValue(processed_value))
-	}
-	// implement SetValue in Section
-	s.section.ParamMap[s.key][0].First = processed_value
-	s.section.ParamMap[s.key][0].Values[0] = processed_value
+	s.setValue(processed_value)
 }
 
 func (s *String) Delete() {
@@ -23,12 +23,15 @@ type ConfigModel struct {
 	sections []*inireader.Section
 	comments []string
 	filepath utils_types.FilePath
+
+	source *inireader.INIFile // for lossless rendering
 }
 
-func (s *ConfigModel) Init(sections []*inireader.Section, comments []string, filepath utils_types.FilePath) {
-	s.sections = sections
-	s.comments = comments
-	s.filepath = filepath
+func (s *ConfigModel) Init(ini *inireader.INIFile) {
+	s.sections = ini.Sections
+	s.comments = ini.Comments
+	s.filepath = ini.File.GetFilepath()
+	s.source = ini
 }
 
 func (s *ConfigModel) SetOutputPath(filepath utils_types.FilePath) {
@@ -40,5 +43,11 @@ func (s *ConfigModel) Render() *inireader.INIFile {
 	inifile.Comments = s.comments
 	inifile.Sections = s.sections
 	inifile.File = file.NewFile(s.filepath)
+	if s.source != nil {
+		inifile.Preamble = s.source.Preamble
+		inifile.LineEnding = s.source.LineEnding
+		inifile.NoFinalNewline = s.source.NoFinalNewline
+		inifile.IsBini = s.source.IsBini
+	}
 	return inifile
 }
@@ -5,7 +5,9 @@ package semantic
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/darklab8/fl-darkstat/configs/cfg"
@@ -73,6 +75,96 @@ func Comment() ValueOption {
 	}
 }
 
+var (
+	ErrMissingKey      = errors.New("missing key")
+	ErrWrongType       = errors.New("wrong type")
+	ErrIndexOutOfRange = errors.New("index out of range")
+)
+
+// ValueError tells why value was not found. Reason is one of ErrMissingKey, ErrWrongType or ErrIndexOutOfRange
+type ValueError struct {
+	Reason  error
+	Key     cfg.ParamKey
+	Index   int
+	Order   int
+	Section string
+}
+
+func (e *ValueError) Error() string {
+	return fmt.Sprintf("%s: %s (index=%d, order=%d) in %s", e.Reason, e.Key, e.Index, e.Order, e.Section)
+}
+
+func (e *ValueError) Unwrap() error {
+	return e.Reason
+}
+
+func (v *Value) newError(section *inireader.Section, reason error) *ValueError {
+	return &ValueError{
+		Reason:  reason,
+		Key:     v.key,
+		Index:   v.index,
+		Order:   v.order,
+		Section: string(section.OriginalType),
+	}
+}
+
+// inheritedSection returns section of inherit = nickname, if key is not defined in own section
+func (v *Value) inheritedSection() *inireader.Section {
+	if _, ok := v.section.ParamMap[v.key]; !ok {
+		if inherit_value, ok := v.section.ParamMap[InheritKey]; ok {
+			inherit_nick := inherit_value[0].First.AsString()
+			if found_section, ok := v.section.INIFile.SectionMapByNick[inherit_nick]; ok {
+				return found_section
+			}
+		}
+	}
+	return v.section
+}
+
+/*
+lookup finds raw value without panicking.
+Nil value without error is returned for missing optional values.
+*/
+func (v *Value) lookup(section *inireader.Section) (inireader.UniValue, error) {
+	params := section.ParamMap[v.key]
+	if len(params) == 0 {
+		if v.optional {
+			return nil, nil
+		}
+		return nil, v.newError(section, ErrMissingKey)
+	}
+	if v.index >= len(params) || v.order >= len(params[v.index].Values) {
+		return nil, v.newError(section, ErrIndexOutOfRange)
+	}
+	return params[v.index].Values[v.order], nil
+}
+
+/*
+setValue writes value at index and order of semantic value.
+Missing params of the key are added, so setting value beyond existing ones grows the section instead of panicking.
+*/
+func (v *Value) setValue(value inireader.UniValue) {
+	if len(v.section.ParamMap[v.key]) == 0 {
+		v.section.AddParamToStart(v.key, &inireader.Param{IsParamAsComment: v.isComment()})
+	}
+	for len(v.section.ParamMap[v.key]) <= v.index {
+		v.section.AddParam(v.key, &inireader.Param{IsParamAsComment: v.isComment()})
+	}
+	v.section.ParamMap[v.key][v.index].SetValue(v.order, value)
+}
+
+func (v *Value) lookupNumber(section *inireader.Section) (float64, error) {
+	raw, err := v.lookup(section)
+	if err != nil || raw == nil {
+		return 0, err
+	}
+	number, ok := raw.(inireader.ValueNumber)
+	if !ok {
+		return 0, v.newError(section, ErrWrongType)
+	}
+	return number.Value, nil
+}
+
 func quickJson(value any) string {
 	result, err := json.Marshal(value)
 	if err != nil {
@@ -81,24 +173,158 @@ func quickJson(value any) string {
 	return string(result)
 }
 
-func handleGetCrashReporting(value *Value) {
-	if r := recover(); r != nil {
-		if value == nil {
-			logus.Log.Panic("value is not defined. not possible. ;)")
+// reportGetError keeps Get() failing loudly for required values, with enough details to find broken config
+func reportGetError(value *Value, err error) {
+	var section strings.Builder
+	section.WriteString(string(value.section.Type))
+	for _, param := range value.section.Params {
+		section.WriteString(fmt.Sprintf("\"%s\"", param.ToString(inireader.WithComments(true))))
+	}
+	logus.Log.Error("unable to Get() from semantic.",
+		typelog.Any("value", quickJson(value)),
+		typelog.Any("key", value.key),
+		typelog.String("section", section.String()),
+		typelog.OptError(err),
+	)
+	panic(err)
+}
+
+// Checker is implemented by every value kind, to find missing fields without knowing their type
+type Checker interface {
+	Check() error
+}
+
+type FieldError struct {
+	Field string `json:"field"`
+	Error string `json:"error"`
+}
+
+/*
+Validate checks semantic values of mapped struct, including ones in embedded structs,
+nested structs and slices, and returns which of them are missing or broken.
+Nested fields are named by path, like HpTypes[0].Nickname.
+Optional values are not reported when absent, as well as fields listed in optional,
+which are named by path without indexes, like HpTypes.Nickname.
+*/
+func Validate(model any, optional ...string) []FieldError {
+	v := &validator{optional: make(map[string]bool, len(optional)), visited: make(map[uintptr]bool)}
+	for _, field := range optional {
+		v.optional[field] = true
+	}
+	v.validate(reflect.ValueOf(model), "", "")
+	return v.result
+}
+
+type validator struct {
+	optional map[string]bool
+	visited  map[uintptr]bool
+	result   []FieldError
+}
+
+// validate walks value, where path is name of field with indexes and optional_path is the same without indexes
+func (v *validator) validate(value reflect.Value, path string, optional_path string) {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return
+		}
+	}
+	if value.CanInterface() {
+		if checker, ok := value.Interface().(Checker); ok && path != "" {
+			if err := checker.Check(); err != nil && !v.optional[optional_path] {
+				v.result = append(v.result, FieldError{Field: path, Error: err.Error()})
+			}
+			return
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		// mapped structs can link to each other
+		if v.visited[value.Pointer()] {
 			return
-		} else {
-			var section strings.Builder
-			section.WriteString(string(value.section.Type))
-			for _, param := range value.section.Params {
-				section.WriteString(fmt.Sprintf("\"%s\"", param.ToString(inireader.WithComments(true))))
+		}
+		v.visited[value.Pointer()] = true
+		v.validate(value.Elem(), path, optional_path)
+	case reflect.Interface:
+		v.validate(value.Elem(), path, optional_path)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			v.validate(value.Index(i), fmt.Sprintf("%s[%d]", path, i), optional_path)
+		}
+	case reflect.Struct:
+		// nested structs are checked only if they are mapped too, not to walk through raw ini data
+		if path != "" && !isMapped(value.Type()) {
+			return
+		}
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if field.Anonymous {
+				v.validate(value.Field(i), path, optional_path)
+				continue
 			}
-			logus.Log.Error("unable to Get() from semantic.",
-				typelog.Any("value", quickJson(value)),
-				typelog.Any("key", value.key),
-				typelog.String("section", section.String()),
-				typelog.Any("r", r),
-			)
+			v.validate(value.Field(i), joinFieldPath(path, field.Name), joinFieldPath(optional_path, field.Name))
 		}
-		panic(r)
+	}
+}
+
+var model_type = reflect.TypeOf(Model{})
+
+// isMapped is true for structs embedding Model
+func isMapped(struct_type reflect.Type) bool {
+	for i := 0; i < struct_type.NumField(); i++ {
+		if field := struct_type.Field(i); field.Anonymous && field.Type == model_type {
+			return true
+		}
+	}
+	return false
+}
+
+func joinFieldPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+type Kind string
+
+const (
+	KindString  Kind = "string"
+	KindInt     Kind = "integer"
+	KindFloat   Kind = "number"
+	KindBool    Kind = "boolean"      // written as true or false
+	KindIntBool Kind = "integer_bool" // written as 1 or 0
+	KindPath    Kind = "path"
+)
+
+// Descriptor tells where value lives in ini file, for generating machine readable description of configs
+type Descriptor struct {
+	Section   *inireader.Section
+	Key       cfg.ParamKey
+	Index     int
+	Order     int
+	Optional  bool
+	IsComment bool
+	Kind      Kind
+}
+
+// Describer is implemented by every value kind
+type Describer interface {
+	Describe() Descriptor
+}
+
+func (v *Value) describe(kind Kind) Descriptor {
+	return Descriptor{
+		Section:   v.section,
+		Key:       v.key,
+		Index:     v.index,
+		Order:     v.order,
+		Optional:  v.optional,
+		IsComment: v.isComment(),
+		Kind:      kind,
 	}
 }
@@ -0,0 +1,127 @@
+package semantic
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
+	"github.com/stretchr/testify/assert"
+)
+
+func readSection(t *testing.T, content string) *inireader.Section {
+	config := inireader.Read(file.NewMemoryFile(strings.Split(content, "\n")))
+	if !assert.Len(t, config.Sections, 1) {
+		t.FailNow()
+	}
+	return config.Sections[0]
+}
+
+func TestLookupReasons(t *testing.T) {
+	section := readSection(t, `[Gun]
+nickname = li_gun01_mark01
+refire_delay = fast
+damage = 10, 20`)
+
+	_, err := NewString(section, cfg.Key("nickname")).Lookup()
+	assert.Nil(t, err)
+
+	_, err = NewFloat(section, cfg.Key("muzzle_velocity"), Precision(2)).Lookup()
+	assert.True(t, errors.Is(err, ErrMissingKey))
+
+	_, err = NewFloat(section, cfg.Key("refire_delay"), Precision(2)).Lookup()
+	assert.True(t, errors.Is(err, ErrWrongType))
+
+	value, err := NewInt(section, cfg.Key("damage"), Order(1)).Lookup()
+	assert.Nil(t, err)
+	assert.Equal(t, 20, value)
+
+	_, err = NewInt(section, cfg.Key("damage"), Order(2)).Lookup()
+	assert.True(t, errors.Is(err, ErrIndexOutOfRange))
+	var value_err *ValueError
+	if assert.True(t, errors.As(err, &value_err)) {
+		assert.Equal(t, cfg.Key("damage"), value_err.Key)
+		assert.Equal(t, "[Gun]", value_err.Section)
+	}
+
+	value, ok := NewInt(section, cfg.Key("toughness"), Optional()).GetValue()
+	assert.True(t, ok)
+	assert.Equal(t, 0, value)
+
+	assert.Panics(t, func() { NewInt(section, cfg.Key("toughness")).Get() })
+}
+
+func TestValidate(t *testing.T) {
+	section := readSection(t, `[Gun]
+nickname = li_gun01_mark01
+hp_type = hp_gun_special_1
+hp_type = hp_gun_special_2, hp_gun_special_3`)
+
+	type HpType struct {
+		Model
+		Nickname *String
+		Allowed  []*String
+	}
+	type Item struct {
+		Model
+		Nickname *String
+		Mass     *Float
+	}
+	type Gun struct {
+		Item
+		RefireDelay *Float
+		Toughness   *Float
+		Volume      *Float
+		NotMapped   *Int
+		HpTypes     []*HpType
+		Section     *inireader.Section
+	}
+	gun := &Gun{
+		Item: Item{
+			Nickname: NewString(section, cfg.Key("nickname")),
+			Mass:     NewFloat(section, cfg.Key("mass"), Precision(2)),
+		},
+		RefireDelay: NewFloat(section, cfg.Key("refire_delay"), Precision(2)),
+		Toughness:   NewFloat(section, cfg.Key("toughness"), Precision(2), OptsF(Optional())),
+		Volume:      NewFloat(section, cfg.Key("volume"), Precision(2)),
+		Section:     section,
+	}
+	gun.Map(section)
+	for index := 0; index < 2; index++ {
+		hp_type := &HpType{Nickname: NewString(section, cfg.Key("hp_type"), OptsS(Index(index)))}
+		hp_type.Allowed = append(hp_type.Allowed, NewString(section, cfg.Key("hp_type"), OptsS(Index(index), Order(1))))
+		hp_type.Map(section)
+		gun.HpTypes = append(gun.HpTypes, hp_type)
+	}
+
+	var fields []string
+	for _, missing := range Validate(gun, "Volume") {
+		fields = append(fields, missing.Field)
+	}
+	assert.Equal(t, []string{"Mass", "RefireDelay", "HpTypes[0].Allowed[0]"}, fields)
+
+	fields = nil
+	for _, missing := range Validate(gun, "Mass", "Volume", "RefireDelay", "HpTypes.Allowed") {
+		fields = append(fields, missing.Field)
+	}
+	assert.Empty(t, fields)
+}
+
+func TestSetBeyondExistingParams(t *testing.T) {
+	section := readSection(t, `[Ship]
+nickname = li_elite
+hp_type = hp_gun_special_1`)
+
+	assert.NotPanics(t, func() {
+		NewString(section, cfg.Key("hp_type"), OptsS(Index(2))).Set("hp_gun_special_3")
+		NewInt(section, cfg.Key("hold_size"), Index(1), Order(1)).Set(50)
+	})
+
+	assert.Equal(t, "hp_gun_special_1", NewString(section, cfg.Key("hp_type")).Get())
+	assert.Equal(t, "hp_gun_special_3", NewString(section, cfg.Key("hp_type"), OptsS(Index(2))).Get())
+	assert.Len(t, section.ParamMap[cfg.Key("hp_type")], 3)
+	assert.Equal(t, 50, NewInt(section, cfg.Key("hold_size"), Index(1), Order(1)).Get())
+	assert.Len(t, section.ParamMap[cfg.Key("hold_size")], 2)
+}
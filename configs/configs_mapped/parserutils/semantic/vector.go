@@ -3,8 +3,6 @@ package semantic
 import (
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
-	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
-	"github.com/darklab8/go-typelog/typelog"
 )
 
 type Vect struct {
@@ -24,6 +22,25 @@ func NewVector(section *inireader.Section, key cfg.ParamKey, precision Precision
 	return v
 }
 
+// Lookup returns vector or error of first missing coordinate
+func (s *Vect) Lookup() (cfg.Vector, error) {
+	var value cfg.Vector
+	var err error
+	if value.X, err = s.X.Lookup(); err != nil {
+		return value, err
+	}
+	if value.Y, err = s.Y.Lookup(); err != nil {
+		return value, err
+	}
+	value.Z, err = s.Z.Lookup()
+	return value, err
+}
+
+func (s *Vect) Check() error {
+	_, err := s.Lookup()
+	return err
+}
+
 func (s *Vect) Get() cfg.Vector {
 	return cfg.Vector{
 		X: s.X.Get(),
@@ -33,17 +50,6 @@ func (s *Vect) Get() cfg.Vector {
 }
 
 func (s *Vect) GetValue() (cfg.Vector, bool) {
-	var value cfg.Vector
-	var ok bool = true
-	func() {
-		defer func() {
-			if r := recover(); r != nil {
-				logus.Log.Debug("Recovered from int GetValue Error:\n", typelog.Any("recover", r))
-				ok = false
-			}
-		}()
-		value = s.Get()
-	}()
-
-	return value, ok
+	value, err := s.Lookup()
+	return value, err == nil
 }
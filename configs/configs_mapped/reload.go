@@ -0,0 +1,354 @@
+package configs_mapped
+
+import (
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/const_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/equipment_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/equipment_mapped/equip_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/equipment_mapped/market_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/equipment_mapped/weaponmoddb"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/initialworld"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/interface_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/missions_mapped/empathy_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/missions_mapped/faction_props_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/missions_mapped/mbases_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/missions_mapped/npc_ships"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/rnd_msns_mapped/diff2money"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/rnd_msns_mapped/npcranktodiff"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/ship_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/solar_mapped/loadouts_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/solar_mapped/solararch_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/universe_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/universe_mapped/systems_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/exe_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/infocard_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/iniload"
+	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
+	"github.com/darklab8/fl-darkstat/configs/overrides"
+	"github.com/darklab8/go-typelog/typelog"
+	"github.com/darklab8/go-utils/utils/timeit"
+	"github.com/darklab8/go-utils/utils/utils_types"
+)
+
+// Component is part of configs read from its own files, which can be reread alone
+type Component string
+
+const (
+	ComponentFreelancer     Component = "freelancer" // freelancer.ini lists other files, its change rereads everything
+	ComponentGoods          Component = "goods"
+	ComponentMarket         Component = "market"
+	ComponentEquip          Component = "equip"
+	ComponentShiparch       Component = "shiparch"
+	ComponentLoadouts       Component = "loadouts"
+	ComponentUniverse       Component = "universe" // universe.ini together with systems and bases
+	ComponentInterface      Component = "interface"
+	ComponentInfocards      Component = "infocards"
+	ComponentInitialWorld   Component = "initialworld"
+	ComponentEmpathy        Component = "empathy"
+	ComponentMBases         Component = "mbases"
+	ComponentConsts         Component = "consts"
+	ComponentWeaponMods     Component = "weaponmoddb"
+	ComponentRandomMissions Component = "rnd_msns" // diff2money and npcranktodiff
+	ComponentNpcs           Component = "npcs"     // faction_prop and npcships
+	ComponentSolararch      Component = "solararch"
+	ComponentOverrides      Component = "overrides"
+	ComponentDiscovery      Component = "discovery" // web configs, reread only together with everything
+)
+
+// components mapped out of game folder, in order of reading
+var components = []Component{
+	ComponentGoods,
+	ComponentMarket,
+	ComponentEquip,
+	ComponentShiparch,
+	ComponentLoadouts,
+	ComponentUniverse,
+	ComponentInterface,
+	ComponentInfocards,
+	ComponentInitialWorld,
+	ComponentEmpathy,
+	ComponentMBases,
+	ComponentConsts,
+	ComponentWeaponMods,
+	ComponentRandomMissions,
+	ComponentNpcs,
+	ComponentSolararch,
+	ComponentOverrides,
+}
+
+// GraphComponents are components trade route graph is built of
+var GraphComponents = []Component{
+	ComponentUniverse,
+	ComponentInitialWorld,
+	ComponentSolararch,
+	ComponentOverrides,
+}
+
+func getConfig(filesystem *filefind.Filesystem, filename utils_types.FilePath) []*iniload.IniLoader {
+	return []*iniload.IniLoader{iniload.NewLoader(filesystem.GetFile(filename))}
+}
+
+// componentLoaders returns not yet scanned ini files of component. Infocards and overrides are not ini files
+func (m *MappedConfigs) componentLoaders(component Component, filesystem *filefind.Filesystem) []*iniload.IniLoader {
+	switch component {
+	case ComponentGoods:
+		return getConfigs(filesystem, m.FreelancerINI.Goods)
+	case ComponentMarket:
+		return getConfigs(filesystem, m.FreelancerINI.Markets)
+	case ComponentEquip:
+		return getConfigs(filesystem, m.FreelancerINI.Equips)
+	case ComponentShiparch:
+		return getConfigs(filesystem, m.FreelancerINI.Ships)
+	case ComponentLoadouts:
+		return getConfigs(filesystem, m.FreelancerINI.Loadouts)
+	case ComponentUniverse:
+		return getConfig(filesystem, universe_mapped.FILENAME)
+	case ComponentInterface:
+		return getConfig(filesystem, interface_mapped.FILENAME_FL_INI)
+	case ComponentInitialWorld:
+		return getConfig(filesystem, initialworld.FILENAME)
+	case ComponentEmpathy:
+		return getConfig(filesystem, empathy_mapped.FILENAME)
+	case ComponentMBases:
+		return getConfig(filesystem, mbases_mapped.FILENAME)
+	case ComponentConsts:
+		return getConfig(filesystem, const_mapped.FILENAME)
+	case ComponentWeaponMods:
+		return getConfig(filesystem, weaponmoddb.FILENAME)
+	case ComponentRandomMissions:
+		return append(getConfig(filesystem, diff2money.FILENAME), getConfig(filesystem, npcranktodiff.FILENAME)...)
+	case ComponentNpcs:
+		return append(getConfig(filesystem, faction_props_mapped.FILENAME), getConfig(filesystem, npc_ships.FILENAME)...)
+	case ComponentSolararch:
+		return getConfig(filesystem, solararch_mapped.FILENAME)
+	}
+	return nil
+}
+
+// mapComponent maps already scanned loaders of component. Components are mapped in parallel to each other
+func (m *MappedConfigs) mapComponent(component Component, loaders []*iniload.IniLoader, filesystem *filefind.Filesystem) {
+	switch component {
+	case ComponentGoods:
+		m.Goods = equipment_mapped.Read(loaders)
+	case ComponentMarket:
+		m.market = market_mapped.Read(loaders)
+	case ComponentEquip:
+		m.equip = equip_mapped.Read(loaders)
+	case ComponentShiparch:
+		m.Shiparch = ship_mapped.Read(loaders)
+	case ComponentLoadouts:
+		m.Loadouts = loadouts_mapped.Read(loaders)
+	case ComponentUniverse:
+		timeit.NewTimerF(func() {
+			m.Universe = universe_mapped.Read(loaders[0], filesystem)
+			m.Systems = systems_mapped.Read(m.Universe, filesystem)
+		}, timeit.WithMsg("map systems"))
+	case ComponentInterface:
+		m.InfocardmapINI = interface_mapped.Read(loaders[0])
+	case ComponentInfocards:
+		var infocards_override *file.File
+		if m.Discovery != nil {
+			infocards_override = discoveryWebFile(discoveryConfigUrl("infocard_overrides.cfg"))
+		}
+		m.Infocards, _ = infocard_mapped.Read(filesystem, m.FreelancerINI, infocards_override)
+	case ComponentInitialWorld:
+		m.InitialWorld = initialworld.Read(loaders[0])
+	case ComponentEmpathy:
+		m.Empathy = empathy_mapped.Read(loaders[0])
+	case ComponentMBases:
+		m.MBases = mbases_mapped.Read(loaders[0])
+	case ComponentConsts:
+		m.Consts = const_mapped.Read(loaders[0])
+	case ComponentWeaponMods:
+		m.WeaponMods = weaponmoddb.Read(loaders[0])
+	case ComponentRandomMissions:
+		m.DiffToMoney = diff2money.Read(loaders[0])
+		m.NpcRankToDiff = npcranktodiff.Read(loaders[1])
+	case ComponentNpcs:
+		m.FactionProps = faction_props_mapped.Read(loaders[0])
+		m.NpcShips = npc_ships.Read(loaders[1])
+	case ComponentSolararch:
+		m.Solararch = solararch_mapped.Read(loaders[0])
+	case ComponentOverrides:
+		m.Overrides = overrides.Overrides{}
+		if overrides_file := filesystem.GetFile(overrides.FILENAME); overrides_file != nil {
+			logus.Log.Info("found overrides file")
+			m.Overrides = overrides.Read(overrides_file)
+		}
+		if m.FLSR != nil {
+			m.Overrides = m.Overrides.WithDefaults(overrides.FLSRDefaults())
+		}
+	}
+}
+
+// readComponents scans and maps given components, all ini files are scanned in parallel first
+func (m *MappedConfigs) readComponents(filesystem *filefind.Filesystem, to_read []Component) {
+	loaders := make(map[Component][]*iniload.IniLoader)
+	var files []*iniload.IniLoader
+	for _, component := range to_read {
+		loaders[component] = m.componentLoaders(component, filesystem)
+		files = append(files, loaders[component]...)
+	}
+
+	timeit.NewTimerF(func() {
+		var wg sync.WaitGroup
+		wg.Add(len(files))
+		for _, file := range files {
+			go func(file *iniload.IniLoader) {
+				file.Scan()
+				wg.Done()
+			}(file)
+		}
+		wg.Wait()
+	}, timeit.WithMsg("Scanned ini loaders"))
+
+	timeit.NewTimerF(func() {
+		var wg sync.WaitGroup
+		wg.Add(len(to_read))
+		for _, component := range to_read {
+			go func(component Component) {
+				m.mapComponent(component, loaders[component], filesystem)
+				wg.Done()
+			}(component)
+		}
+		wg.Wait()
+	}, timeit.WithMsg("Mapped stuff"))
+
+	for component, component_loaders := range loaders {
+		m.loaders[component] = component_loaders
+	}
+}
+
+// finishReading rebuilds everything made out of all loaded files
+func (m *MappedConfigs) finishReading() {
+	var all_files []*iniload.IniLoader
+	for _, component := range append([]Component{ComponentFreelancer, ComponentDiscovery}, components...) {
+		all_files = append(all_files, m.loaders[component]...)
+	}
+
+	m.Hashes = m.buildHashIndex(all_files)
+
+	m.ParseReport = &ParseReport{}
+	m.ParseReport.AddFromLoaders(all_files...)
+	m.ParseReport.Add(m.Universe.Diagnostics...)
+	m.ParseReport.Add(m.Systems.Diagnostics...)
+	m.ParseReport.Sort()
+	m.ParseReport.Log()
+}
+
+// Changes are components which were reread
+type Changes map[Component]bool
+
+// Has tells if any of components changed. Full reread changes all of them
+func (c Changes) Has(components ...Component) bool {
+	if c[ComponentFreelancer] {
+		return true
+	}
+	for _, component := range components {
+		if c[component] {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Changes) IsFull() bool { return c[ComponentFreelancer] }
+
+func normalizedPath(path utils_types.FilePath) string {
+	return strings.ToLower(filepath.ToSlash(path.ToString()))
+}
+
+/*
+Classify finds components read from changed files.
+Files are matched by names, as configs are found in folder by names as well.
+Files which are not read by darkstat are ignored.
+*/
+func (m *MappedConfigs) Classify(changed ...utils_types.FilePath) Changes {
+	changes := make(Changes)
+	if m.FreelancerINI == nil || m.loaders == nil {
+		// configs were deallocated with Clean(), nothing to reuse
+		changes[ComponentFreelancer] = true
+		return changes
+	}
+
+	by_name := make(map[string]Component)
+	for _, component := range components {
+		for _, loader := range m.loaders[component] {
+			if loader.INIFile != nil && loader.File != nil {
+				by_name[strings.ToLower(filepath.Base(loader.File.GetFilepath().ToString()))] = component
+			}
+		}
+	}
+
+	for _, path := range changed {
+		normalized := normalizedPath(path)
+		name := filepath.Base(normalized)
+		switch {
+		case name == string(exe_mapped.FILENAME_FL_INI):
+			changes[ComponentFreelancer] = true
+		case name == string(overrides.FILENAME):
+			changes[ComponentOverrides] = true
+		case strings.HasSuffix(name, ".dll"):
+			changes[ComponentInfocards] = true
+		case by_name[name] != "":
+			changes[by_name[name]] = true
+		case strings.Contains("/"+normalized, "/universe/"):
+			changes[ComponentUniverse] = true
+		}
+	}
+	return changes
+}
+
+/*
+Reload rereads only components whose files changed.
+New configs are returned, and current ones are left untouched,
+so they can be served until new data is exported.
+Unchanged components are shared between both, which is why configs should not be cleaned with Clean() if reloaded.
+*/
+func (m *MappedConfigs) Reload(changed ...utils_types.FilePath) (*MappedConfigs, Changes) {
+	changes := m.Classify(changed...)
+	if len(changes) == 0 {
+		return m, changes
+	}
+
+	filesystem := m.filesystem
+	if m.rescan != nil {
+		// new files could appear, like new system
+		filesystem = m.rescan()
+		// files which are still needed were already read, opened archives of previous scan are released
+		defer m.Close()
+	}
+	if filesystem == nil {
+		logus.Log.Panic("configs can not be reloaded, as they were not read from filesystem")
+	}
+
+	if changes.IsFull() {
+		logus.Log.Info("rereading all configs")
+		fresh := NewMappedConfigs()
+		fresh.rescan = m.rescan
+		return fresh.ReadFilesystem(filesystem), changes
+	}
+
+	fresh := *m
+	fresh.filesystem = filesystem
+	fresh.loaders = make(map[Component][]*iniload.IniLoader)
+	for component, loaders := range m.loaders {
+		fresh.loaders[component] = loaders
+	}
+
+	var to_read []Component
+	for _, component := range components {
+		if changes[component] {
+			to_read = append(to_read, component)
+		}
+	}
+	logus.Log.Info("rereading changed configs", typelog.Any("components", to_read))
+	fresh.readComponents(filesystem, to_read)
+	fresh.finishReading()
+	return &fresh, changes
+}
@@ -0,0 +1,107 @@
+package configs_mapped
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/darklab8/go-utils/utils/utils_os"
+	"github.com/darklab8/go-utils/utils/utils_types"
+	"github.com/stretchr/testify/assert"
+)
+
+func copyFixtureMod(t *testing.T) string {
+	folder := t.TempDir()
+	err := os.CopyFS(folder, os.DirFS(utils_os.GetCurrrentTestFolder().Join("mod").ToString()))
+	assert.Nil(t, err)
+	return folder
+}
+
+func rewriteFile(t *testing.T, path string, old string, new string) {
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), old)
+	assert.Nil(t, os.WriteFile(path, []byte(strings.Replace(string(data), old, new, 1)), 0644))
+}
+
+func TestReloadChangedComponent(t *testing.T) {
+	folder := copyFixtureMod(t)
+	mapped := NewMappedConfigs().Read(utils_types.FilePath(folder))
+	assert.Equal(t, 100, mapped.Goods.GoodsMap["li_gun01_mark01"].Price.Get())
+
+	goods_path := filepath.Join(folder, "DATA", "EQUIPMENT", "goods.ini")
+	rewriteFile(t, goods_path, "price = 100", "price = 150")
+
+	fresh, changes := mapped.Reload(utils_types.FilePath(goods_path))
+	assert.Equal(t, Changes{ComponentGoods: true}, changes)
+	assert.Equal(t, 150, fresh.Goods.GoodsMap["li_gun01_mark01"].Price.Get())
+	assert.Equal(t, 100, mapped.Goods.GoodsMap["li_gun01_mark01"].Price.Get(), "current configs stay untouched")
+
+	assert.Same(t, mapped.Equip(), fresh.Equip(), "unchanged components are reused")
+	assert.Same(t, mapped.Universe, fresh.Universe)
+	assert.False(t, changes.Has(GraphComponents...))
+}
+
+func TestReloadClassify(t *testing.T) {
+	folder := copyFixtureMod(t)
+	mapped := NewMappedConfigs().Read(utils_types.FilePath(folder))
+
+	changes := mapped.Classify(
+		utils_types.FilePath(filepath.Join(folder, "DATA", "UNIVERSE", "SYSTEMS", "LI01", "li01.ini")),
+		utils_types.FilePath(filepath.Join(folder, "DATA", "EQUIPMENT", "Market_Misc.ini")),
+		utils_types.FilePath(filepath.Join(folder, "readme.txt")),
+	)
+	assert.Equal(t, Changes{ComponentUniverse: true, ComponentMarket: true}, changes)
+	assert.True(t, changes.Has(GraphComponents...))
+
+	changes = mapped.Classify(utils_types.FilePath(filepath.Join(folder, "EXE", "freelancer.ini")))
+	assert.True(t, changes.IsFull())
+	assert.True(t, changes.Has(ComponentShiparch))
+}
+
+func TestReloadFull(t *testing.T) {
+	folder := copyFixtureMod(t)
+	mapped := NewMappedConfigs().Read(utils_types.FilePath(folder))
+
+	freelancer_path := filepath.Join(folder, "EXE", "freelancer.ini")
+	rewriteFile(t, freelancer_path, "goods = equipment\\goods.ini\n", "")
+
+	fresh, changes := mapped.Reload(utils_types.FilePath(freelancer_path))
+	assert.True(t, changes.IsFull())
+	assert.Len(t, fresh.Goods.Goods, 0)
+	assert.Len(t, mapped.Goods.Goods, 2)
+	assert.NotSame(t, mapped.Equip(), fresh.Equip())
+}
+
+func TestCleanDropsLoaders(t *testing.T) {
+	folder := copyFixtureMod(t)
+	mapped := NewMappedConfigs().Read(utils_types.FilePath(folder))
+	assert.NotEmpty(t, mapped.loaders)
+
+	mapped.Clean()
+	assert.Nil(t, mapped.loaders, "parsed ini files are released together with mapped configs")
+	assert.True(t, mapped.Classify(utils_types.FilePath(filepath.Join(folder, "DATA", "EQUIPMENT", "goods.ini"))).IsFull())
+}
+
+func TestReloadClosesPreviousArchive(t *testing.T) {
+	archive_path := filepath.Join(t.TempDir(), "mod.zip")
+	archive, err := os.Create(archive_path)
+	assert.Nil(t, err)
+	writer := zip.NewWriter(archive)
+	assert.Nil(t, writer.AddFS(os.DirFS(copyFixtureMod(t))))
+	assert.Nil(t, writer.Close())
+	assert.Nil(t, archive.Close())
+
+	mapped := NewMappedConfigs().Read(utils_types.FilePath(archive_path))
+	_, err = mapped.filesystem.GetFile("goods.ini").ReadLines()
+	assert.Nil(t, err)
+
+	fresh, changes := mapped.Reload(utils_types.FilePath("goods.ini"))
+	assert.Equal(t, Changes{ComponentGoods: true}, changes)
+	_, err = fresh.filesystem.GetFile("goods.ini").ReadLines()
+	assert.Nil(t, err)
+	_, err = mapped.filesystem.GetFile("goods.ini").ReadLines()
+	assert.NotNil(t, err, "archive of previous scan is closed instead of leaking")
+}
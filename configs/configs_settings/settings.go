@@ -2,7 +2,9 @@ package configs_settings
 
 import (
 	"os"
+	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/darklab8/go-utils/utils/enverant"
 	"github.com/darklab8/go-utils/utils/utils_settings"
@@ -15,7 +17,14 @@ type ConfEnvVars struct {
 	Strict                      bool
 	FreelancerFolder            utils_types.FilePath
 	FreelancerFolderFailback    utils_types.FilePath
+	FreelancerLayers            []utils_types.FilePath // Mod folders or archives applied on top of FreelancerFolder, in order
+	FreelancerLayersMerge       bool
 	MaxCores                    *int
+
+	DiscoveryConfigsUrl string               // Folder with public Discovery configs, url or local path
+	DiscoveryPobsUrl    string               // Player owned bases json, url or local path
+	WebCacheFolder      utils_types.FilePath // Downloaded web configs are kept here for offline runs
+	WebTimeout          time.Duration
 }
 
 var Env ConfEnvVars
@@ -31,7 +40,13 @@ func GetEnvs(envs *enverant.Enverant) ConfEnvVars {
 		Strict:                      envs.GetBool("CONFIGS_STRICT", enverant.OrBool(true)),
 		FreelancerFolder:            getGameLocation(envs),
 		FreelancerFolderFailback:    utils_types.FilePath(envs.GetStrOr("FREELANCER_FOLDER_FAILBACK", "")),
+		FreelancerLayers:            getLayers(envs),
+		FreelancerLayersMerge:       envs.GetBool("FREELANCER_LAYERS_MERGE", enverant.OrBool(false)),
 		MaxCores:                    envs.GetPtrInt("CONFIGS_MAX_CORES"),
+		DiscoveryConfigsUrl:         envs.GetStrOr("DISCOVERY_CONFIGS_URL", "https://discoverygc.com/gameconfigpublic"),
+		DiscoveryPobsUrl:            envs.GetStrOr("DISCOVERY_POBS_URL", "https://discoverygc.com/forums/base_admin.php?action=getjson"),
+		WebCacheFolder:              getWebCacheFolder(envs),
+		WebTimeout:                  time.Duration(envs.GetIntOr("WEB_CONFIGS_TIMEOUT", 30)) * time.Second,
 	}
 
 	return Env
@@ -50,6 +65,33 @@ func GetMaxCores() int {
 	return *Env.MaxCores
 }
 
+// getLayers reads FREELANCER_LAYERS, separated same way as PATH
+func getLayers(envs *enverant.Enverant) []utils_types.FilePath {
+	var layers []utils_types.FilePath
+	for _, layer := range filepath.SplitList(envs.GetStr("FREELANCER_LAYERS", enverant.OrStr(""))) {
+		if layer != "" {
+			layers = append(layers, utils_types.FilePath(layer))
+		}
+	}
+	return layers
+}
+
+// getWebCacheFolder reads WEB_CONFIGS_CACHE, defaulting to user cache folder. Setting it to "-" disables cache
+func getWebCacheFolder(envs *enverant.Enverant) utils_types.FilePath {
+	folder := envs.GetStrOr("WEB_CONFIGS_CACHE", "")
+	if folder == "-" {
+		return ""
+	}
+	if folder == "" {
+		user_cache, err := os.UserCacheDir()
+		if err != nil {
+			return ""
+		}
+		folder = filepath.Join(user_cache, "darkstat", "web_configs")
+	}
+	return utils_types.FilePath(folder)
+}
+
 func getGameLocation(envs *enverant.Enverant) utils_types.FilePath {
 	var folder utils_types.FilePath = utils_types.FilePath(
 		envs.GetStr("FREELANCER_FOLDER", enverant.OrStr("")),
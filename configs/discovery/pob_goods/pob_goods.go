@@ -27,6 +27,16 @@ func (good ShopItem) BaseBuys() bool {
 	return good.Quantity < good.MaxStock
 }
 
+// StockBaseSells is amount of units base can sell before reaching min stock
+func (good ShopItem) StockBaseSells() int {
+	return max(0, good.Quantity-good.MinStock)
+}
+
+// StockBaseBuys is amount of units base can buy before reaching max stock
+func (good ShopItem) StockBaseBuys() int {
+	return max(0, good.MaxStock-good.Quantity)
+}
+
 type Base struct {
 	Name      string
 	Nickname  string
@@ -62,12 +72,9 @@ type Config struct {
 	Bases       []*Base
 }
 
-func (c *Config) Refresh() {
-	reread := Read(c.file)
-	c.file = reread.file
-	c.BasesByName = reread.BasesByName
-	c.Timestamp = reread.Timestamp
-	c.Bases = reread.Bases
+// Reread fetches bases anew into new config, current one stays as it is
+func (c *Config) Reread() *Config {
+	return Read(c.file)
 }
 
 func NameToNickname(name string) string {
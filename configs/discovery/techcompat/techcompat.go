@@ -1,9 +1,13 @@
 package techcompat
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/iniload"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
 )
 
@@ -179,6 +183,43 @@ func Read(input_file *iniload.IniLoader) *Config {
 	return conf
 }
 
-func (frelconfig *Config) Write() *file.File {
-	return &file.File{}
+func (frelconfig *Config) Write(opts ...inireader.WriteOption) *file.File {
+	inifile := frelconfig.Render()
+	inifile.Write(inifile.File, opts...)
+	return inifile.File
+}
+
+// RemoveGroupItem removes item from [tech] group
+func (conf *Config) RemoveGroupItem(group string, item string) error {
+	techgroup, ok := conf.TechGroupByName[group]
+	if !ok {
+		return fmt.Errorf("tech group %s is not found", group)
+	}
+	section := techgroup.RenderModel()
+	for _, param := range section.ParamMap[cfg.Key("item")] {
+		if strings.EqualFold(param.First.AsString(), item) {
+			section.RemoveParam(param)
+			*conf = *Read(conf.IniLoader)
+			return nil
+		}
+	}
+	return fmt.Errorf("item %s is not found in tech group %s", item, group)
+}
+
+// RemoveFactionTech removes compatibility of faction ID with tech group.
+// Faction section can list several IDs, all of them lose it
+func (conf *Config) RemoveFactionTech(id cfg.TractorID, group string) error {
+	faction, ok := conf.FactionByID[id]
+	if !ok {
+		return fmt.Errorf("techcompat faction %s is not found", id)
+	}
+	section := faction.RenderModel()
+	for _, param := range section.ParamMap[cfg.Key("tech")] {
+		if strings.EqualFold(param.First.AsString(), group) {
+			section.RemoveParam(param)
+			*conf = *Read(conf.IniLoader)
+			return nil
+		}
+	}
+	return fmt.Errorf("tech group %s is not found in faction %s", group, id)
 }
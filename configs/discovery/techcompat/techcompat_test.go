@@ -46,3 +46,15 @@ func TestReader(t *testing.T) {
 
 	assert.Equal(t, float64(1.0), config.GetCompatibilty("dsy_no2_cruiser", "dsy_license_nomadguard"))
 }
+
+func TestRemoveIgnoresCase(t *testing.T) {
+	test_directory := utils_os.GetCurrrentTestFolder()
+	fileref := file.NewFile(utils_types.FilePath(utils_filepath.Join(test_directory, "techcompat.cfg")))
+	config := Read(iniload.NewLoader(fileref).Scan())
+
+	assert.Nil(t, config.RemoveFactionTech("dsy_license_br_n_grp", "BR_LAW_GUNS"))
+	for _, compat := range config.FactionByID["dsy_license_br_n_grp"].TechCompats {
+		assert.NotEqual(t, "br_law_guns", compat.Nickname.Get())
+	}
+	assert.Error(t, config.RemoveFactionTech("dsy_license_br_n_grp", "br_law_guns"))
+}
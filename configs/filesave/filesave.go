@@ -0,0 +1,413 @@
+/*
+Reader of player save files (.fl), plain or FLS1 encrypted.
+Model is ported from configs/docs/filesave onto ini reader of this repo.
+Items, ships and visited objects are kept as hashes, resolving them to nicknames is job of configs_mapped hash index.
+*/
+package filesave
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/initialworld/flhash"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
+	"github.com/darklab8/go-utils/utils/utils_types"
+)
+
+var ErrNotSaveFile = errors.New("file has no [Player] section")
+
+// CodepointString is how names are stored, as 4 hex digits per UTF-16 code unit
+type CodepointString string
+
+func (cs CodepointString) String() string {
+	var points []uint16
+	for index := 0; index+4 <= len(cs); index += 4 {
+		point, err := strconv.ParseUint(string(cs[index:index+4]), 16, 16)
+		if err != nil {
+			point = 0
+		}
+		points = append(points, uint16(point))
+	}
+	return string(utf16.Decode(points))
+}
+
+func CPSFromString(s string) CodepointString {
+	var sb strings.Builder
+	for _, point := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&sb, "%04X", point)
+	}
+	return CodepointString(sb.String())
+}
+
+const (
+	winTicksEpochDifference = 116444736000000000
+	nsPerWinTick            = 100
+)
+
+// TStamp is windows FILETIME split into high and low 32 bits
+type TStamp [2]uint32
+
+func (t TStamp) Time() time.Time {
+	// 100-nanosecond intervals since January 1, 1601
+	ticks := int64(t[0])<<32 + int64(t[1])
+	return time.Unix(0, (ticks-winTicksEpochDifference)*nsPerWinTick).UTC()
+}
+
+func TStampFromTime(t time.Time) TStamp {
+	ticks := uint64(t.UnixNano()/nsPerWinTick + winTicksEpochDifference)
+	return TStamp{uint32(ticks >> 32), uint32(ticks)}
+}
+
+type Equipment struct {
+	ID        flhash.HashCode `json:"id"`
+	Hardpoint string          `json:"hardpoint"`
+	Health    float64         `json:"health"`
+}
+
+type Cargo struct {
+	ID       flhash.HashCode `json:"id"`
+	Quantity int             `json:"quantity"`
+	Health   float64         `json:"health"`
+}
+
+type ShipKills struct {
+	ID       flhash.HashCode `json:"id"`
+	Quantity int             `json:"quantity"`
+}
+
+// Visit is object player met, with flags of what was done with it
+type Visit struct {
+	ID    flhash.HashCode `json:"id"`
+	Flags int             `json:"flags"`
+}
+
+type Model struct {
+	Body      string `json:"body"`
+	Head      string `json:"head"`
+	LeftHand  string `json:"left_hand"`
+	RightHand string `json:"right_hand"`
+}
+
+type Player struct {
+	Name               string             `json:"name"`
+	Description        string             `json:"description"`
+	Timestamp          time.Time          `json:"timestamp"`
+	Rank               int                `json:"rank"`
+	Reputations        map[string]float64 `json:"reputations"` // by faction nickname
+	ReputationGroup    string             `json:"reputation_group"`
+	Money              int                `json:"money"`
+	NumKills           int                `json:"num_kills"`
+	NumMissionSuccess  int                `json:"num_misn_successes"`
+	NumMissionFailures int                `json:"num_misn_failures"`
+	ComModel           Model              `json:"com_model"`
+	Model              Model              `json:"model"`
+	System             string             `json:"system"`
+	Base               string             `json:"base,omitempty"` // empty when player logged off in space
+	Position           *cfg.Vector        `json:"position,omitempty"`
+	Ship               flhash.HashCode    `json:"ship"`
+	Equipment          []Equipment        `json:"equipment"`
+	Cargo              []Cargo            `json:"cargo"`
+	LastBase           string             `json:"last_base"`
+	BaseHullStatus     float64            `json:"base_hull_status"`
+	BaseEquipment      []Equipment        `json:"base_equipment"`
+	BaseCargo          []Cargo            `json:"base_cargo"`
+	Visited            []Visit            `json:"visited"`
+
+	TimePlayed     time.Duration     `json:"time_played"`
+	SystemsVisited []flhash.HashCode `json:"systems_visited"`
+	BasesVisited   []flhash.HashCode `json:"bases_visited"`
+	HolesVisited   []flhash.HashCode `json:"holes_visited"`
+	ShipKills      []ShipKills       `json:"ship_kills"`
+}
+
+func ReadFile(path utils_types.FilePath) (*Player, error) {
+	data, err := os.ReadFile(path.ToString())
+	if err != nil {
+		return nil, err
+	}
+	return Read(data)
+}
+
+// Read parses save file content, decrypting it first if it is FLS1 encrypted
+func Read(data []byte) (*Player, error) {
+	content := strings.TrimPrefix(string(Decrypt(data)), "\uFEFF")
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	config := inireader.Read(file.NewMemoryFile(lines))
+
+	players, ok := config.SectionMap["[player]"]
+	if !ok || len(players) == 0 {
+		return nil, ErrNotSaveFile
+	}
+	player := &Player{Reputations: make(map[string]float64)}
+	if err := player.readPlayer(players[0]); err != nil {
+		return nil, err
+	}
+	if mplayers, ok := config.SectionMap["[mplayer]"]; ok && len(mplayers) > 0 {
+		if err := player.readMPlayer(mplayers[0]); err != nil {
+			return nil, err
+		}
+	}
+	return player, nil
+}
+
+func paramErr(key string, err error) error {
+	return fmt.Errorf("failed to read %s: %w", key, err)
+}
+
+func params(section *inireader.Section, key string) []*inireader.Param {
+	return section.ParamMap[cfg.Key(key)]
+}
+
+func firstParam(section *inireader.Section, key string) (*inireader.Param, bool) {
+	if values := params(section, key); len(values) > 0 {
+		return values[0], true
+	}
+	return nil, false
+}
+
+func paramString(section *inireader.Section, key string) string {
+	if param, ok := firstParam(section, key); ok {
+		return param.RawValue()
+	}
+	return ""
+}
+
+func paramNumber(section *inireader.Section, key string) (float64, error) {
+	param, ok := firstParam(section, key)
+	if !ok {
+		return 0, nil
+	}
+	return valueNumber(param.First)
+}
+
+func valueNumber(value inireader.UniValue) (float64, error) {
+	if number, ok := value.(inireader.ValueNumber); ok {
+		return number.Value, nil
+	}
+	if value.AsString() == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(value.AsString(), 64)
+}
+
+// valueHash reads hash, or hashes nickname like in mpnewcharacter.fl template
+func valueHash(value inireader.UniValue) (flhash.HashCode, error) {
+	if _, ok := value.(inireader.ValueNumber); ok || strings.HasPrefix(value.AsString(), "0x") {
+		return flhash.ParseHash(value.AsString())
+	}
+	if value.AsString() == "" {
+		return 0, errors.New("empty hash")
+	}
+	return flhash.HashNickname(value.AsString()), nil
+}
+
+func paramHashes(section *inireader.Section, key string) ([]flhash.HashCode, error) {
+	var result []flhash.HashCode
+	for _, param := range params(section, key) {
+		hash, err := valueHash(param.First)
+		if err != nil {
+			return nil, paramErr(key, err)
+		}
+		result = append(result, hash)
+	}
+	return result, nil
+}
+
+func readEquipment(section *inireader.Section, key string) ([]Equipment, error) {
+	var result []Equipment
+	for _, param := range params(section, key) {
+		if len(param.Values) < 2 {
+			return nil, paramErr(key, fmt.Errorf("expected id and hardpoint, got %q", param.RawValue()))
+		}
+		id, err := valueHash(param.Values[0])
+		if err != nil {
+			return nil, paramErr(key, err)
+		}
+		equip := Equipment{ID: id, Hardpoint: param.Values[1].AsString(), Health: 1}
+		if len(param.Values) > 2 && param.Values[2].AsString() != "" {
+			if equip.Health, err = valueNumber(param.Values[2]); err != nil {
+				return nil, paramErr(key, err)
+			}
+		}
+		result = append(result, equip)
+	}
+	return result, nil
+}
+
+// cargo = id, quantity, , health, mission flag
+func readCargo(section *inireader.Section, key string) ([]Cargo, error) {
+	var result []Cargo
+	for _, param := range params(section, key) {
+		if len(param.Values) < 2 {
+			return nil, paramErr(key, fmt.Errorf("expected id and quantity, got %q", param.RawValue()))
+		}
+		id, err := valueHash(param.Values[0])
+		if err != nil {
+			return nil, paramErr(key, err)
+		}
+		quantity, err := valueNumber(param.Values[1])
+		if err != nil {
+			return nil, paramErr(key, err)
+		}
+		cargo := Cargo{ID: id, Quantity: int(quantity), Health: 1}
+		if len(param.Values) > 3 && param.Values[3].AsString() != "" {
+			if cargo.Health, err = valueNumber(param.Values[3]); err != nil {
+				return nil, paramErr(key, err)
+			}
+		}
+		result = append(result, cargo)
+	}
+	return result, nil
+}
+
+func (p *Player) readPlayer(section *inireader.Section) error {
+	p.Name = CodepointString(paramString(section, "name")).String()
+	p.Description = CodepointString(paramString(section, "description")).String()
+
+	if param, ok := firstParam(section, "tstamp"); ok && len(param.Values) == 2 {
+		high, err_high := valueNumber(param.Values[0])
+		low, err_low := valueNumber(param.Values[1])
+		if err := errors.Join(err_high, err_low); err != nil {
+			return paramErr("tstamp", err)
+		}
+		p.Timestamp = TStamp{uint32(high), uint32(low)}.Time()
+	}
+
+	for key, target := range map[string]*int{
+		"rank":               &p.Rank,
+		"money":              &p.Money,
+		"num_kills":          &p.NumKills,
+		"num_misn_successes": &p.NumMissionSuccess,
+		"num_misn_failures":  &p.NumMissionFailures,
+	} {
+		value, err := paramNumber(section, key)
+		if err != nil {
+			return paramErr(key, err)
+		}
+		*target = int(value)
+	}
+
+	for _, param := range params(section, "house") {
+		if len(param.Values) < 2 {
+			return paramErr("house", fmt.Errorf("expected reputation and faction, got %q", param.RawValue()))
+		}
+		rep, err := valueNumber(param.Values[0])
+		if err != nil {
+			return paramErr("house", err)
+		}
+		p.Reputations[strings.ToLower(param.Values[1].AsString())] = rep
+	}
+	p.ReputationGroup = strings.ToLower(paramString(section, "rep_group"))
+
+	p.ComModel = Model{
+		Body:      paramString(section, "com_body"),
+		Head:      paramString(section, "com_head"),
+		LeftHand:  paramString(section, "com_lefthand"),
+		RightHand: paramString(section, "com_righthand"),
+	}
+	p.Model = Model{
+		Body:      paramString(section, "body"),
+		Head:      paramString(section, "head"),
+		LeftHand:  paramString(section, "lefthand"),
+		RightHand: paramString(section, "righthand"),
+	}
+
+	p.System = strings.ToLower(paramString(section, "system"))
+	p.Base = strings.ToLower(paramString(section, "base"))
+	p.LastBase = strings.ToLower(paramString(section, "last_base"))
+	if param, ok := firstParam(section, "pos"); ok && len(param.Values) == 3 {
+		var coords [3]float64
+		for index := range coords {
+			value, err := valueNumber(param.Values[index])
+			if err != nil {
+				return paramErr("pos", err)
+			}
+			coords[index] = value
+		}
+		p.Position = &cfg.Vector{X: coords[0], Y: coords[1], Z: coords[2]}
+	}
+
+	if param, ok := firstParam(section, "ship_archetype"); ok {
+		ship, err := valueHash(param.First)
+		if err != nil {
+			return paramErr("ship_archetype", err)
+		}
+		p.Ship = ship
+	}
+
+	var err error
+	if p.Equipment, err = readEquipment(section, "equip"); err != nil {
+		return err
+	}
+	if p.Cargo, err = readCargo(section, "cargo"); err != nil {
+		return err
+	}
+	if p.BaseEquipment, err = readEquipment(section, "base_equip"); err != nil {
+		return err
+	}
+	if p.BaseCargo, err = readCargo(section, "base_cargo"); err != nil {
+		return err
+	}
+	if p.BaseHullStatus, err = paramNumber(section, "base_hull_status"); err != nil {
+		return paramErr("base_hull_status", err)
+	}
+
+	for _, param := range params(section, "visit") {
+		id, err := valueHash(param.First)
+		if err != nil {
+			return paramErr("visit", err)
+		}
+		visit := Visit{ID: id}
+		if len(param.Values) > 1 {
+			flags, err := valueNumber(param.Values[1])
+			if err != nil {
+				return paramErr("visit", err)
+			}
+			visit.Flags = int(flags)
+		}
+		p.Visited = append(p.Visited, visit)
+	}
+	return nil
+}
+
+func (p *Player) readMPlayer(section *inireader.Section) error {
+	time_played, err := paramNumber(section, "total_time_played")
+	if err != nil {
+		return paramErr("total_time_played", err)
+	}
+	p.TimePlayed = time.Duration(time_played * float64(time.Second))
+
+	if p.SystemsVisited, err = paramHashes(section, "sys_visited"); err != nil {
+		return err
+	}
+	if p.BasesVisited, err = paramHashes(section, "base_visited"); err != nil {
+		return err
+	}
+	if p.HolesVisited, err = paramHashes(section, "holes_visited"); err != nil {
+		return err
+	}
+
+	for _, param := range params(section, "ship_type_killed") {
+		id, err := valueHash(param.First)
+		if err != nil {
+			return paramErr("ship_type_killed", err)
+		}
+		kills := ShipKills{ID: id}
+		if len(param.Values) > 1 {
+			quantity, err := valueNumber(param.Values[1])
+			if err != nil {
+				return paramErr("ship_type_killed", err)
+			}
+			kills.Quantity = int(quantity)
+		}
+		p.ShipKills = append(p.ShipKills, kills)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package filesave
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/initialworld/flhash"
+	"github.com/darklab8/go-utils/utils/utils_os"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSave(t *testing.T) {
+	path := utils_os.GetCurrrentTestFolder().Join("plain.fl")
+	player, err := ReadFile(path)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "Trent", player.Name)
+	assert.Equal(t, "0123", player.Description)
+	assert.Equal(t, 5, player.Rank)
+	assert.Equal(t, 25400, player.Money)
+	assert.Equal(t, map[string]float64{"fc_x_grp": -0.65, "li_n_grp": 0.91, "fc_lr_grp": -1}, player.Reputations)
+	assert.Equal(t, "li01", player.System)
+	assert.Equal(t, "li01_01_base", player.Base)
+	assert.Nil(t, player.Position)
+	assert.Equal(t, flhash.HashCode(2151746432), player.Ship)
+
+	assert.Equal(t, []Equipment{
+		{ID: flhash.HashCode(2339324873), Hardpoint: "HpWeapon01", Health: 1},
+		{ID: flhash.HashNickname("li_gun01_mark01"), Hardpoint: "HpWeapon02", Health: 1},
+	}, player.Equipment)
+	assert.Equal(t, []Cargo{
+		{ID: flhash.HashCode(2918571840), Quantity: 5, Health: 1},
+		{ID: flhash.HashCode(2339324873), Quantity: 1, Health: 0.5},
+	}, player.Cargo)
+	assert.Equal(t, 0.8, player.BaseEquipment[0].Health)
+	assert.Equal(t, []Visit{{ID: 2412156096, Flags: 1}, {ID: 2155555968, Flags: 16}}, player.Visited)
+
+	assert.Equal(t, time.Duration(3725.5*float64(time.Second)), player.TimePlayed)
+	assert.Equal(t, []flhash.HashCode{2412156096}, player.SystemsVisited)
+	assert.Equal(t, []ShipKills{{ID: 2151746432, Quantity: 3}}, player.ShipKills)
+	assert.Equal(t, 2022, player.Timestamp.Year())
+}
+
+func TestReadEncryptedSave(t *testing.T) {
+	path := utils_os.GetCurrrentTestFolder().Join("plain.fl")
+	data, err := os.ReadFile(path.ToString())
+	assert.Nil(t, err)
+
+	encrypted := Encrypt(data)
+	assert.True(t, IsEncrypted(encrypted))
+	assert.NotContains(t, string(encrypted), "[Player]")
+	assert.Equal(t, data, Decrypt(encrypted))
+
+	player, err := Read(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, "Trent", player.Name)
+}
+
+func TestReadNotSave(t *testing.T) {
+	_, err := Read([]byte("[Ship]\nnickname = li_elite\n"))
+	assert.ErrorIs(t, err, ErrNotSaveFile)
+}
+
+func TestCodepointString(t *testing.T) {
+	assert.Equal(t, "Жук", CPSFromString("Жук").String())
+	stamp := TStampFromTime(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	assert.Equal(t, time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC), stamp.Time())
+}
@@ -0,0 +1,38 @@
+package filesave
+
+import "bytes"
+
+/*
+FLS1 is encryption of single player and local server saves.
+File starts with FLS1 magic, and every byte after it is xored with key derived from "Gene" and byte position.
+Algorithm is from flcodec by sherlog@t-online.de
+*/
+
+const FLS1Magic = "FLS1"
+
+var fls1Gene = []byte("Gene")
+
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(FLS1Magic))
+}
+
+func fls1Xor(data []byte) []byte {
+	result := make([]byte, len(data))
+	for index, value := range data {
+		key := byte((int(fls1Gene[index%len(fls1Gene)]) + index) % 256)
+		result[index] = value ^ (key | 0x80)
+	}
+	return result
+}
+
+// Decrypt returns save content in plain text. Not encrypted saves are returned as is
+func Decrypt(data []byte) []byte {
+	if !IsEncrypted(data) {
+		return data
+	}
+	return fls1Xor(data[len(FLS1Magic):])
+}
+
+func Encrypt(data []byte) []byte {
+	return append([]byte(FLS1Magic), fls1Xor(data)...)
+}
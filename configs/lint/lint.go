@@ -0,0 +1,284 @@
+/*
+Package lint finds broken cross references between mod configs,
+like market selling goods which do not exist, or goods pointing to missing equipment.
+*/
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/universe_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
+	"github.com/darklab8/go-utils/utils/utils_types"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+type Category string
+
+const (
+	CategoryGoodEquipment   Category = "good_missing_equipment"
+	CategoryGoodShip        Category = "good_missing_ship"
+	CategoryMarketGood      Category = "market_unknown_good"
+	CategoryMarketBase      Category = "market_unknown_base"
+	CategoryBaseObject      Category = "base_without_system_object"
+	CategoryBaseSystem      Category = "base_unknown_system"
+	CategoryMissingInfoname Category = "missing_ids_name"
+	CategoryTechcompatItem  Category = "techcompat_unknown_item"
+	CategoryTechcompatGroup Category = "techcompat_unknown_group"
+)
+
+type Finding struct {
+	Severity Severity             `json:"severity"`
+	Category Category             `json:"category"`
+	Message  string               `json:"message"`
+	Filepath utils_types.FilePath `json:"filepath"`
+	Section  string               `json:"section"`
+	Nickname string               `json:"nickname"`
+}
+
+func (f Finding) String() string {
+	var location string
+	if f.Filepath != "" {
+		location = f.Filepath.ToString()
+	}
+	if f.Section != "" {
+		location += " " + f.Section
+	}
+	if f.Nickname != "" {
+		location += " nickname=" + f.Nickname
+	}
+	return fmt.Sprintf("%s\t%s\t%s\t%s", f.Severity, f.Category, strings.TrimSpace(location), f.Message)
+}
+
+type Report struct {
+	Findings []Finding `json:"findings"`
+}
+
+func (r *Report) add(severity Severity, category Category, model semantic.Model, nickname string, msg string, args ...any) {
+	finding := Finding{
+		Severity: severity,
+		Category: category,
+		Message:  fmt.Sprintf(msg, args...),
+		Nickname: nickname,
+	}
+	if section := model.RenderModel(); section != nil {
+		finding.Section = string(section.OriginalType)
+		if section.INIFile != nil {
+			finding.Filepath = section.INIFile.File.GetFilepath()
+		}
+	}
+	r.Findings = append(r.Findings, finding)
+}
+
+func (r *Report) Count(severity Severity) int {
+	count := 0
+	for _, finding := range r.Findings {
+		if finding.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *Report) HasErrors() bool {
+	return r.Count(SeverityError) > 0
+}
+
+func (r *Report) sort() {
+	sort.SliceStable(r.Findings, func(i, j int) bool {
+		a, b := r.Findings[i], r.Findings[j]
+		if a.Severity != b.Severity {
+			return a.Severity == SeverityError
+		}
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+		if a.Filepath != b.Filepath {
+			return a.Filepath < b.Filepath
+		}
+		return a.Nickname < b.Nickname
+	})
+}
+
+/*
+Lint checks references between parsed configs.
+Errors are references which break the game or darkstat,
+warnings are suspicious places which are still legitimate in some mods.
+*/
+func Lint(m *configs_mapped.MappedConfigs) *Report {
+	report := &Report{Findings: make([]Finding, 0)}
+	lintGoods(m, report)
+	lintMarkets(m, report)
+	lintBases(m, report)
+	lintInfonames(m, report)
+	lintTechcompat(m, report)
+	report.sort()
+	return report
+}
+
+func lintGoods(m *configs_mapped.MappedConfigs, report *Report) {
+	equip := m.Equip()
+	for _, good := range m.Goods.Goods {
+		nickname, _ := good.Nickname.GetValue()
+		category, _ := good.Category.GetValue()
+		if category != "equipment" {
+			continue
+		}
+		section := good.RenderModel()
+		equipment, ok := section.ParamMap[cfg.Key("equipment")]
+		if !ok || len(equipment) == 0 {
+			report.add(SeverityError, CategoryGoodEquipment, good.Model, nickname, "equipment good has no equipment param")
+			continue
+		}
+		equipment_nick := strings.ToLower(equipment[0].First.AsString())
+		if _, ok := equip.ItemsMap[equipment_nick]; !ok {
+			report.add(SeverityError, CategoryGoodEquipment, good.Model, nickname, "equipment %q is not found in equipment files", equipment_nick)
+		}
+	}
+
+	for _, hull := range m.Goods.ShipHulls {
+		nickname, _ := hull.Nickname.GetValue()
+		ship, ok := hull.Ship.GetValue()
+		if !ok {
+			continue
+		}
+		ship = strings.ToLower(ship)
+		if _, ok := m.Shiparch.ShipsMap[ship]; !ok {
+			report.add(SeverityError, CategoryGoodShip, hull.Model, nickname, "ship %q is not found in shiparch", ship)
+		}
+	}
+	for _, ship := range m.Goods.Ships {
+		nickname, _ := ship.Nickname.GetValue()
+		hull, ok := ship.Hull.GetValue()
+		if !ok {
+			continue
+		}
+		hull = strings.ToLower(hull)
+		if _, ok := m.Goods.ShipHullsMap[hull]; !ok {
+			report.add(SeverityError, CategoryGoodShip, ship.Model, nickname, "hull %q is not found in goods", hull)
+		}
+		for _, addon := range ship.Addons {
+			item, ok := addon.ItemNickname.GetValue()
+			if !ok {
+				continue
+			}
+			item = strings.ToLower(item)
+			if _, ok := equip.ItemsMap[item]; !ok {
+				report.add(SeverityError, CategoryGoodEquipment, ship.Model, nickname, "addon %q is not found in equipment files", item)
+			}
+		}
+	}
+}
+
+func lintMarkets(m *configs_mapped.MappedConfigs, report *Report) {
+	for _, base_good := range m.Market().BaseGoods {
+		base, _ := base_good.Base.GetValue()
+		base = strings.ToLower(base)
+		if _, ok := m.Universe.BasesMap[universe_mapped.BaseNickname(base)]; !ok {
+			report.add(SeverityWarning, CategoryMarketBase, base_good.Model, base, "market is defined for base missing in universe")
+		}
+		for _, market_good := range base_good.MarketGoods {
+			good, ok := market_good.Nickname.GetValue()
+			if !ok {
+				continue
+			}
+			good = strings.ToLower(good)
+			if _, ok := m.Goods.GoodsMap[good]; !ok {
+				report.add(SeverityError, CategoryMarketGood, base_good.Model, base, "market good %q is not found in goods", good)
+			}
+		}
+	}
+}
+
+func lintBases(m *configs_mapped.MappedConfigs, report *Report) {
+	for _, base := range m.Universe.Bases {
+		nickname, _ := base.Nickname.GetValue()
+		system, _ := base.System.GetValue()
+		system = strings.ToLower(system)
+		if _, ok := m.Universe.SystemMap[universe_mapped.SystemNickname(system)]; !ok {
+			report.add(SeverityError, CategoryBaseSystem, base.Model, nickname, "system %q is not found in universe", system)
+			continue
+		}
+		if _, ok := m.Systems.BasesByBases[nickname]; !ok {
+			report.add(SeverityWarning, CategoryBaseObject, base.Model, nickname, "no object in system %q has base = %s", system, nickname)
+		}
+	}
+}
+
+func lintInfonames(m *configs_mapped.MappedConfigs, report *Report) {
+	if m.Infocards == nil {
+		return
+	}
+	check := func(model semantic.Model, nickname string, key string, ids_name *semantic.Int) {
+		if ids_name == nil {
+			return
+		}
+		id, ok := ids_name.GetValue()
+		if !ok || id == 0 {
+			return
+		}
+		if _, ok := m.Infocards.Infonames[id]; !ok {
+			report.add(SeverityWarning, CategoryMissingInfoname, model, nickname, "%s %d is not found in infocards", key, id)
+		}
+	}
+
+	for _, item := range m.Equip().Items {
+		nickname, _ := item.Nickname.GetValue()
+		check(item.Model, nickname, "ids_name", item.IdsName)
+	}
+	for _, ship := range m.Shiparch.Ships {
+		nickname, _ := ship.Nickname.GetValue()
+		check(ship.Model, nickname, "ids_name", ship.IdsName)
+	}
+	for _, base := range m.Universe.Bases {
+		nickname, _ := base.Nickname.GetValue()
+		check(base.Model, nickname, "strid_name", base.StridName)
+	}
+}
+
+func lintTechcompat(m *configs_mapped.MappedConfigs, report *Report) {
+	if m.Discovery == nil || m.Discovery.Techcompat == nil {
+		return
+	}
+	equip := m.Equip()
+	techcompat := m.Discovery.Techcompat
+	for _, group := range techcompat.TechGroups {
+		for _, item := range group.Items {
+			nickname, ok := item.GetValue()
+			if !ok {
+				continue
+			}
+			nickname = strings.ToLower(nickname)
+			_, is_equipment := equip.ItemsMap[nickname]
+			_, is_ship := m.Shiparch.ShipsMap[nickname]
+			if !is_equipment && !is_ship {
+				report.add(SeverityError, CategoryTechcompatItem, group.Model, nickname, "techcompat item is not found in equipment or shiparch")
+			}
+		}
+	}
+	for _, faction := range techcompat.Factions {
+		id, _ := faction.ID.GetValue()
+		if _, ok := equip.ItemsMap[strings.ToLower(id)]; !ok {
+			report.add(SeverityError, CategoryTechcompatItem, faction.Model, id, "techcompat faction ID is not found in equipment")
+		}
+		for _, compat := range faction.TechCompats {
+			group, ok := compat.Nickname.GetValue()
+			if !ok {
+				continue
+			}
+			if _, ok := techcompat.TechGroupByName[group]; !ok {
+				report.add(SeverityError, CategoryTechcompatGroup, faction.Model, id, "tech group %q is not found", group)
+			}
+		}
+	}
+}
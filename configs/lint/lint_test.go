@@ -0,0 +1,31 @@
+package lint
+
+import (
+	"os"
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/go-utils/utils/utils_os"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	mod := os.DirFS(utils_os.GetCurrrentTestFolder().Join("mod").ToString())
+	mapped := configs_mapped.NewMappedConfigs().ReadFS(mod)
+
+	report := Lint(mapped)
+	found := make(map[Category][]string)
+	for _, finding := range report.Findings {
+		found[finding.Category] = append(found[finding.Category], finding.Nickname)
+	}
+
+	assert.True(t, report.HasErrors())
+	assert.Equal(t, []string{"li_gun01_mark02"}, found[CategoryGoodEquipment])
+	// nicknames are case insensitive, mixed case references are not reported
+	assert.Equal(t, []string{"li01_01_base"}, found[CategoryMarketGood])
+	assert.Empty(t, found[CategoryGoodShip])
+	assert.Equal(t, []string{"li01_02_base"}, found[CategoryBaseSystem])
+	assert.Equal(t, []string{"li01_03_base"}, found[CategoryBaseObject])
+	assert.Equal(t, SeverityError, report.Findings[0].Severity)
+	assert.Equal(t, SeverityWarning, report.Findings[len(report.Findings)-1].Severity)
+}
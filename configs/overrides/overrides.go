@@ -1,17 +1,52 @@
 package overrides
 
 import (
-	"os"
+	_ "embed"
+	"strings"
 
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
 	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
-	"github.com/darklab8/go-utils/utils/utils_types"
 	"gopkg.in/yaml.v3"
 )
 
 const FILENAME = "overrides.fl_configs.yml"
 
+/*
+Overrides let mod maintainers tune darkstat for their mod without code changes.
+Every unset value keeps darkstat defaults.
+*/
 type Overrides struct {
 	SystemTravelSpeedMultipliers map[string]float64 `yaml:"system_travel_speed_multilpliers"`
+
+	// Average cruise speeds of ships used for trade route times
+	CruiseSpeeds CruiseSpeeds `yaml:"cruise_speeds"`
+	// Average speed of flying through trade lane
+	TradeLaneSpeed *int `yaml:"trade_lane_speed"`
+
+	// Manual prices per base nickname and good nickname
+	Prices map[string]map[string]Price `yaml:"prices"`
+
+	// Jump gates and holes to treat as locked, in addition to initialworld.ini locked_gate
+	LockedJumps []string `yaml:"locked_jumps"`
+
+	// Bases and items nicknames to exclude from output
+	HiddenBases []string `yaml:"hidden_bases"`
+	HiddenItems []string `yaml:"hidden_items"`
+
+	locked_jumps map[string]bool
+	hidden_bases map[string]bool
+	hidden_items map[string]bool
+}
+
+type CruiseSpeeds struct {
+	Transport *int `yaml:"transport"`
+	Frigate   *int `yaml:"frigate"`
+	Freighter *int `yaml:"freighter"`
+}
+
+type Price struct {
+	BaseSellsFor *int `yaml:"base_sells_for"`
+	BaseBuysFor  *int `yaml:"base_buys_for"`
 }
 
 type InfocardRegion string
@@ -24,16 +59,92 @@ func (o Overrides) GetSystemSpeedMultiplier(system_nickname string) float64 {
 	}
 }
 
-func Read(filepath utils_types.FilePath) Overrides {
-	var config Overrides
-	config.SystemTravelSpeedMultipliers = make(map[string]float64)
+func (o Overrides) GetTradeLaneSpeed(default_speed int) int {
+	if o.TradeLaneSpeed != nil {
+		return *o.TradeLaneSpeed
+	}
+	return default_speed
+}
+
+func (o Overrides) GetPrice(base_nickname string, good_nickname string) (Price, bool) {
+	if goods, ok := o.Prices[strings.ToLower(base_nickname)]; ok {
+		price, ok := goods[strings.ToLower(good_nickname)]
+		return price, ok
+	}
+	return Price{}, false
+}
+
+func (o Overrides) IsLockedJump(object_nickname string) bool {
+	return o.locked_jumps[strings.ToLower(object_nickname)]
+}
+
+func (o Overrides) IsHiddenBase(base_nickname string) bool {
+	return o.hidden_bases[strings.ToLower(base_nickname)]
+}
 
-	data, err := os.ReadFile(filepath.ToString())
+func (o Overrides) IsHiddenItem(item_nickname string) bool {
+	return o.hidden_items[strings.ToLower(item_nickname)]
+}
+
+func toSet(nicknames []string) map[string]bool {
+	result := make(map[string]bool, len(nicknames))
+	for _, nickname := range nicknames {
+		result[strings.ToLower(nickname)] = true
+	}
+	return result
+}
+
+func Read(file *file.File) Overrides {
+	data, err := file.ReadBytes()
 	logus.Log.CheckWarn(err, "overrides for fl configs is not found")
+	return parse(data)
+}
 
-	err = yaml.Unmarshal(data, &config)
+func parse(data []byte) Overrides {
+	var config Overrides
+	config.SystemTravelSpeedMultipliers = make(map[string]float64)
+
+	err := yaml.Unmarshal(data, &config)
 
 	logus.Log.CheckPanic(err, "failed inmarshaling yaml file for overrides")
 
+	prices := make(map[string]map[string]Price, len(config.Prices))
+	for base_nickname, goods := range config.Prices {
+		base_prices := make(map[string]Price, len(goods))
+		for good_nickname, price := range goods {
+			base_prices[strings.ToLower(good_nickname)] = price
+		}
+		prices[strings.ToLower(base_nickname)] = base_prices
+	}
+	config.Prices = prices
+	config.locked_jumps = toSet(config.LockedJumps)
+	config.hidden_bases = toSet(config.HiddenBases)
+	config.hidden_items = toSet(config.HiddenItems)
+
 	return config
 }
+
+//go:embed defaults/flsr.fl_configs.yml
+var flsr_defaults []byte
+
+// FLSRDefaults are overrides Freelancer: Sirius Revival is known to need, shipped with darkstat as regular overrides file
+func FLSRDefaults() Overrides {
+	return parse(flsr_defaults)
+}
+
+// WithDefaults fills speeds not set by mod overrides file from defaults
+func (o Overrides) WithDefaults(defaults Overrides) Overrides {
+	if o.CruiseSpeeds.Transport == nil {
+		o.CruiseSpeeds.Transport = defaults.CruiseSpeeds.Transport
+	}
+	if o.CruiseSpeeds.Frigate == nil {
+		o.CruiseSpeeds.Frigate = defaults.CruiseSpeeds.Frigate
+	}
+	if o.CruiseSpeeds.Freighter == nil {
+		o.CruiseSpeeds.Freighter = defaults.CruiseSpeeds.Freighter
+	}
+	if o.TradeLaneSpeed == nil {
+		o.TradeLaneSpeed = defaults.TradeLaneSpeed
+	}
+	return o
+}
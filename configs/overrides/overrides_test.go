@@ -3,6 +3,7 @@ package overrides
 import (
 	"testing"
 
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
 	"github.com/darklab8/go-utils/utils/utils_filepath"
 	"github.com/darklab8/go-utils/utils/utils_os"
 	"github.com/darklab8/go-utils/utils/utils_types"
@@ -12,8 +13,50 @@ import (
 func TestReadingIt(t *testing.T) {
 	test_directory := utils_os.GetCurrrentTestFolder()
 
-	overrides := Read(utils_types.FilePath(utils_filepath.Join(test_directory, FILENAME)))
+	overrides := Read(file.NewFile(utils_types.FilePath(utils_filepath.Join(test_directory, FILENAME))))
 
 	assert.Equal(t, 0.33, overrides.GetSystemSpeedMultiplier("test_nickname"))
 	assert.Equal(t, 1.0, overrides.GetSystemSpeedMultiplier("another_nickname"))
+
+	assert.Nil(t, overrides.CruiseSpeeds.Transport)
+	assert.Equal(t, 450, *overrides.CruiseSpeeds.Frigate)
+	assert.Equal(t, 3000, overrides.GetTradeLaneSpeed(2250))
+
+	price, ok := overrides.GetPrice("li01_01_base", "commodity_gold")
+	assert.True(t, ok)
+	assert.Equal(t, 700, *price.BaseSellsFor)
+	assert.Nil(t, price.BaseBuysFor)
+	_, ok = overrides.GetPrice("li01_01_base", "commodity_silver")
+	assert.False(t, ok)
+
+	assert.True(t, overrides.IsLockedJump("li01_to_li02"))
+	assert.True(t, overrides.IsHiddenBase("Li01_Test_Base"))
+	assert.True(t, overrides.IsHiddenItem("li_gun01_mark01"))
+	assert.False(t, overrides.IsHiddenItem("li_gun01_mark02"))
+}
+
+func TestEmptyOverridesKeepDefaults(t *testing.T) {
+	var overrides Overrides
+
+	assert.Equal(t, 2250, overrides.GetTradeLaneSpeed(2250))
+	assert.False(t, overrides.IsLockedJump("li01_to_li02"))
+	assert.False(t, overrides.IsHiddenBase("li01_01_base"))
+	_, ok := overrides.GetPrice("li01_01_base", "commodity_gold")
+	assert.False(t, ok)
+}
+
+func TestWithFLSRDefaults(t *testing.T) {
+	test_directory := utils_os.GetCurrrentTestFolder()
+
+	overrides := Read(file.NewFile(utils_types.FilePath(utils_filepath.Join(test_directory, FILENAME)))).WithDefaults(FLSRDefaults())
+
+	// mod file takes priority over defaults
+	assert.Equal(t, 450, *overrides.CruiseSpeeds.Frigate)
+	assert.Equal(t, 3000, overrides.GetTradeLaneSpeed(2250))
+	// missing in mod file are taken from defaults
+	assert.Equal(t, 500, *overrides.CruiseSpeeds.Transport)
+	assert.Equal(t, 500, *overrides.CruiseSpeeds.Freighter)
+
+	var empty Overrides
+	assert.Equal(t, 5000, empty.WithDefaults(FLSRDefaults()).GetTradeLaneSpeed(2250))
 }
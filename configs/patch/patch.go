@@ -0,0 +1,421 @@
+/*
+Package patch applies declarative batch edits to configs, like changing good prices or market contents.
+Patches are written in YAML or JSON and applied through semantic models,
+so only changed params are rendered anew and the rest of files is written back untouched.
+
+	operations:
+	  - op: set_good_price
+	    good: commodity_gold
+	    value: 500
+	  - op: add_market_good
+	    base: li01_01_base
+	    good: li_gun01_mark03
+	    sells: true
+	  - op: set_gun_refire_delay
+	    gun: li_gun01_mark01
+	    value: 0.25
+	  - op: remove_techcompat
+	    group: li_guns
+	    item: li_gun01_mark01
+*/
+package patch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
+	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
+	"github.com/darklab8/go-typelog/typelog"
+	"github.com/darklab8/go-utils/utils/utils_types"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+)
+
+type OpKind string
+
+const (
+	OpSetGoodPrice           OpKind = "set_good_price"
+	OpSetGunRefireDelay      OpKind = "set_gun_refire_delay"
+	OpAddMarketGood          OpKind = "add_market_good"
+	OpRemoveMarketGood       OpKind = "remove_market_good"
+	OpRemoveTechcompat       OpKind = "remove_techcompat"
+	OpSetMarketPriceModifier OpKind = "set_market_price_modifier"
+)
+
+/*
+Operation is single edit. Which fields are used depends on Op:
+  - set_good_price: good, value
+  - set_gun_refire_delay: gun, value
+  - add_market_good: base, good, and optional level, rep, sells, value as price modifier. Existing market good is updated
+  - remove_market_good: base, good
+  - set_market_price_modifier: base, good, value
+  - remove_techcompat: group and item to remove item from [tech] group,
+    or faction (ID nickname) and group to remove tech group from faction
+*/
+type Operation struct {
+	Op      OpKind   `yaml:"op" json:"op"`
+	Good    string   `yaml:"good,omitempty" json:"good,omitempty"`
+	Gun     string   `yaml:"gun,omitempty" json:"gun,omitempty"`
+	Base    string   `yaml:"base,omitempty" json:"base,omitempty"`
+	Group   string   `yaml:"group,omitempty" json:"group,omitempty"`
+	Item    string   `yaml:"item,omitempty" json:"item,omitempty"`
+	Faction string   `yaml:"faction,omitempty" json:"faction,omitempty"`
+	Value   *float64 `yaml:"value,omitempty" json:"value,omitempty"`
+	Level   *int     `yaml:"level,omitempty" json:"level,omitempty"`
+	Rep     *float64 `yaml:"rep,omitempty" json:"rep,omitempty"`
+	Sells   *bool    `yaml:"sells,omitempty" json:"sells,omitempty"`
+}
+
+type Patch struct {
+	Operations []Operation `yaml:"operations" json:"operations"`
+}
+
+// Parse accepts YAML and JSON, as JSON is valid YAML
+func Parse(data []byte) (*Patch, error) {
+	var patch Patch
+	if err := yaml.Unmarshal(data, &patch); err != nil {
+		return nil, fmt.Errorf("failed to parse patch: %w", err)
+	}
+	return &patch, nil
+}
+
+func ReadFile(path utils_types.FilePath) (*Patch, error) {
+	data, err := file.NewFile(path).ReadBytes()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+func (o Operation) requireValue() (float64, error) {
+	if o.Value == nil {
+		return 0, fmt.Errorf("value is required")
+	}
+	return *o.Value, nil
+}
+
+func (o Operation) apply(m *configs_mapped.MappedConfigs) error {
+	switch o.Op {
+	case OpSetGoodPrice:
+		good, ok := m.Goods.GoodsMap[strings.ToLower(o.Good)]
+		if !ok {
+			return fmt.Errorf("good %q is not found", o.Good)
+		}
+		value, err := o.requireValue()
+		if err != nil {
+			return err
+		}
+		good.Price.Set(int(value))
+	case OpSetGunRefireDelay:
+		gun, ok := m.Equip().GunMap[strings.ToLower(o.Gun)]
+		if !ok {
+			return fmt.Errorf("gun %q is not found", o.Gun)
+		}
+		value, err := o.requireValue()
+		if err != nil {
+			return err
+		}
+		gun.RefireDelay.Set(value)
+	case OpAddMarketGood:
+		if _, ok := m.Goods.GoodsMap[strings.ToLower(o.Good)]; !ok {
+			return fmt.Errorf("good %q is not found", o.Good)
+		}
+		market_good, err := m.Market().AddMarketGood(cfg.BaseUniNick(strings.ToLower(o.Base)), o.Good)
+		if err != nil {
+			return err
+		}
+		if o.Level != nil {
+			market_good.LevelRequired.Set(*o.Level)
+		}
+		if o.Rep != nil {
+			market_good.RepRequired.Set(*o.Rep)
+		}
+		if o.Sells != nil {
+			market_good.SetBaseSells(*o.Sells)
+		}
+		if o.Value != nil {
+			market_good.PriceModifier.Set(*o.Value)
+		}
+	case OpSetMarketPriceModifier:
+		base_good, ok := m.Market().GoodsPerBase[cfg.BaseUniNick(strings.ToLower(o.Base))]
+		if !ok {
+			return fmt.Errorf("base %s has no market section", o.Base)
+		}
+		market_good, ok := base_good.MarketGoodsMap[strings.ToLower(o.Good)]
+		if !ok {
+			return fmt.Errorf("good %s is not found in market of base %s", o.Good, o.Base)
+		}
+		value, err := o.requireValue()
+		if err != nil {
+			return err
+		}
+		market_good.PriceModifier.Set(value)
+	case OpRemoveMarketGood:
+		return m.Market().RemoveMarketGood(cfg.BaseUniNick(strings.ToLower(o.Base)), o.Good)
+	case OpRemoveTechcompat:
+		if m.Discovery == nil || m.Discovery.Techcompat == nil {
+			return fmt.Errorf("techcompat is available only for Discovery")
+		}
+		if o.Faction != "" {
+			return m.Discovery.Techcompat.RemoveFactionTech(cfg.TractorID(o.Faction), o.Group)
+		}
+		return m.Discovery.Techcompat.RemoveGroupItem(o.Group, o.Item)
+	default:
+		return fmt.Errorf("unknown operation %q", o.Op)
+	}
+	return nil
+}
+
+/*
+patchState is what configs look like after already validated operations,
+so operations can depend on earlier ones, like changing price of market good added by patch.
+*/
+type patchState struct {
+	m             *configs_mapped.MappedConfigs
+	market        map[cfg.BaseUniNick]map[string]bool
+	group_items   map[string]map[string]bool
+	faction_techs map[cfg.TractorID]map[string]bool
+}
+
+func newPatchState(m *configs_mapped.MappedConfigs) *patchState {
+	return &patchState{
+		m:             m,
+		market:        make(map[cfg.BaseUniNick]map[string]bool),
+		group_items:   make(map[string]map[string]bool),
+		faction_techs: make(map[cfg.TractorID]map[string]bool),
+	}
+}
+
+// marketGoods returns goods sold at base, or false if base has no market section
+func (s *patchState) marketGoods(base cfg.BaseUniNick) (map[string]bool, bool) {
+	if goods, ok := s.market[base]; ok {
+		return goods, true
+	}
+	base_good, ok := s.m.Market().GoodsPerBase[base]
+	if !ok {
+		return nil, false
+	}
+	goods := make(map[string]bool, len(base_good.MarketGoodsMap))
+	for nickname := range base_good.MarketGoodsMap {
+		goods[nickname] = true
+	}
+	s.market[base] = goods
+	return goods, true
+}
+
+// groupItems returns lowercased items of tech group, or false if group is not found
+func (s *patchState) groupItems(group string) (map[string]bool, bool) {
+	if items, ok := s.group_items[group]; ok {
+		return items, true
+	}
+	techgroup, ok := s.m.Discovery.Techcompat.TechGroupByName[group]
+	if !ok {
+		return nil, false
+	}
+	items := make(map[string]bool, len(techgroup.Items))
+	for _, item := range techgroup.Items {
+		items[strings.ToLower(item.Get())] = true
+	}
+	s.group_items[group] = items
+	return items, true
+}
+
+// factionTechs returns lowercased tech groups of faction ID, or false if faction is not found
+func (s *patchState) factionTechs(id cfg.TractorID) (map[string]bool, bool) {
+	if techs, ok := s.faction_techs[id]; ok {
+		return techs, true
+	}
+	faction, ok := s.m.Discovery.Techcompat.FactionByID[id]
+	if !ok {
+		return nil, false
+	}
+	techs := make(map[string]bool, len(faction.TechCompats))
+	for _, compat := range faction.TechCompats {
+		techs[strings.ToLower(compat.Nickname.Get())] = true
+	}
+	s.faction_techs[id] = techs
+	return techs, true
+}
+
+// validate checks operation can be applied, without changing configs
+func (o Operation) validate(s *patchState) error {
+	m := s.m
+	switch o.Op {
+	case OpSetGoodPrice:
+		if _, ok := m.Goods.GoodsMap[strings.ToLower(o.Good)]; !ok {
+			return fmt.Errorf("good %q is not found", o.Good)
+		}
+		_, err := o.requireValue()
+		return err
+	case OpSetGunRefireDelay:
+		if _, ok := m.Equip().GunMap[strings.ToLower(o.Gun)]; !ok {
+			return fmt.Errorf("gun %q is not found", o.Gun)
+		}
+		_, err := o.requireValue()
+		return err
+	case OpAddMarketGood:
+		if _, ok := m.Goods.GoodsMap[strings.ToLower(o.Good)]; !ok {
+			return fmt.Errorf("good %q is not found", o.Good)
+		}
+		goods, ok := s.marketGoods(cfg.BaseUniNick(strings.ToLower(o.Base)))
+		if !ok {
+			return fmt.Errorf("base %s has no market section", o.Base)
+		}
+		goods[strings.ToLower(o.Good)] = true
+	case OpSetMarketPriceModifier, OpRemoveMarketGood:
+		goods, ok := s.marketGoods(cfg.BaseUniNick(strings.ToLower(o.Base)))
+		if !ok {
+			return fmt.Errorf("base %s has no market section", o.Base)
+		}
+		if !goods[strings.ToLower(o.Good)] {
+			return fmt.Errorf("good %s is not found in market of base %s", o.Good, o.Base)
+		}
+		if o.Op == OpRemoveMarketGood {
+			delete(goods, strings.ToLower(o.Good))
+			return nil
+		}
+		_, err := o.requireValue()
+		return err
+	case OpRemoveTechcompat:
+		if m.Discovery == nil || m.Discovery.Techcompat == nil {
+			return fmt.Errorf("techcompat is available only for Discovery")
+		}
+		if o.Faction != "" {
+			techs, ok := s.factionTechs(cfg.TractorID(o.Faction))
+			if !ok {
+				return fmt.Errorf("techcompat faction %s is not found", o.Faction)
+			}
+			if !techs[strings.ToLower(o.Group)] {
+				return fmt.Errorf("tech group %s is not found in faction %s", o.Group, o.Faction)
+			}
+			delete(techs, strings.ToLower(o.Group))
+			return nil
+		}
+		items, ok := s.groupItems(o.Group)
+		if !ok {
+			return fmt.Errorf("tech group %s is not found", o.Group)
+		}
+		if !items[strings.ToLower(o.Item)] {
+			return fmt.Errorf("item %s is not found in tech group %s", o.Item, o.Group)
+		}
+		delete(items, strings.ToLower(o.Item))
+	default:
+		return fmt.Errorf("unknown operation %q", o.Op)
+	}
+	return nil
+}
+
+// Validate checks all operations before any of them is applied, so failed patch leaves configs untouched
+func (p *Patch) Validate(m *configs_mapped.MappedConfigs) error {
+	state := newPatchState(m)
+	for index, operation := range p.Operations {
+		if err := operation.validate(state); err != nil {
+			return fmt.Errorf("operation %d (%s): %w", index+1, operation.Op, err)
+		}
+	}
+	return nil
+}
+
+// Apply changes mapped configs in memory. Operations are validated first, and nothing is changed if any of them is invalid
+func (p *Patch) Apply(m *configs_mapped.MappedConfigs) error {
+	if err := p.Validate(m); err != nil {
+		return err
+	}
+	for index, operation := range p.Operations {
+		if err := operation.apply(m); err != nil {
+			return fmt.Errorf("operation %d (%s): %w", index+1, operation.Op, err)
+		}
+	}
+	return nil
+}
+
+type FileDiff struct {
+	Filepath utils_types.FilePath `json:"filepath"`
+	Diff     string               `json:"diff"` // unified diff
+}
+
+type Result struct {
+	Files []FileDiff `json:"files"`
+
+	changed []int // indexes of changed files among rendered ones
+}
+
+func (r *Result) String() string {
+	var sb strings.Builder
+	for _, file := range r.Files {
+		sb.WriteString(file.Diff)
+	}
+	return sb.String()
+}
+
+func render(m *configs_mapped.MappedConfigs, opts ...inireader.WriteOption) []*file.File {
+	opts = append([]inireader.WriteOption{inireader.Lossless()}, opts...)
+	files := m.Render(opts...)
+	if m.Discovery != nil && m.Discovery.Techcompat != nil {
+		files = append(files, m.Discovery.Techcompat.Write(opts...))
+	}
+	return files
+}
+
+/*
+Run applies patch and returns unified diff of every changed file.
+Files are written only when it is not dry run and all operations succeeded.
+Files read from web, like Discovery techcompat, can be only previewed.
+*/
+func (p *Patch) Run(m *configs_mapped.MappedConfigs, is_dry_run configs_mapped.IsDruRun) (*Result, error) {
+	before := render(m, inireader.AsText())
+	if err := p.Apply(m); err != nil {
+		return nil, err
+	}
+	after := render(m, inireader.AsText())
+
+	result := &Result{Files: make([]FileDiff, 0)}
+	for index := range after {
+		old_lines := before[index].ScheduledLines()
+		new_lines := after[index].ScheduledLines()
+		if strings.Join(old_lines, "\n") == strings.Join(new_lines, "\n") {
+			continue
+		}
+		filepath := after[index].GetFilepath()
+		name := filepath.ToString()
+		if name == "" {
+			name = "techcompat.cfg"
+		}
+		diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        toDiffLines(old_lines),
+			B:        toDiffLines(new_lines),
+			FromFile: "a/" + name,
+			ToFile:   "b/" + name,
+			Context:  3,
+		})
+		result.Files = append(result.Files, FileDiff{Filepath: filepath, Diff: diff})
+		result.changed = append(result.changed, index)
+	}
+
+	if is_dry_run {
+		return result, nil
+	}
+
+	// rendered again to keep binary ini files in their format
+	files := render(m)
+	for _, index := range result.changed {
+		if files[index].GetFilepath() == "" {
+			logus.Log.Warn("file is read from web, writing is skipped", typelog.Int("file_index", index))
+			continue
+		}
+		files[index].WriteLines()
+	}
+	return result, nil
+}
+
+func toDiffLines(lines []string) []string {
+	result := make([]string, len(lines))
+	for index, line := range lines {
+		result[index] = line + "\n"
+	}
+	return result
+}
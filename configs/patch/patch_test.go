@@ -0,0 +1,87 @@
+package patch
+
+import (
+	"os"
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/go-utils/utils/utils_os"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatchDryRun(t *testing.T) {
+	test_directory := utils_os.GetCurrrentTestFolder()
+	mapped := configs_mapped.NewMappedConfigs().ReadFS(os.DirFS(test_directory.Join("mod").ToString()))
+
+	patch, err := Parse([]byte(`
+operations:
+  - op: set_good_price
+    good: li_gun01_mark01
+    value: 150
+  - op: set_gun_refire_delay
+    gun: li_gun01_mark02
+    value: 0.25
+  - op: add_market_good
+    base: li01_01_base
+    good: li_gun01_mark02
+    sells: true
+  - op: remove_market_good
+    base: li01_01_base
+    good: li_gun01_mark01
+`))
+	assert.Nil(t, err)
+
+	result, err := patch.Run(mapped, configs_mapped.IsDruRun(true))
+	assert.Nil(t, err)
+	assert.Len(t, result.Files, 3)
+
+	diff := result.String()
+	assert.Contains(t, diff, "-price = 100\n+price = 150\n")
+	assert.Contains(t, diff, "-refire_delay = 0.50\n+refire_delay = 0.25\n")
+	assert.Contains(t, diff, "-MarketGood = li_gun01_mark01, 0, -1, 1, 1, 0, 1 ; sold at Manhattan\n")
+	assert.Contains(t, diff, "+MarketGood = li_gun01_mark02, 0, -1, 1, 1, 0, 1\n")
+
+	market := mapped.Market().GoodsPerBase["li01_01_base"]
+	assert.Len(t, market.MarketGoods, 1)
+	assert.True(t, market.MarketGoodsMap["li_gun01_mark02"].BaseSells())
+}
+
+func TestPatchErrors(t *testing.T) {
+	test_directory := utils_os.GetCurrrentTestFolder()
+	mapped := configs_mapped.NewMappedConfigs().ReadFS(os.DirFS(test_directory.Join("mod").ToString()))
+
+	patch, err := Parse([]byte(`{"operations": [{"op": "set_good_price", "good": "missing_good", "value": 1}]}`))
+	assert.Nil(t, err)
+	_, err = patch.Run(mapped, configs_mapped.IsDruRun(true))
+	assert.ErrorContains(t, err, "operation 1 (set_good_price): good \"missing_good\" is not found")
+}
+
+func TestPatchFailureKeepsConfigs(t *testing.T) {
+	test_directory := utils_os.GetCurrrentTestFolder()
+	mapped := configs_mapped.NewMappedConfigs().ReadFS(os.DirFS(test_directory.Join("mod").ToString()))
+
+	patch, err := Parse([]byte(`
+operations:
+  - op: set_good_price
+    good: li_gun01_mark01
+    value: 150
+  - op: add_market_good
+    base: li01_01_base
+    good: li_gun01_mark02
+  - op: remove_market_good
+    base: li01_01_base
+    good: li_gun01_mark02
+  - op: set_market_price_modifier
+    base: li01_01_base
+    good: li_gun01_mark02
+    value: 2
+`))
+	assert.Nil(t, err)
+	_, err = patch.Run(mapped, configs_mapped.IsDruRun(true))
+	assert.ErrorContains(t, err, "operation 4 (set_market_price_modifier): good li_gun01_mark02 is not found in market of base li01_01_base")
+
+	assert.Equal(t, 100, mapped.Goods.GoodsMap["li_gun01_mark01"].Price.Get())
+	market := mapped.Market().GoodsPerBase["li01_01_base"]
+	assert.Len(t, market.MarketGoods, 1)
+	assert.NotContains(t, market.MarketGoodsMap, "li_gun01_mark02")
+}
@@ -0,0 +1,286 @@
+/*
+Package schema generates JSON Schema of ini sections out of semantic models.
+Ini keys are not part of Go types, they are given to semantic values during mapping,
+so schema is collected by walking already mapped configs. Keys are known only if some model maps them.
+*/
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
+	"github.com/darklab8/go-utils/utils/utils_types"
+)
+
+const SchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+type keyInfo struct {
+	kinds    map[int]semantic.Kind // by order of value in param
+	repeated bool
+	optional bool
+	present  map[*inireader.Section]bool
+}
+
+type sectionInfo struct {
+	model    string
+	header   string
+	keys     map[cfg.ParamKey]*keyInfo
+	sections map[*inireader.Section]bool
+}
+
+// Collector remembers every semantic value met while walking models
+type Collector struct {
+	sections map[string]*sectionInfo
+	visited  map[visitKey]bool
+}
+
+// struct and its first field share address, so type is part of the key
+type visitKey struct {
+	t reflect.Type
+	p uintptr
+}
+
+func NewCollector() *Collector {
+	return &Collector{
+		sections: make(map[string]*sectionInfo),
+		visited:  make(map[visitKey]bool),
+	}
+}
+
+// packages with raw parsed data, which have nothing to describe and are heavy to walk
+var skippedPackages = []string{
+	"parserutils/inireader",
+	"parserutils/iniload",
+	"parserutils/filefind",
+}
+
+func isSkipped(t reflect.Type) bool {
+	for _, pkg := range skippedPackages {
+		if strings.HasSuffix(t.PkgPath(), pkg) {
+			return true
+		}
+	}
+	return false
+}
+
+// Walk goes through exported fields, slices and maps of value in search of semantic values
+func (c *Collector) Walk(value any) {
+	c.walk(reflect.ValueOf(value), "")
+}
+
+func (c *Collector) walk(v reflect.Value, model string) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return
+		}
+		if describer, ok := v.Interface().(semantic.Describer); ok {
+			c.add(model, describer.Describe())
+			return
+		}
+		key := visitKey{t: v.Type(), p: v.Pointer()}
+		if c.visited[key] {
+			return
+		}
+		c.visited[key] = true
+		c.walk(v.Elem(), model)
+	case reflect.Interface:
+		if !v.IsNil() {
+			c.walk(v.Elem(), model)
+		}
+	case reflect.Struct:
+		if isSkipped(v.Type()) {
+			return
+		}
+		// Vect and similar composite values belong to model using them
+		if pkg := v.Type().PkgPath(); pkg != "" && !strings.HasSuffix(pkg, "parserutils/semantic") {
+			model = filepath.Base(pkg)
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				c.walk(v.Field(i), model)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			c.walk(v.Index(i), model)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			c.walk(iter.Value(), model)
+		}
+	}
+}
+
+func (c *Collector) add(model string, descriptor semantic.Descriptor) {
+	if descriptor.Section == nil || descriptor.IsComment {
+		return
+	}
+	header := strings.ToLower(string(descriptor.Section.Type))
+	name := fmt.Sprintf("%s.%s", model, strings.Trim(header, "[]"))
+	section, ok := c.sections[name]
+	if !ok {
+		section = &sectionInfo{
+			model:    model,
+			header:   header,
+			keys:     make(map[cfg.ParamKey]*keyInfo),
+			sections: make(map[*inireader.Section]bool),
+		}
+		c.sections[name] = section
+	}
+	section.sections[descriptor.Section] = true
+
+	key, ok := section.keys[descriptor.Key]
+	if !ok {
+		key = &keyInfo{
+			kinds:   make(map[int]semantic.Kind),
+			present: make(map[*inireader.Section]bool),
+		}
+		section.keys[descriptor.Key] = key
+	}
+	key.kinds[descriptor.Order] = mergeKinds(key.kinds[descriptor.Order], descriptor.Kind)
+	if descriptor.Index > 0 {
+		key.repeated = true
+	}
+	if descriptor.Optional {
+		key.optional = true
+	}
+	if len(descriptor.Section.ParamMap[descriptor.Key]) > 0 {
+		key.present[descriptor.Section] = true
+	}
+}
+
+// mergeKinds picks kind accepting both, when different models map the same value differently
+func mergeKinds(old semantic.Kind, new semantic.Kind) semantic.Kind {
+	if old == "" || old == new {
+		return new
+	}
+	numeric := map[semantic.Kind]bool{semantic.KindInt: true, semantic.KindFloat: true, semantic.KindIntBool: true}
+	if numeric[old] && numeric[new] {
+		return semantic.KindFloat
+	}
+	return semantic.KindString
+}
+
+func kindSchema(kind semantic.Kind) map[string]any {
+	switch kind {
+	case semantic.KindIntBool:
+		return map[string]any{"type": "integer", "enum": []int{0, 1}}
+	case semantic.KindPath:
+		return map[string]any{"type": "string", "format": "path"}
+	case "":
+		return map[string]any{}
+	}
+	return map[string]any{"type": string(kind)}
+}
+
+func (k *keyInfo) schema() map[string]any {
+	max_order := 0
+	for order := range k.kinds {
+		if order > max_order {
+			max_order = order
+		}
+	}
+
+	var result map[string]any
+	if max_order == 0 {
+		result = kindSchema(k.kinds[0])
+	} else {
+		// values separated by comma, positions not mapped by any model accept anything
+		items := make([]map[string]any, max_order+1)
+		for order := range items {
+			items[order] = kindSchema(k.kinds[order])
+		}
+		result = map[string]any{"type": "array", "prefixItems": items}
+	}
+
+	if k.repeated {
+		result = map[string]any{"type": "array", "items": result}
+	}
+	return result
+}
+
+// Schema is JSON Schema document of single ini section type
+type Schema map[string]any
+
+func (s *sectionInfo) schema(name string) Schema {
+	properties := make(map[string]any)
+	required := make([]string, 0)
+	for key, info := range s.keys {
+		properties[string(key)] = info.schema()
+		if !info.optional && len(info.present) == len(s.sections) {
+			required = append(required, string(key))
+		}
+	}
+	sort.Strings(required)
+
+	return Schema{
+		"$schema":              SchemaDraft,
+		"$id":                  name,
+		"title":                s.header,
+		"description":          fmt.Sprintf("Section %s as it is read by %s", s.header, s.model),
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": true,
+	}
+}
+
+// Schemas are keyed by model package and section type, like equip_mapped.gun
+type Schemas map[string]Schema
+
+func (c *Collector) Schemas() Schemas {
+	result := make(Schemas)
+	for name, section := range c.sections {
+		result[name] = section.schema(name)
+	}
+	return result
+}
+
+// Generate describes all sections mapped by darkstat
+func Generate(m *configs_mapped.MappedConfigs) Schemas {
+	collector := NewCollector()
+	collector.Walk(m)
+	collector.Walk(m.Market())
+	collector.Walk(m.Equip())
+	return collector.Schemas()
+}
+
+// Bundle puts all schemas into single document under $defs
+func (s Schemas) Bundle() map[string]any {
+	defs := make(map[string]any)
+	for name, schema := range s {
+		defs[name] = schema
+	}
+	return map[string]any{
+		"$schema": SchemaDraft,
+		"$defs":   defs,
+	}
+}
+
+// WriteFolder writes each schema to its own file, named as <model>.<section>.schema.json
+func (s Schemas) WriteFolder(folder utils_types.FilePath) error {
+	if err := os.MkdirAll(folder.ToString(), 0755); err != nil {
+		return err
+	}
+	for name, schema := range s {
+		data, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(folder.Join(name+".schema.json").ToString(), data, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
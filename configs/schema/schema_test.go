@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/inireader"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
+	"github.com/stretchr/testify/assert"
+)
+
+type testGood struct {
+	semantic.Model
+	Nickname *semantic.String
+	Level    *semantic.Int
+	Rep      *semantic.Float
+}
+
+type testBase struct {
+	semantic.Model
+	Base     *semantic.String
+	Pos      *semantic.Vect
+	Locked   *semantic.Bool
+	Goods    []*testGood
+	hidden   *semantic.String
+	Messages []*semantic.String
+}
+
+func TestGenerate(t *testing.T) {
+	content := `[BaseGood]
+base = li01_01_base
+pos = 1, 2, 3
+marketgood = li_gun01_mark01, 0, -1
+marketgood = li_gun01_mark02, 0, -1
+message = hello`
+	config := inireader.Read(file.NewMemoryFile(strings.Split(content, "\n")))
+	section := config.Sections[0]
+
+	base := &testBase{
+		Base:   semantic.NewString(section, cfg.Key("base")),
+		Pos:    semantic.NewVector(section, cfg.Key("pos"), semantic.Precision(2)),
+		Locked: semantic.NewBool(section, cfg.Key("locked"), semantic.IntBool, semantic.Optional()),
+		hidden: semantic.NewString(section, cfg.Key("hidden")),
+	}
+	base.Map(section)
+	for index := range section.ParamMap[cfg.Key("marketgood")] {
+		good := &testGood{
+			Nickname: semantic.NewString(section, cfg.Key("marketgood"), semantic.OptsS(semantic.Index(index))),
+			Level:    semantic.NewInt(section, cfg.Key("marketgood"), semantic.Index(index), semantic.Order(1)),
+			Rep:      semantic.NewFloat(section, cfg.Key("marketgood"), semantic.Precision(2), semantic.OptsF(semantic.Index(index), semantic.Order(2))),
+		}
+		good.Map(section)
+		base.Goods = append(base.Goods, good)
+	}
+	base.Messages = append(base.Messages, semantic.NewString(section, cfg.Key("message")))
+
+	collector := NewCollector()
+	collector.Walk(base)
+	schemas := collector.Schemas()
+
+	schema, ok := schemas["schema.basegood"]
+	if !assert.True(t, ok) {
+		return
+	}
+	data, err := json.Marshal(schema)
+	assert.Nil(t, err)
+
+	var decoded struct {
+		Title      string                     `json:"title"`
+		Properties map[string]json.RawMessage `json:"properties"`
+		Required   []string                   `json:"required"`
+	}
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "[basegood]", decoded.Title)
+	assert.Equal(t, []string{"base", "marketgood", "message", "pos"}, decoded.Required)
+	assert.NotContains(t, decoded.Properties, "hidden")
+
+	assert.JSONEq(t, `{"type": "string"}`, string(decoded.Properties["base"]))
+	assert.JSONEq(t, `{"type": "integer", "enum": [0, 1]}`, string(decoded.Properties["locked"]))
+	assert.JSONEq(t, `{"type": "array", "prefixItems": [{"type": "number"}, {"type": "number"}, {"type": "number"}]}`, string(decoded.Properties["pos"]))
+	assert.JSONEq(t, `{"type": "array", "items": {"type": "array", "prefixItems": [{"type": "string"}, {"type": "integer"}, {"type": "number"}]}}`, string(decoded.Properties["marketgood"]))
+}
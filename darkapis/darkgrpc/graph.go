@@ -9,15 +9,18 @@ import (
 
 func (s *Server) GetGraphPaths(_ context.Context, in *pb.GetGraphPathsInput) (*pb.GetGraphPathsReply, error) {
 	if s.app_data != nil {
-		s.app_data.Lock()
-		defer s.app_data.Unlock()
+		s.app_data.RLock()
+		defer s.app_data.RUnlock()
 	}
 
 	var input_queries []appdata.GraphPathReq
 	for _, query := range in.Queries {
 		input_queries = append(input_queries, appdata.GraphPathReq{
-			From: query.From,
-			To:   query.To,
+			From:        query.From,
+			To:          query.To,
+			Ship:        query.Ship,
+			DiscoveryID: query.DiscoveryId,
+			Reputations: query.Reputations,
 		})
 	}
 	answers := s.app_data.GetGraphPaths(input_queries)
@@ -40,8 +43,11 @@ func NewGraphQuery(Query *appdata.GraphPathReq) *pb.GraphPathQuery {
 	}
 
 	return &pb.GraphPathQuery{
-		From: Query.From,
-		To:   Query.To,
+		From:        Query.From,
+		To:          Query.To,
+		Ship:        Query.Ship,
+		DiscoveryId: Query.DiscoveryID,
+		Reputations: Query.Reputations,
 	}
 }
 
@@ -54,5 +60,60 @@ func NewGraphTimeAnswer(Time *appdata.GraphPathTime) *pb.GraphPathTime {
 		Transport: NewInt64(Time.Transport),
 		Frigate:   NewInt64(Time.Frigate),
 		Freighter: NewInt64(Time.Freighter),
+		Ship:      NewInt64(Time.Ship),
 	}
 }
+
+func (s *Server) GetGraphRouteDetails(_ context.Context, in *pb.GetGraphPathsInput) (*pb.GetGraphRouteDetailsReply, error) {
+	if s.app_data != nil {
+		s.app_data.RLock()
+		defer s.app_data.RUnlock()
+	}
+
+	var input_queries []appdata.GraphPathReq
+	for _, query := range in.Queries {
+		input_queries = append(input_queries, appdata.GraphPathReq{
+			From:        query.From,
+			To:          query.To,
+			Ship:        query.Ship,
+			DiscoveryID: query.DiscoveryId,
+			Reputations: query.Reputations,
+		})
+	}
+	answers := s.app_data.GetGraphRouteDetails(input_queries)
+	var grpc_answers []*pb.GetGraphRouteDetailsAnswer
+
+	for _, answer := range answers {
+		grpc_answers = append(grpc_answers, &pb.GetGraphRouteDetailsAnswer{
+			Route:     NewGraphQuery(&answer.Query),
+			Transport: NewGraphRouteDetails(answer.Transport),
+			Frigate:   NewGraphRouteDetails(answer.Frigate),
+			Freighter: NewGraphRouteDetails(answer.Freighter),
+			Ship:      NewGraphRouteDetails(answer.Ship),
+			Error:     answer.Error,
+		})
+	}
+
+	return &pb.GetGraphRouteDetailsReply{Answers: grpc_answers}, nil
+}
+
+func NewGraphRouteDetails(details *appdata.GraphRouteDetails) *pb.GraphRouteDetails {
+	if details == nil {
+		return nil
+	}
+
+	result := &pb.GraphRouteDetails{Time: int64(details.Time)}
+	for _, waypoint := range details.Waypoints {
+		result.Waypoints = append(result.Waypoints, &pb.GraphRouteWaypoint{
+			Nickname:       waypoint.Nickname,
+			Name:           waypoint.Name,
+			SystemNickname: waypoint.SystemNickname,
+			SystemName:     waypoint.SystemName,
+			SectorCoord:    waypoint.SectorCoord,
+			Pos:            NewPos(&waypoint.Pos),
+			Segment:        string(waypoint.Segment),
+			Time:           int64(waypoint.Time),
+		})
+	}
+	return result
+}
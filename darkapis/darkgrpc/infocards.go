@@ -10,8 +10,8 @@ import (
 
 func (s *Server) GetInfocards(_ context.Context, in *pb.GetInfocardsInput) (*pb.GetInfocardsReply, error) {
 	if s.app_data != nil {
-		s.app_data.Lock()
-		defer s.app_data.Unlock()
+		s.app_data.RLock()
+		defer s.app_data.RUnlock()
 	}
 
 	var outputs []*pb.GetInfocardAnswer
@@ -30,13 +30,16 @@ func NewInfocard(info configs_export.Infocard) *pb.Infocard {
 	result := &pb.Infocard{}
 
 	for _, line := range info {
-		line_to_add := &pb.InfocardLine{}
+		line_to_add := &pb.InfocardLine{Justify: string(line.Justify)}
 
 		for _, phrase := range line.Phrases {
 			phrase_to_add := &pb.InfocardPhrase{
-				Phrase: phrase.Phrase,
-				Link:   phrase.Link,
-				Bold:   phrase.Bold,
+				Phrase:    phrase.Phrase,
+				Link:      phrase.Link,
+				Bold:      phrase.Bold,
+				Italic:    phrase.Italic,
+				Underline: phrase.Underline,
+				Color:     phrase.Color,
 			}
 			line_to_add.Phrases = append(line_to_add.Phrases, phrase_to_add)
 		}
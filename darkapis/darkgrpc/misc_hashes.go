@@ -14,6 +14,7 @@ import (
 	"github.com/darklab8/fl-darkstat/configs/configs_settings"
 	pb "github.com/darklab8/fl-darkstat/darkapis/darkgrpc/statproto"
 	"github.com/darklab8/fl-darkstat/darkstat/appdata"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
 	"github.com/darklab8/fl-darkstat/darkstat/settings"
 )
 
@@ -37,6 +38,7 @@ func GetHashesData(app_data *appdata.AppData) map[string]Hash {
 	hashes = make(map[string]Hash)
 
 	filesystem := filefind.FindConfigs(settings.Env.FreelancerFolder)
+	defer filesystem.Close()
 
 	var wg sync.WaitGroup
 	var mu sync.Mutex
@@ -71,6 +73,7 @@ func GetHashesData(app_data *appdata.AppData) map[string]Hash {
 	runtime.GC()
 
 	filesystem = filefind.FindConfigs(configs_settings.Env.FreelancerFolder)
+	defer filesystem.Close()
 	fileref := filesystem.GetFile(initialworld.FILENAME)
 	InitialWorld := initialworld.Read(iniload.NewLoader(fileref).Scan())
 
@@ -88,8 +91,8 @@ func GetHashesData(app_data *appdata.AppData) map[string]Hash {
 
 func (s *Server) GetHashes(_ context.Context, in *pb.Empty) (*pb.GetHashesReply, error) {
 	if s.app_data != nil {
-		s.app_data.Lock()
-		defer s.app_data.Unlock()
+		s.app_data.RLock()
+		defer s.app_data.RUnlock()
 	}
 
 	answer := &pb.GetHashesReply{HashesByNick: make(map[string]*pb.Hash)}
@@ -106,3 +109,46 @@ func (s *Server) GetHashes(_ context.Context, in *pb.Empty) (*pb.GetHashesReply,
 
 	return answer, nil
 }
+
+func NewResolvedHashEntries(entries []configs_export.ResolvedHashEntry) []*pb.ResolvedHashEntry {
+	result := make([]*pb.ResolvedHashEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, &pb.ResolvedHashEntry{
+			Nickname: entry.Nickname,
+			Kind:     entry.Kind,
+			Section:  entry.Section,
+		})
+	}
+	return result
+}
+
+func (s *Server) ResolveHashes(_ context.Context, in *pb.ResolveHashesInput) (*pb.ResolveHashesReply, error) {
+	if s.app_data != nil {
+		s.app_data.RLock()
+		defer s.app_data.RUnlock()
+	}
+
+	answer := &pb.ResolveHashesReply{}
+	for _, resolved := range s.app_data.Configs.ResolveHashes(in.Hashes) {
+		answer.Items = append(answer.Items, &pb.ResolvedHash{
+			Query:   resolved.Query,
+			Int32:   resolved.Int32,
+			Uint32:  resolved.Uint32,
+			Hex:     resolved.Hex,
+			Found:   resolved.Found,
+			Error:   resolved.Error,
+			Entries: NewResolvedHashEntries(resolved.Entries),
+		})
+	}
+	for _, collision := range s.app_data.Configs.HashCollisions() {
+		entries := make([]configs_export.ResolvedHashEntry, 0, len(collision.Entries))
+		for _, entry := range collision.Entries {
+			entries = append(entries, configs_export.ResolvedHashEntry{Nickname: entry.Nickname, Kind: string(entry.Kind), Section: entry.Section})
+		}
+		answer.Collisions = append(answer.Collisions, &pb.HashCollision{
+			Hex:     collision.Hash.ToHexStr(),
+			Entries: NewResolvedHashEntries(entries),
+		})
+	}
+	return answer, nil
+}
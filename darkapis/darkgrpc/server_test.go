@@ -67,6 +67,38 @@ func TestRpc(t *testing.T) {
 			assert.Greater(t, *res.Answers[0].Time.Transport, int64(0))
 		})
 
+		t.Run("GetGraphRouteDetails", func(t *testing.T) {
+			res, err := c.GetGraphRouteDetails(context.Background(), &statproto.GetGraphPathsInput{
+				Queries: []*statproto.GraphPathQuery{{
+					From: string(res.Items[0].Nickname),
+					To:   string(res.Items[1].Nickname),
+				}},
+			})
+			logus.Log.CheckPanic(err, "error making rpc call to get items: %s\n", typelog.OptError(err))
+			assert.Equal(t, 1, len(res.Answers))
+			assert.Nil(t, res.Answers[0].Error)
+			waypoints := res.Answers[0].Freighter.Waypoints
+			assert.Equal(t, "dock", waypoints[len(waypoints)-1].Segment)
+			assert.Greater(t, res.Answers[0].Freighter.Time, int64(0))
+		})
+
+		t.Run("GetTradeRoutes", func(t *testing.T) {
+			from := res.Items[0].Nickname
+			res, err := c.GetTradeRoutes(context.Background(), &statproto.GetTradeRoutesInput{
+				From:     &from,
+				Capacity: &statproto.TradeCapacity{HoldSize: 3000, Credits: 1000000, ShipClass: -1},
+			})
+			logus.Log.CheckPanic(err, "error making rpc call to get items: %s\n", typelog.OptError(err))
+			assert.Nil(t, res.Error)
+			for _, route := range res.Routes {
+				assert.Greater(t, route.Run.Units, int64(0))
+			}
+
+			res, err = c.GetTradeRoutes(context.Background(), &statproto.GetTradeRoutesInput{From: &from})
+			logus.Log.CheckPanic(err, "error making rpc call to get items: %s\n", typelog.OptError(err))
+			assert.NotNil(t, res.Error)
+		})
+
 		t.Run("GetInfocards", func(t *testing.T) {
 			res, err := c.GetInfocards(context.Background(), &statproto.GetInfocardsInput{
 				Nicknames: []string{res.Items[0].Nickname, res.Items[2].Nickname, "not_existing"}})
@@ -140,6 +172,14 @@ func TestRpc(t *testing.T) {
 		assert.Greater(t, len(res.HashesByNick), 0)
 	})
 
+	t.Run("ResolveHashes", func(t *testing.T) {
+		res, err := c.ResolveHashes(context.Background(), &statproto.ResolveHashesInput{Hashes: []string{"fc_freelancer", "4169"}})
+		logus.Log.CheckPanic(err, "error making rpc call to get items: %s\n", typelog.OptError(err))
+		assert.Len(t, res.Items, 2)
+		assert.NotNil(t, res.Items[0].Error)
+		assert.True(t, res.Items[1].Found)
+	})
+
 	t.Run("GetFactions", func(t *testing.T) {
 		res, err := c.GetFactions(context.Background(), &statproto.GetFactionsInput{
 			IncludeReputations: true,
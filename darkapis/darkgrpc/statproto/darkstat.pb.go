@@ -251,8 +251,10 @@ func (x *Infocard) GetLines() []*InfocardLine {
 }
 
 type InfocardLine struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Phrases       []*InfocardPhrase      `protobuf:"bytes,1,rep,name=phrases,proto3" json:"phrases,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Phrases []*InfocardPhrase      `protobuf:"bytes,1,rep,name=phrases,proto3" json:"phrases,omitempty"`
+	// left, center or right
+	Justify       string `protobuf:"bytes,2,opt,name=justify,proto3" json:"justify,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -294,11 +296,22 @@ func (x *InfocardLine) GetPhrases() []*InfocardPhrase {
 	return nil
 }
 
+func (x *InfocardLine) GetJustify() string {
+	if x != nil {
+		return x.Justify
+	}
+	return ""
+}
+
 type InfocardPhrase struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Phrase        string                 `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
-	Link          *string                `protobuf:"bytes,2,opt,name=link,proto3,oneof" json:"link,omitempty"`
-	Bold          bool                   `protobuf:"varint,3,opt,name=bold,proto3" json:"bold,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Phrase    string                 `protobuf:"bytes,1,opt,name=phrase,proto3" json:"phrase,omitempty"`
+	Link      *string                `protobuf:"bytes,2,opt,name=link,proto3,oneof" json:"link,omitempty"`
+	Bold      bool                   `protobuf:"varint,3,opt,name=bold,proto3" json:"bold,omitempty"`
+	Italic    bool                   `protobuf:"varint,4,opt,name=italic,proto3" json:"italic,omitempty"`
+	Underline bool                   `protobuf:"varint,5,opt,name=underline,proto3" json:"underline,omitempty"`
+	// in #RRGGBB format
+	Color         *string `protobuf:"bytes,6,opt,name=color,proto3,oneof" json:"color,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -354,6 +367,27 @@ func (x *InfocardPhrase) GetBold() bool {
 	return false
 }
 
+func (x *InfocardPhrase) GetItalic() bool {
+	if x != nil {
+		return x.Italic
+	}
+	return false
+}
+
+func (x *InfocardPhrase) GetUnderline() bool {
+	if x != nil {
+		return x.Underline
+	}
+	return false
+}
+
+func (x *InfocardPhrase) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
 type HealthReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IsHealthy     bool                   `protobuf:"varint,1,opt,name=is_healthy,json=isHealthy,proto3" json:"is_healthy,omitempty"`
@@ -5158,27 +5192,27 @@ func (x *Hash) GetHex() string {
 	return ""
 }
 
-type GetPoBsReply struct {
+type ResolveHashesInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*PoB                 `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Hashes        []string               `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPoBsReply) Reset() {
-	*x = GetPoBsReply{}
+func (x *ResolveHashesInput) Reset() {
+	*x = ResolveHashesInput{}
 	mi := &file_darkstat_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPoBsReply) String() string {
+func (x *ResolveHashesInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPoBsReply) ProtoMessage() {}
+func (*ResolveHashesInput) ProtoMessage() {}
 
-func (x *GetPoBsReply) ProtoReflect() protoreflect.Message {
+func (x *ResolveHashesInput) ProtoReflect() protoreflect.Message {
 	mi := &file_darkstat_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5190,54 +5224,41 @@ func (x *GetPoBsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPoBsReply.ProtoReflect.Descriptor instead.
-func (*GetPoBsReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveHashesInput.ProtoReflect.Descriptor instead.
+func (*ResolveHashesInput) Descriptor() ([]byte, []int) {
 	return file_darkstat_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetPoBsReply) GetItems() []*PoB {
+func (x *ResolveHashesInput) GetHashes() []string {
 	if x != nil {
-		return x.Items
+		return x.Hashes
 	}
 	return nil
 }
 
-type PoBCore struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Nickname       string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Pos            *string                `protobuf:"bytes,3,opt,name=pos,proto3,oneof" json:"pos,omitempty"`
-	Level          *int64                 `protobuf:"varint,4,opt,name=level,proto3,oneof" json:"level,omitempty"`
-	Money          *int64                 `protobuf:"varint,5,opt,name=money,proto3,oneof" json:"money,omitempty"`
-	Health         *float64               `protobuf:"fixed64,6,opt,name=health,proto3,oneof" json:"health,omitempty"`
-	DefenseMode    *int64                 `protobuf:"varint,7,opt,name=defense_mode,json=defenseMode,proto3,oneof" json:"defense_mode,omitempty"`
-	SystemNick     *string                `protobuf:"bytes,8,opt,name=system_nick,json=systemNick,proto3,oneof" json:"system_nick,omitempty"`
-	SystemName     *string                `protobuf:"bytes,9,opt,name=system_name,json=systemName,proto3,oneof" json:"system_name,omitempty"`
-	FactionNick    *string                `protobuf:"bytes,10,opt,name=faction_nick,json=factionNick,proto3,oneof" json:"faction_nick,omitempty"`
-	FactionName    *string                `protobuf:"bytes,11,opt,name=faction_name,json=factionName,proto3,oneof" json:"faction_name,omitempty"`
-	ForumThreadUrl *string                `protobuf:"bytes,12,opt,name=forum_thread_url,json=forumThreadUrl,proto3,oneof" json:"forum_thread_url,omitempty"`
-	CargoSpaceLeft *int64                 `protobuf:"varint,13,opt,name=cargo_space_left,json=cargoSpaceLeft,proto3,oneof" json:"cargo_space_left,omitempty"`
-	BasePos        *Pos                   `protobuf:"bytes,14,opt,name=base_pos,json=basePos,proto3,oneof" json:"base_pos,omitempty"`
-	SectorCoord    *string                `protobuf:"bytes,15,opt,name=sector_coord,json=sectorCoord,proto3,oneof" json:"sector_coord,omitempty"`
-	Region         *string                `protobuf:"bytes,16,opt,name=region,proto3,oneof" json:"region,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ResolvedHashEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nickname      string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Section       string                 `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PoBCore) Reset() {
-	*x = PoBCore{}
+func (x *ResolvedHashEntry) Reset() {
+	*x = ResolvedHashEntry{}
 	mi := &file_darkstat_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PoBCore) String() string {
+func (x *ResolvedHashEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoBCore) ProtoMessage() {}
+func (*ResolvedHashEntry) ProtoMessage() {}
 
-func (x *PoBCore) ProtoReflect() protoreflect.Message {
+func (x *ResolvedHashEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_darkstat_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5249,146 +5270,147 @@ func (x *PoBCore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoBCore.ProtoReflect.Descriptor instead.
-func (*PoBCore) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolvedHashEntry.ProtoReflect.Descriptor instead.
+func (*ResolvedHashEntry) Descriptor() ([]byte, []int) {
 	return file_darkstat_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *PoBCore) GetNickname() string {
+func (x *ResolvedHashEntry) GetNickname() string {
 	if x != nil {
 		return x.Nickname
 	}
 	return ""
 }
 
-func (x *PoBCore) GetName() string {
+func (x *ResolvedHashEntry) GetKind() string {
 	if x != nil {
-		return x.Name
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *PoBCore) GetPos() string {
-	if x != nil && x.Pos != nil {
-		return *x.Pos
+func (x *ResolvedHashEntry) GetSection() string {
+	if x != nil {
+		return x.Section
 	}
 	return ""
 }
 
-func (x *PoBCore) GetLevel() int64 {
-	if x != nil && x.Level != nil {
-		return *x.Level
-	}
-	return 0
+type ResolvedHash struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Int32         int32                  `protobuf:"varint,2,opt,name=int32,proto3" json:"int32,omitempty"`
+	Uint32        uint32                 `protobuf:"varint,3,opt,name=uint32,proto3" json:"uint32,omitempty"`
+	Hex           string                 `protobuf:"bytes,4,opt,name=hex,proto3" json:"hex,omitempty"`
+	Found         bool                   `protobuf:"varint,5,opt,name=found,proto3" json:"found,omitempty"`
+	Error         *string                `protobuf:"bytes,6,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Entries       []*ResolvedHashEntry   `protobuf:"bytes,7,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PoBCore) GetMoney() int64 {
-	if x != nil && x.Money != nil {
-		return *x.Money
-	}
-	return 0
+func (x *ResolvedHash) Reset() {
+	*x = ResolvedHash{}
+	mi := &file_darkstat_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PoBCore) GetHealth() float64 {
-	if x != nil && x.Health != nil {
-		return *x.Health
-	}
-	return 0
+func (x *ResolvedHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PoBCore) GetDefenseMode() int64 {
-	if x != nil && x.DefenseMode != nil {
-		return *x.DefenseMode
-	}
-	return 0
-}
+func (*ResolvedHash) ProtoMessage() {}
 
-func (x *PoBCore) GetSystemNick() string {
-	if x != nil && x.SystemNick != nil {
-		return *x.SystemNick
+func (x *ResolvedHash) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PoBCore) GetSystemName() string {
-	if x != nil && x.SystemName != nil {
-		return *x.SystemName
-	}
-	return ""
+// Deprecated: Use ResolvedHash.ProtoReflect.Descriptor instead.
+func (*ResolvedHash) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *PoBCore) GetFactionNick() string {
-	if x != nil && x.FactionNick != nil {
-		return *x.FactionNick
+func (x *ResolvedHash) GetQuery() string {
+	if x != nil {
+		return x.Query
 	}
 	return ""
 }
 
-func (x *PoBCore) GetFactionName() string {
-	if x != nil && x.FactionName != nil {
-		return *x.FactionName
+func (x *ResolvedHash) GetInt32() int32 {
+	if x != nil {
+		return x.Int32
 	}
-	return ""
+	return 0
 }
 
-func (x *PoBCore) GetForumThreadUrl() string {
-	if x != nil && x.ForumThreadUrl != nil {
-		return *x.ForumThreadUrl
+func (x *ResolvedHash) GetUint32() uint32 {
+	if x != nil {
+		return x.Uint32
 	}
-	return ""
+	return 0
 }
 
-func (x *PoBCore) GetCargoSpaceLeft() int64 {
-	if x != nil && x.CargoSpaceLeft != nil {
-		return *x.CargoSpaceLeft
+func (x *ResolvedHash) GetHex() string {
+	if x != nil {
+		return x.Hex
 	}
-	return 0
+	return ""
 }
 
-func (x *PoBCore) GetBasePos() *Pos {
+func (x *ResolvedHash) GetFound() bool {
 	if x != nil {
-		return x.BasePos
+		return x.Found
 	}
-	return nil
+	return false
 }
 
-func (x *PoBCore) GetSectorCoord() string {
-	if x != nil && x.SectorCoord != nil {
-		return *x.SectorCoord
+func (x *ResolvedHash) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-func (x *PoBCore) GetRegion() string {
-	if x != nil && x.Region != nil {
-		return *x.Region
+func (x *ResolvedHash) GetEntries() []*ResolvedHashEntry {
+	if x != nil {
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type PoB struct {
+type ResolveHashesReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Core          *PoBCore               `protobuf:"bytes,1,opt,name=core,proto3" json:"core,omitempty"`
-	ShopItems     []*ShopItem            `protobuf:"bytes,2,rep,name=shop_items,json=shopItems,proto3" json:"shop_items,omitempty"`
+	Items         []*ResolvedHash        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Collisions    []*HashCollision       `protobuf:"bytes,2,rep,name=collisions,proto3" json:"collisions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PoB) Reset() {
-	*x = PoB{}
-	mi := &file_darkstat_proto_msgTypes[63]
+func (x *ResolveHashesReply) Reset() {
+	*x = ResolveHashesReply{}
+	mi := &file_darkstat_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PoB) String() string {
+func (x *ResolveHashesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoB) ProtoMessage() {}
+func (*ResolveHashesReply) ProtoMessage() {}
 
-func (x *PoB) ProtoReflect() protoreflect.Message {
-	mi := &file_darkstat_proto_msgTypes[63]
+func (x *ResolveHashesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5399,55 +5421,48 @@ func (x *PoB) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoB.ProtoReflect.Descriptor instead.
-func (*PoB) Descriptor() ([]byte, []int) {
-	return file_darkstat_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use ResolveHashesReply.ProtoReflect.Descriptor instead.
+func (*ResolveHashesReply) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *PoB) GetCore() *PoBCore {
+func (x *ResolveHashesReply) GetItems() []*ResolvedHash {
 	if x != nil {
-		return x.Core
+		return x.Items
 	}
 	return nil
 }
 
-func (x *PoB) GetShopItems() []*ShopItem {
+func (x *ResolveHashesReply) GetCollisions() []*HashCollision {
 	if x != nil {
-		return x.ShopItems
+		return x.Collisions
 	}
 	return nil
 }
 
-type ShopItem struct {
+type HashCollision struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nickname      string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Id            int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
-	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
-	SellPrice     int64                  `protobuf:"varint,7,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
-	MinStock      int64                  `protobuf:"varint,8,opt,name=min_stock,json=minStock,proto3" json:"min_stock,omitempty"`
-	MaxStock      int64                  `protobuf:"varint,9,opt,name=max_stock,json=maxStock,proto3" json:"max_stock,omitempty"`
+	Hex           string                 `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	Entries       []*ResolvedHashEntry   `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShopItem) Reset() {
-	*x = ShopItem{}
-	mi := &file_darkstat_proto_msgTypes[64]
+func (x *HashCollision) Reset() {
+	*x = HashCollision{}
+	mi := &file_darkstat_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShopItem) String() string {
+func (x *HashCollision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShopItem) ProtoMessage() {}
+func (*HashCollision) ProtoMessage() {}
 
-func (x *ShopItem) ProtoReflect() protoreflect.Message {
-	mi := &file_darkstat_proto_msgTypes[64]
+func (x *HashCollision) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5458,96 +5473,974 @@ func (x *ShopItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShopItem.ProtoReflect.Descriptor instead.
-func (*ShopItem) Descriptor() ([]byte, []int) {
-	return file_darkstat_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use HashCollision.ProtoReflect.Descriptor instead.
+func (*HashCollision) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ShopItem) GetNickname() string {
+func (x *HashCollision) GetHex() string {
 	if x != nil {
-		return x.Nickname
+		return x.Hex
 	}
 	return ""
 }
 
-func (x *ShopItem) GetName() string {
+func (x *HashCollision) GetEntries() []*ResolvedHashEntry {
 	if x != nil {
-		return x.Name
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-func (x *ShopItem) GetCategory() string {
-	if x != nil {
-		return x.Category
-	}
-	return ""
+type GetPoBsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PoB                 `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShopItem) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+func (x *GetPoBsReply) Reset() {
+	*x = GetPoBsReply{}
+	mi := &file_darkstat_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShopItem) GetQuantity() int64 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
+func (x *GetPoBsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ShopItem) GetPrice() int64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
+func (*GetPoBsReply) ProtoMessage() {}
 
-func (x *ShopItem) GetSellPrice() int64 {
+func (x *GetPoBsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[66]
 	if x != nil {
-		return x.SellPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ShopItem) GetMinStock() int64 {
-	if x != nil {
+// Deprecated: Use GetPoBsReply.ProtoReflect.Descriptor instead.
+func (*GetPoBsReply) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetPoBsReply) GetItems() []*PoB {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PoBCore struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Nickname       string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Pos            *string                `protobuf:"bytes,3,opt,name=pos,proto3,oneof" json:"pos,omitempty"`
+	Level          *int64                 `protobuf:"varint,4,opt,name=level,proto3,oneof" json:"level,omitempty"`
+	Money          *int64                 `protobuf:"varint,5,opt,name=money,proto3,oneof" json:"money,omitempty"`
+	Health         *float64               `protobuf:"fixed64,6,opt,name=health,proto3,oneof" json:"health,omitempty"`
+	DefenseMode    *int64                 `protobuf:"varint,7,opt,name=defense_mode,json=defenseMode,proto3,oneof" json:"defense_mode,omitempty"`
+	SystemNick     *string                `protobuf:"bytes,8,opt,name=system_nick,json=systemNick,proto3,oneof" json:"system_nick,omitempty"`
+	SystemName     *string                `protobuf:"bytes,9,opt,name=system_name,json=systemName,proto3,oneof" json:"system_name,omitempty"`
+	FactionNick    *string                `protobuf:"bytes,10,opt,name=faction_nick,json=factionNick,proto3,oneof" json:"faction_nick,omitempty"`
+	FactionName    *string                `protobuf:"bytes,11,opt,name=faction_name,json=factionName,proto3,oneof" json:"faction_name,omitempty"`
+	ForumThreadUrl *string                `protobuf:"bytes,12,opt,name=forum_thread_url,json=forumThreadUrl,proto3,oneof" json:"forum_thread_url,omitempty"`
+	CargoSpaceLeft *int64                 `protobuf:"varint,13,opt,name=cargo_space_left,json=cargoSpaceLeft,proto3,oneof" json:"cargo_space_left,omitempty"`
+	BasePos        *Pos                   `protobuf:"bytes,14,opt,name=base_pos,json=basePos,proto3,oneof" json:"base_pos,omitempty"`
+	SectorCoord    *string                `protobuf:"bytes,15,opt,name=sector_coord,json=sectorCoord,proto3,oneof" json:"sector_coord,omitempty"`
+	Region         *string                `protobuf:"bytes,16,opt,name=region,proto3,oneof" json:"region,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PoBCore) Reset() {
+	*x = PoBCore{}
+	mi := &file_darkstat_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoBCore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoBCore) ProtoMessage() {}
+
+func (x *PoBCore) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoBCore.ProtoReflect.Descriptor instead.
+func (*PoBCore) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PoBCore) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *PoBCore) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PoBCore) GetPos() string {
+	if x != nil && x.Pos != nil {
+		return *x.Pos
+	}
+	return ""
+}
+
+func (x *PoBCore) GetLevel() int64 {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return 0
+}
+
+func (x *PoBCore) GetMoney() int64 {
+	if x != nil && x.Money != nil {
+		return *x.Money
+	}
+	return 0
+}
+
+func (x *PoBCore) GetHealth() float64 {
+	if x != nil && x.Health != nil {
+		return *x.Health
+	}
+	return 0
+}
+
+func (x *PoBCore) GetDefenseMode() int64 {
+	if x != nil && x.DefenseMode != nil {
+		return *x.DefenseMode
+	}
+	return 0
+}
+
+func (x *PoBCore) GetSystemNick() string {
+	if x != nil && x.SystemNick != nil {
+		return *x.SystemNick
+	}
+	return ""
+}
+
+func (x *PoBCore) GetSystemName() string {
+	if x != nil && x.SystemName != nil {
+		return *x.SystemName
+	}
+	return ""
+}
+
+func (x *PoBCore) GetFactionNick() string {
+	if x != nil && x.FactionNick != nil {
+		return *x.FactionNick
+	}
+	return ""
+}
+
+func (x *PoBCore) GetFactionName() string {
+	if x != nil && x.FactionName != nil {
+		return *x.FactionName
+	}
+	return ""
+}
+
+func (x *PoBCore) GetForumThreadUrl() string {
+	if x != nil && x.ForumThreadUrl != nil {
+		return *x.ForumThreadUrl
+	}
+	return ""
+}
+
+func (x *PoBCore) GetCargoSpaceLeft() int64 {
+	if x != nil && x.CargoSpaceLeft != nil {
+		return *x.CargoSpaceLeft
+	}
+	return 0
+}
+
+func (x *PoBCore) GetBasePos() *Pos {
+	if x != nil {
+		return x.BasePos
+	}
+	return nil
+}
+
+func (x *PoBCore) GetSectorCoord() string {
+	if x != nil && x.SectorCoord != nil {
+		return *x.SectorCoord
+	}
+	return ""
+}
+
+func (x *PoBCore) GetRegion() string {
+	if x != nil && x.Region != nil {
+		return *x.Region
+	}
+	return ""
+}
+
+type PoB struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Core          *PoBCore               `protobuf:"bytes,1,opt,name=core,proto3" json:"core,omitempty"`
+	ShopItems     []*ShopItem            `protobuf:"bytes,2,rep,name=shop_items,json=shopItems,proto3" json:"shop_items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoB) Reset() {
+	*x = PoB{}
+	mi := &file_darkstat_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoB) ProtoMessage() {}
+
+func (x *PoB) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoB.ProtoReflect.Descriptor instead.
+func (*PoB) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PoB) GetCore() *PoBCore {
+	if x != nil {
+		return x.Core
+	}
+	return nil
+}
+
+func (x *PoB) GetShopItems() []*ShopItem {
+	if x != nil {
+		return x.ShopItems
+	}
+	return nil
+}
+
+type ShopItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nickname      string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Id            int64                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         int64                  `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	SellPrice     int64                  `protobuf:"varint,7,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	MinStock      int64                  `protobuf:"varint,8,opt,name=min_stock,json=minStock,proto3" json:"min_stock,omitempty"`
+	MaxStock      int64                  `protobuf:"varint,9,opt,name=max_stock,json=maxStock,proto3" json:"max_stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShopItem) Reset() {
+	*x = ShopItem{}
+	mi := &file_darkstat_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShopItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShopItem) ProtoMessage() {}
+
+func (x *ShopItem) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShopItem.ProtoReflect.Descriptor instead.
+func (*ShopItem) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ShopItem) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *ShopItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShopItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ShopItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShopItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShopItem) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ShopItem) GetSellPrice() int64 {
+	if x != nil {
+		return x.SellPrice
+	}
+	return 0
+}
+
+func (x *ShopItem) GetMinStock() int64 {
+	if x != nil {
 		return x.MinStock
 	}
-	return 0
+	return 0
+}
+
+func (x *ShopItem) GetMaxStock() int64 {
+	if x != nil {
+		return x.MaxStock
+	}
+	return 0
+}
+
+type GetPoBGoodsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PoBGood             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPoBGoodsReply) Reset() {
+	*x = GetPoBGoodsReply{}
+	mi := &file_darkstat_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPoBGoodsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoBGoodsReply) ProtoMessage() {}
+
+func (x *GetPoBGoodsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoBGoodsReply.ProtoReflect.Descriptor instead.
+func (*GetPoBGoodsReply) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetPoBGoodsReply) GetItems() []*PoBGood {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PoBGood struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Nickname              string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TotalBuyableFromBases int64                  `protobuf:"varint,3,opt,name=total_buyable_from_bases,json=totalBuyableFromBases,proto3" json:"total_buyable_from_bases,omitempty"`
+	TotalSellableToBases  int64                  `protobuf:"varint,4,opt,name=total_sellable_to_bases,json=totalSellableToBases,proto3" json:"total_sellable_to_bases,omitempty"`
+	BestPriceToBuy        *int64                 `protobuf:"varint,5,opt,name=best_price_to_buy,json=bestPriceToBuy,proto3,oneof" json:"best_price_to_buy,omitempty"`
+	BestPriceToSell       *int64                 `protobuf:"varint,6,opt,name=best_price_to_sell,json=bestPriceToSell,proto3,oneof" json:"best_price_to_sell,omitempty"`
+	Category              string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	AnyBaseSells          bool                   `protobuf:"varint,8,opt,name=any_base_sells,json=anyBaseSells,proto3" json:"any_base_sells,omitempty"`
+	AnyBaseBuys           bool                   `protobuf:"varint,9,opt,name=any_base_buys,json=anyBaseBuys,proto3" json:"any_base_buys,omitempty"`
+	Bases                 []*PoBGoodBase         `protobuf:"bytes,10,rep,name=bases,proto3" json:"bases,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PoBGood) Reset() {
+	*x = PoBGood{}
+	mi := &file_darkstat_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoBGood) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoBGood) ProtoMessage() {}
+
+func (x *PoBGood) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoBGood.ProtoReflect.Descriptor instead.
+func (*PoBGood) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PoBGood) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *PoBGood) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PoBGood) GetTotalBuyableFromBases() int64 {
+	if x != nil {
+		return x.TotalBuyableFromBases
+	}
+	return 0
+}
+
+func (x *PoBGood) GetTotalSellableToBases() int64 {
+	if x != nil {
+		return x.TotalSellableToBases
+	}
+	return 0
+}
+
+func (x *PoBGood) GetBestPriceToBuy() int64 {
+	if x != nil && x.BestPriceToBuy != nil {
+		return *x.BestPriceToBuy
+	}
+	return 0
+}
+
+func (x *PoBGood) GetBestPriceToSell() int64 {
+	if x != nil && x.BestPriceToSell != nil {
+		return *x.BestPriceToSell
+	}
+	return 0
+}
+
+func (x *PoBGood) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PoBGood) GetAnyBaseSells() bool {
+	if x != nil {
+		return x.AnyBaseSells
+	}
+	return false
+}
+
+func (x *PoBGood) GetAnyBaseBuys() bool {
+	if x != nil {
+		return x.AnyBaseBuys
+	}
+	return false
+}
+
+func (x *PoBGood) GetBases() []*PoBGoodBase {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+type PoBGoodBase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShopItem      *ShopItem              `protobuf:"bytes,1,opt,name=shop_item,json=shopItem,proto3" json:"shop_item,omitempty"`
+	Base          *PoBCore               `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoBGoodBase) Reset() {
+	*x = PoBGoodBase{}
+	mi := &file_darkstat_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoBGoodBase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoBGoodBase) ProtoMessage() {}
+
+func (x *PoBGoodBase) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoBGoodBase.ProtoReflect.Descriptor instead.
+func (*PoBGoodBase) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PoBGoodBase) GetShopItem() *ShopItem {
+	if x != nil {
+		return x.ShopItem
+	}
+	return nil
+}
+
+func (x *PoBGoodBase) GetBase() *PoBCore {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+type GetGraphPathsInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queries       []*GraphPathQuery      `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGraphPathsInput) Reset() {
+	*x = GetGraphPathsInput{}
+	mi := &file_darkstat_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGraphPathsInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGraphPathsInput) ProtoMessage() {}
+
+func (x *GetGraphPathsInput) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGraphPathsInput.ProtoReflect.Descriptor instead.
+func (*GetGraphPathsInput) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetGraphPathsInput) GetQueries() []*GraphPathQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type GraphPathQuery struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Optional ship nickname, to get time for its cruise speed and docking abilities
+	Ship *string `protobuf:"bytes,3,opt,name=ship,proto3,oneof" json:"ship,omitempty"`
+	// Optional Discovery ID nickname. Routes starting or ending at bases hostile to its rephacks return error. Path between them is not checked
+	DiscoveryId *string `protobuf:"bytes,4,opt,name=discovery_id,json=discoveryId,proto3,oneof" json:"discovery_id,omitempty"`
+	// Optional reputation per faction nickname, used if discovery id is not set
+	Reputations   map[string]float64 `protobuf:"bytes,5,rep,name=reputations,proto3" json:"reputations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphPathQuery) Reset() {
+	*x = GraphPathQuery{}
+	mi := &file_darkstat_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphPathQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphPathQuery) ProtoMessage() {}
+
+func (x *GraphPathQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphPathQuery.ProtoReflect.Descriptor instead.
+func (*GraphPathQuery) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GraphPathQuery) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GraphPathQuery) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GraphPathQuery) GetShip() string {
+	if x != nil && x.Ship != nil {
+		return *x.Ship
+	}
+	return ""
+}
+
+func (x *GraphPathQuery) GetDiscoveryId() string {
+	if x != nil && x.DiscoveryId != nil {
+		return *x.DiscoveryId
+	}
+	return ""
+}
+
+func (x *GraphPathQuery) GetReputations() map[string]float64 {
+	if x != nil {
+		return x.Reputations
+	}
+	return nil
+}
+
+type GetGraphPathsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answers       []*GetGraphPathsAnswer `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGraphPathsReply) Reset() {
+	*x = GetGraphPathsReply{}
+	mi := &file_darkstat_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGraphPathsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGraphPathsReply) ProtoMessage() {}
+
+func (x *GetGraphPathsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGraphPathsReply.ProtoReflect.Descriptor instead.
+func (*GetGraphPathsReply) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetGraphPathsReply) GetAnswers() []*GetGraphPathsAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type GetGraphPathsAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Route         *GraphPathQuery        `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	Time          *GraphPathTime         `protobuf:"bytes,2,opt,name=time,proto3,oneof" json:"time,omitempty"`
+	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGraphPathsAnswer) Reset() {
+	*x = GetGraphPathsAnswer{}
+	mi := &file_darkstat_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGraphPathsAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGraphPathsAnswer) ProtoMessage() {}
+
+func (x *GetGraphPathsAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGraphPathsAnswer.ProtoReflect.Descriptor instead.
+func (*GetGraphPathsAnswer) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetGraphPathsAnswer) GetRoute() *GraphPathQuery {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *GetGraphPathsAnswer) GetTime() *GraphPathTime {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GetGraphPathsAnswer) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type GraphPathTime struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Transport *int64                 `protobuf:"varint,1,opt,name=transport,proto3,oneof" json:"transport,omitempty"`
+	Frigate   *int64                 `protobuf:"varint,2,opt,name=frigate,proto3,oneof" json:"frigate,omitempty"`
+	Freighter *int64                 `protobuf:"varint,3,opt,name=freighter,proto3,oneof" json:"freighter,omitempty"`
+	// time for requested ship
+	Ship          *int64 `protobuf:"varint,4,opt,name=ship,proto3,oneof" json:"ship,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphPathTime) Reset() {
+	*x = GraphPathTime{}
+	mi := &file_darkstat_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphPathTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphPathTime) ProtoMessage() {}
+
+func (x *GraphPathTime) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GraphPathTime.ProtoReflect.Descriptor instead.
+func (*GraphPathTime) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GraphPathTime) GetTransport() int64 {
+	if x != nil && x.Transport != nil {
+		return *x.Transport
+	}
+	return 0
+}
+
+func (x *GraphPathTime) GetFrigate() int64 {
+	if x != nil && x.Frigate != nil {
+		return *x.Frigate
+	}
+	return 0
+}
+
+func (x *GraphPathTime) GetFreighter() int64 {
+	if x != nil && x.Freighter != nil {
+		return *x.Freighter
+	}
+	return 0
+}
+
+func (x *GraphPathTime) GetShip() int64 {
+	if x != nil && x.Ship != nil {
+		return *x.Ship
+	}
+	return 0
+}
+
+type GetGraphRouteDetailsReply struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Answers       []*GetGraphRouteDetailsAnswer `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGraphRouteDetailsReply) Reset() {
+	*x = GetGraphRouteDetailsReply{}
+	mi := &file_darkstat_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGraphRouteDetailsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGraphRouteDetailsReply) ProtoMessage() {}
+
+func (x *GetGraphRouteDetailsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ShopItem) GetMaxStock() int64 {
+// Deprecated: Use GetGraphRouteDetailsReply.ProtoReflect.Descriptor instead.
+func (*GetGraphRouteDetailsReply) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetGraphRouteDetailsReply) GetAnswers() []*GetGraphRouteDetailsAnswer {
 	if x != nil {
-		return x.MaxStock
+		return x.Answers
 	}
-	return 0
+	return nil
 }
 
-type GetPoBGoodsReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*PoBGood             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+type GetGraphRouteDetailsAnswer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Route *GraphPathQuery        `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// empty if destination is not reachable by ship class
+	Transport *GraphRouteDetails `protobuf:"bytes,2,opt,name=transport,proto3,oneof" json:"transport,omitempty"`
+	Frigate   *GraphRouteDetails `protobuf:"bytes,3,opt,name=frigate,proto3,oneof" json:"frigate,omitempty"`
+	Freighter *GraphRouteDetails `protobuf:"bytes,4,opt,name=freighter,proto3,oneof" json:"freighter,omitempty"`
+	Error     *string            `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// for requested ship
+	Ship          *GraphRouteDetails `protobuf:"bytes,6,opt,name=ship,proto3,oneof" json:"ship,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPoBGoodsReply) Reset() {
-	*x = GetPoBGoodsReply{}
-	mi := &file_darkstat_proto_msgTypes[65]
+func (x *GetGraphRouteDetailsAnswer) Reset() {
+	*x = GetGraphRouteDetailsAnswer{}
+	mi := &file_darkstat_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPoBGoodsReply) String() string {
+func (x *GetGraphRouteDetailsAnswer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPoBGoodsReply) ProtoMessage() {}
+func (*GetGraphRouteDetailsAnswer) ProtoMessage() {}
 
-func (x *GetPoBGoodsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_darkstat_proto_msgTypes[65]
+func (x *GetGraphRouteDetailsAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5558,49 +6451,77 @@ func (x *GetPoBGoodsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPoBGoodsReply.ProtoReflect.Descriptor instead.
-func (*GetPoBGoodsReply) Descriptor() ([]byte, []int) {
-	return file_darkstat_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use GetGraphRouteDetailsAnswer.ProtoReflect.Descriptor instead.
+func (*GetGraphRouteDetailsAnswer) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetPoBGoodsReply) GetItems() []*PoBGood {
+func (x *GetGraphRouteDetailsAnswer) GetRoute() *GraphPathQuery {
 	if x != nil {
-		return x.Items
+		return x.Route
 	}
 	return nil
 }
 
-type PoBGood struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Nickname              string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Name                  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	TotalBuyableFromBases int64                  `protobuf:"varint,3,opt,name=total_buyable_from_bases,json=totalBuyableFromBases,proto3" json:"total_buyable_from_bases,omitempty"`
-	TotalSellableToBases  int64                  `protobuf:"varint,4,opt,name=total_sellable_to_bases,json=totalSellableToBases,proto3" json:"total_sellable_to_bases,omitempty"`
-	BestPriceToBuy        *int64                 `protobuf:"varint,5,opt,name=best_price_to_buy,json=bestPriceToBuy,proto3,oneof" json:"best_price_to_buy,omitempty"`
-	BestPriceToSell       *int64                 `protobuf:"varint,6,opt,name=best_price_to_sell,json=bestPriceToSell,proto3,oneof" json:"best_price_to_sell,omitempty"`
-	Category              string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
-	AnyBaseSells          bool                   `protobuf:"varint,8,opt,name=any_base_sells,json=anyBaseSells,proto3" json:"any_base_sells,omitempty"`
-	AnyBaseBuys           bool                   `protobuf:"varint,9,opt,name=any_base_buys,json=anyBaseBuys,proto3" json:"any_base_buys,omitempty"`
-	Bases                 []*PoBGoodBase         `protobuf:"bytes,10,rep,name=bases,proto3" json:"bases,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *GetGraphRouteDetailsAnswer) GetTransport() *GraphRouteDetails {
+	if x != nil {
+		return x.Transport
+	}
+	return nil
 }
 
-func (x *PoBGood) Reset() {
-	*x = PoBGood{}
-	mi := &file_darkstat_proto_msgTypes[66]
+func (x *GetGraphRouteDetailsAnswer) GetFrigate() *GraphRouteDetails {
+	if x != nil {
+		return x.Frigate
+	}
+	return nil
+}
+
+func (x *GetGraphRouteDetailsAnswer) GetFreighter() *GraphRouteDetails {
+	if x != nil {
+		return x.Freighter
+	}
+	return nil
+}
+
+func (x *GetGraphRouteDetailsAnswer) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *GetGraphRouteDetailsAnswer) GetShip() *GraphRouteDetails {
+	if x != nil {
+		return x.Ship
+	}
+	return nil
+}
+
+type GraphRouteDetails struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// total time in seconds
+	Time          int64                 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Waypoints     []*GraphRouteWaypoint `protobuf:"bytes,2,rep,name=waypoints,proto3" json:"waypoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphRouteDetails) Reset() {
+	*x = GraphRouteDetails{}
+	mi := &file_darkstat_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PoBGood) String() string {
+func (x *GraphRouteDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoBGood) ProtoMessage() {}
+func (*GraphRouteDetails) ProtoMessage() {}
 
-func (x *PoBGood) ProtoReflect() protoreflect.Message {
-	mi := &file_darkstat_proto_msgTypes[66]
+func (x *GraphRouteDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5611,104 +6532,161 @@ func (x *PoBGood) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoBGood.ProtoReflect.Descriptor instead.
-func (*PoBGood) Descriptor() ([]byte, []int) {
-	return file_darkstat_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use GraphRouteDetails.ProtoReflect.Descriptor instead.
+func (*GraphRouteDetails) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *PoBGood) GetNickname() string {
+func (x *GraphRouteDetails) GetTime() int64 {
 	if x != nil {
-		return x.Nickname
+		return x.Time
 	}
-	return ""
+	return 0
 }
 
-func (x *PoBGood) GetName() string {
+func (x *GraphRouteDetails) GetWaypoints() []*GraphRouteWaypoint {
 	if x != nil {
-		return x.Name
+		return x.Waypoints
 	}
-	return ""
+	return nil
 }
 
-func (x *PoBGood) GetTotalBuyableFromBases() int64 {
+type GraphRouteWaypoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Nickname       string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SystemNickname string                 `protobuf:"bytes,3,opt,name=system_nickname,json=systemNickname,proto3" json:"system_nickname,omitempty"`
+	SystemName     string                 `protobuf:"bytes,4,opt,name=system_name,json=systemName,proto3" json:"system_name,omitempty"`
+	SectorCoord    string                 `protobuf:"bytes,5,opt,name=sector_coord,json=sectorCoord,proto3" json:"sector_coord,omitempty"`
+	Pos            *Pos                   `protobuf:"bytes,6,opt,name=pos,proto3" json:"pos,omitempty"`
+	// how waypoint is reached from previous one: cruise, tradelane, jump_gate, jump_hole or dock. Empty for starting point
+	Segment string `protobuf:"bytes,7,opt,name=segment,proto3" json:"segment,omitempty"`
+	// seconds spent on the segment
+	Time          int64 `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GraphRouteWaypoint) Reset() {
+	*x = GraphRouteWaypoint{}
+	mi := &file_darkstat_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GraphRouteWaypoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GraphRouteWaypoint) ProtoMessage() {}
+
+func (x *GraphRouteWaypoint) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[81]
 	if x != nil {
-		return x.TotalBuyableFromBases
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PoBGood) GetTotalSellableToBases() int64 {
+// Deprecated: Use GraphRouteWaypoint.ProtoReflect.Descriptor instead.
+func (*GraphRouteWaypoint) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GraphRouteWaypoint) GetNickname() string {
 	if x != nil {
-		return x.TotalSellableToBases
+		return x.Nickname
 	}
-	return 0
+	return ""
 }
 
-func (x *PoBGood) GetBestPriceToBuy() int64 {
-	if x != nil && x.BestPriceToBuy != nil {
-		return *x.BestPriceToBuy
+func (x *GraphRouteWaypoint) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *PoBGood) GetBestPriceToSell() int64 {
-	if x != nil && x.BestPriceToSell != nil {
-		return *x.BestPriceToSell
+func (x *GraphRouteWaypoint) GetSystemNickname() string {
+	if x != nil {
+		return x.SystemNickname
 	}
-	return 0
+	return ""
 }
 
-func (x *PoBGood) GetCategory() string {
+func (x *GraphRouteWaypoint) GetSystemName() string {
 	if x != nil {
-		return x.Category
+		return x.SystemName
 	}
 	return ""
 }
 
-func (x *PoBGood) GetAnyBaseSells() bool {
+func (x *GraphRouteWaypoint) GetSectorCoord() string {
 	if x != nil {
-		return x.AnyBaseSells
+		return x.SectorCoord
 	}
-	return false
+	return ""
 }
 
-func (x *PoBGood) GetAnyBaseBuys() bool {
+func (x *GraphRouteWaypoint) GetPos() *Pos {
 	if x != nil {
-		return x.AnyBaseBuys
+		return x.Pos
 	}
-	return false
+	return nil
 }
 
-func (x *PoBGood) GetBases() []*PoBGoodBase {
+func (x *GraphRouteWaypoint) GetSegment() string {
 	if x != nil {
-		return x.Bases
+		return x.Segment
 	}
-	return nil
+	return ""
 }
 
-type PoBGoodBase struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShopItem      *ShopItem              `protobuf:"bytes,1,opt,name=shop_item,json=shopItem,proto3" json:"shop_item,omitempty"`
-	Base          *PoBCore               `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+func (x *GraphRouteWaypoint) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type GetTradeRoutesInput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// base to buy cargo at
+	From *string `protobuf:"bytes,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// commodity nickname to trade
+	Commodity *string `protobuf:"bytes,2,opt,name=commodity,proto3,oneof" json:"commodity,omitempty"`
+	// transport, frigate or freighter. Default is transport
+	RouteType string         `protobuf:"bytes,3,opt,name=route_type,json=routeType,proto3" json:"route_type,omitempty"`
+	Capacity  *TradeCapacity `protobuf:"bytes,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// amount of best routes to return. Default is 20
+	Limit int64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Optional Discovery ID nickname. Routes from or to bases hostile to its rephacks and goods with unmet required reputation are skipped
+	DiscoveryId *string `protobuf:"bytes,6,opt,name=discovery_id,json=discoveryId,proto3,oneof" json:"discovery_id,omitempty"`
+	// Optional reputation per faction nickname, used if discovery id is not set
+	Reputations   map[string]float64 `protobuf:"bytes,7,rep,name=reputations,proto3" json:"reputations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PoBGoodBase) Reset() {
-	*x = PoBGoodBase{}
-	mi := &file_darkstat_proto_msgTypes[67]
+func (x *GetTradeRoutesInput) Reset() {
+	*x = GetTradeRoutesInput{}
+	mi := &file_darkstat_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PoBGoodBase) String() string {
+func (x *GetTradeRoutesInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PoBGoodBase) ProtoMessage() {}
+func (*GetTradeRoutesInput) ProtoMessage() {}
 
-func (x *PoBGoodBase) ProtoReflect() protoreflect.Message {
-	mi := &file_darkstat_proto_msgTypes[67]
+func (x *GetTradeRoutesInput) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5719,47 +6697,85 @@ func (x *PoBGoodBase) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PoBGoodBase.ProtoReflect.Descriptor instead.
-func (*PoBGoodBase) Descriptor() ([]byte, []int) {
-	return file_darkstat_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use GetTradeRoutesInput.ProtoReflect.Descriptor instead.
+func (*GetTradeRoutesInput) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *PoBGoodBase) GetShopItem() *ShopItem {
+func (x *GetTradeRoutesInput) GetFrom() string {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return ""
+}
+
+func (x *GetTradeRoutesInput) GetCommodity() string {
+	if x != nil && x.Commodity != nil {
+		return *x.Commodity
+	}
+	return ""
+}
+
+func (x *GetTradeRoutesInput) GetRouteType() string {
 	if x != nil {
-		return x.ShopItem
+		return x.RouteType
+	}
+	return ""
+}
+
+func (x *GetTradeRoutesInput) GetCapacity() *TradeCapacity {
+	if x != nil {
+		return x.Capacity
 	}
 	return nil
 }
 
-func (x *PoBGoodBase) GetBase() *PoBCore {
+func (x *GetTradeRoutesInput) GetLimit() int64 {
 	if x != nil {
-		return x.Base
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTradeRoutesInput) GetDiscoveryId() string {
+	if x != nil && x.DiscoveryId != nil {
+		return *x.DiscoveryId
+	}
+	return ""
+}
+
+func (x *GetTradeRoutesInput) GetReputations() map[string]float64 {
+	if x != nil {
+		return x.Reputations
 	}
 	return nil
 }
 
-type GetGraphPathsInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Queries       []*GraphPathQuery      `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+type TradeCapacity struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	HoldSize int64                  `protobuf:"varint,1,opt,name=hold_size,json=holdSize,proto3" json:"hold_size,omitempty"`
+	Credits  int64                  `protobuf:"varint,2,opt,name=credits,proto3" json:"credits,omitempty"`
+	// Discovery specific. Commodity volumes depend on it. -1 if not known
+	ShipClass     int64 `protobuf:"varint,3,opt,name=ship_class,json=shipClass,proto3" json:"ship_class,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGraphPathsInput) Reset() {
-	*x = GetGraphPathsInput{}
-	mi := &file_darkstat_proto_msgTypes[68]
+func (x *TradeCapacity) Reset() {
+	*x = TradeCapacity{}
+	mi := &file_darkstat_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGraphPathsInput) String() string {
+func (x *TradeCapacity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGraphPathsInput) ProtoMessage() {}
+func (*TradeCapacity) ProtoMessage() {}
 
-func (x *GetGraphPathsInput) ProtoReflect() protoreflect.Message {
-	mi := &file_darkstat_proto_msgTypes[68]
+func (x *TradeCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5770,41 +6786,55 @@ func (x *GetGraphPathsInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGraphPathsInput.ProtoReflect.Descriptor instead.
-func (*GetGraphPathsInput) Descriptor() ([]byte, []int) {
-	return file_darkstat_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use TradeCapacity.ProtoReflect.Descriptor instead.
+func (*TradeCapacity) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetGraphPathsInput) GetQueries() []*GraphPathQuery {
+func (x *TradeCapacity) GetHoldSize() int64 {
 	if x != nil {
-		return x.Queries
+		return x.HoldSize
 	}
-	return nil
+	return 0
 }
 
-type GraphPathQuery struct {
+func (x *TradeCapacity) GetCredits() int64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *TradeCapacity) GetShipClass() int64 {
+	if x != nil {
+		return x.ShipClass
+	}
+	return 0
+}
+
+type GetTradeRoutesReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Routes        []*TradeRouteRun       `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	Error         *string                `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GraphPathQuery) Reset() {
-	*x = GraphPathQuery{}
-	mi := &file_darkstat_proto_msgTypes[69]
+func (x *GetTradeRoutesReply) Reset() {
+	*x = GetTradeRoutesReply{}
+	mi := &file_darkstat_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GraphPathQuery) String() string {
+func (x *GetTradeRoutesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GraphPathQuery) ProtoMessage() {}
+func (*GetTradeRoutesReply) ProtoMessage() {}
 
-func (x *GraphPathQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_darkstat_proto_msgTypes[69]
+func (x *GetTradeRoutesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5815,47 +6845,58 @@ func (x *GraphPathQuery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GraphPathQuery.ProtoReflect.Descriptor instead.
-func (*GraphPathQuery) Descriptor() ([]byte, []int) {
-	return file_darkstat_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetTradeRoutesReply.ProtoReflect.Descriptor instead.
+func (*GetTradeRoutesReply) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GraphPathQuery) GetFrom() string {
+func (x *GetTradeRoutesReply) GetRoutes() []*TradeRouteRun {
 	if x != nil {
-		return x.From
+		return x.Routes
 	}
-	return ""
+	return nil
 }
 
-func (x *GraphPathQuery) GetTo() string {
-	if x != nil {
-		return x.To
+func (x *GetTradeRoutesReply) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-type GetGraphPathsReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Answers       []*GetGraphPathsAnswer `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetGraphPathsReply) Reset() {
-	*x = GetGraphPathsReply{}
-	mi := &file_darkstat_proto_msgTypes[70]
+type TradeRouteRun struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CommodityNickname string                 `protobuf:"bytes,1,opt,name=commodity_nickname,json=commodityNickname,proto3" json:"commodity_nickname,omitempty"`
+	CommodityName     string                 `protobuf:"bytes,2,opt,name=commodity_name,json=commodityName,proto3" json:"commodity_name,omitempty"`
+	FromBaseNickname  string                 `protobuf:"bytes,3,opt,name=from_base_nickname,json=fromBaseNickname,proto3" json:"from_base_nickname,omitempty"`
+	FromBaseName      string                 `protobuf:"bytes,4,opt,name=from_base_name,json=fromBaseName,proto3" json:"from_base_name,omitempty"`
+	ToBaseNickname    string                 `protobuf:"bytes,5,opt,name=to_base_nickname,json=toBaseNickname,proto3" json:"to_base_nickname,omitempty"`
+	ToBaseName        string                 `protobuf:"bytes,6,opt,name=to_base_name,json=toBaseName,proto3" json:"to_base_name,omitempty"`
+	BuyPrice          int64                  `protobuf:"varint,7,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
+	SellPrice         int64                  `protobuf:"varint,8,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	// seconds to fly from base to base
+	Time            int64     `protobuf:"varint,9,opt,name=time,proto3" json:"time,omitempty"`
+	ProfitPerVolume float64   `protobuf:"fixed64,10,opt,name=profit_per_volume,json=profitPerVolume,proto3" json:"profit_per_volume,omitempty"`
+	Run             *TradeRun `protobuf:"bytes,11,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TradeRouteRun) Reset() {
+	*x = TradeRouteRun{}
+	mi := &file_darkstat_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGraphPathsReply) String() string {
+func (x *TradeRouteRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGraphPathsReply) ProtoMessage() {}
+func (*TradeRouteRun) ProtoMessage() {}
 
-func (x *GetGraphPathsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_darkstat_proto_msgTypes[70]
+func (x *TradeRouteRun) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5866,102 +6907,116 @@ func (x *GetGraphPathsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGraphPathsReply.ProtoReflect.Descriptor instead.
-func (*GetGraphPathsReply) Descriptor() ([]byte, []int) {
-	return file_darkstat_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use TradeRouteRun.ProtoReflect.Descriptor instead.
+func (*TradeRouteRun) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetGraphPathsReply) GetAnswers() []*GetGraphPathsAnswer {
+func (x *TradeRouteRun) GetCommodityNickname() string {
 	if x != nil {
-		return x.Answers
+		return x.CommodityNickname
 	}
-	return nil
+	return ""
 }
 
-type GetGraphPathsAnswer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Route         *GraphPathQuery        `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
-	Time          *GraphPathTime         `protobuf:"bytes,2,opt,name=time,proto3,oneof" json:"time,omitempty"`
-	Error         *string                `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TradeRouteRun) GetCommodityName() string {
+	if x != nil {
+		return x.CommodityName
+	}
+	return ""
 }
 
-func (x *GetGraphPathsAnswer) Reset() {
-	*x = GetGraphPathsAnswer{}
-	mi := &file_darkstat_proto_msgTypes[71]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TradeRouteRun) GetFromBaseNickname() string {
+	if x != nil {
+		return x.FromBaseNickname
+	}
+	return ""
 }
 
-func (x *GetGraphPathsAnswer) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TradeRouteRun) GetFromBaseName() string {
+	if x != nil {
+		return x.FromBaseName
+	}
+	return ""
 }
 
-func (*GetGraphPathsAnswer) ProtoMessage() {}
+func (x *TradeRouteRun) GetToBaseNickname() string {
+	if x != nil {
+		return x.ToBaseNickname
+	}
+	return ""
+}
 
-func (x *GetGraphPathsAnswer) ProtoReflect() protoreflect.Message {
-	mi := &file_darkstat_proto_msgTypes[71]
+func (x *TradeRouteRun) GetToBaseName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ToBaseName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetGraphPathsAnswer.ProtoReflect.Descriptor instead.
-func (*GetGraphPathsAnswer) Descriptor() ([]byte, []int) {
-	return file_darkstat_proto_rawDescGZIP(), []int{71}
+func (x *TradeRouteRun) GetBuyPrice() int64 {
+	if x != nil {
+		return x.BuyPrice
+	}
+	return 0
 }
 
-func (x *GetGraphPathsAnswer) GetRoute() *GraphPathQuery {
+func (x *TradeRouteRun) GetSellPrice() int64 {
 	if x != nil {
-		return x.Route
+		return x.SellPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGraphPathsAnswer) GetTime() *GraphPathTime {
+func (x *TradeRouteRun) GetTime() int64 {
 	if x != nil {
 		return x.Time
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGraphPathsAnswer) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+func (x *TradeRouteRun) GetProfitPerVolume() float64 {
+	if x != nil {
+		return x.ProfitPerVolume
 	}
-	return ""
+	return 0
 }
 
-type GraphPathTime struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transport     *int64                 `protobuf:"varint,1,opt,name=transport,proto3,oneof" json:"transport,omitempty"`
-	Frigate       *int64                 `protobuf:"varint,2,opt,name=frigate,proto3,oneof" json:"frigate,omitempty"`
-	Freighter     *int64                 `protobuf:"varint,3,opt,name=freighter,proto3,oneof" json:"freighter,omitempty"`
+func (x *TradeRouteRun) GetRun() *TradeRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+type TradeRun struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Units int64                  `protobuf:"varint,1,opt,name=units,proto3" json:"units,omitempty"`
+	// of one unit for ship class
+	Volume        float64 `protobuf:"fixed64,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Profit        int64   `protobuf:"varint,3,opt,name=profit,proto3" json:"profit,omitempty"`
+	ProfitPerHour float64 `protobuf:"fixed64,4,opt,name=profit_per_hour,json=profitPerHour,proto3" json:"profit_per_hour,omitempty"`
+	// hold, credits or stock of PoB
+	LimitedBy     string `protobuf:"bytes,5,opt,name=limited_by,json=limitedBy,proto3" json:"limited_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GraphPathTime) Reset() {
-	*x = GraphPathTime{}
-	mi := &file_darkstat_proto_msgTypes[72]
+func (x *TradeRun) Reset() {
+	*x = TradeRun{}
+	mi := &file_darkstat_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GraphPathTime) String() string {
+func (x *TradeRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GraphPathTime) ProtoMessage() {}
+func (*TradeRun) ProtoMessage() {}
 
-func (x *GraphPathTime) ProtoReflect() protoreflect.Message {
-	mi := &file_darkstat_proto_msgTypes[72]
+func (x *TradeRun) ProtoReflect() protoreflect.Message {
+	mi := &file_darkstat_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5972,32 +7027,46 @@ func (x *GraphPathTime) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GraphPathTime.ProtoReflect.Descriptor instead.
-func (*GraphPathTime) Descriptor() ([]byte, []int) {
-	return file_darkstat_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use TradeRun.ProtoReflect.Descriptor instead.
+func (*TradeRun) Descriptor() ([]byte, []int) {
+	return file_darkstat_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GraphPathTime) GetTransport() int64 {
-	if x != nil && x.Transport != nil {
-		return *x.Transport
+func (x *TradeRun) GetUnits() int64 {
+	if x != nil {
+		return x.Units
 	}
 	return 0
 }
 
-func (x *GraphPathTime) GetFrigate() int64 {
-	if x != nil && x.Frigate != nil {
-		return *x.Frigate
+func (x *TradeRun) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
 	}
 	return 0
 }
 
-func (x *GraphPathTime) GetFreighter() int64 {
-	if x != nil && x.Freighter != nil {
-		return *x.Freighter
+func (x *TradeRun) GetProfit() int64 {
+	if x != nil {
+		return x.Profit
+	}
+	return 0
+}
+
+func (x *TradeRun) GetProfitPerHour() float64 {
+	if x != nil {
+		return x.ProfitPerHour
 	}
 	return 0
 }
 
+func (x *TradeRun) GetLimitedBy() string {
+	if x != nil {
+		return x.LimitedBy
+	}
+	return ""
+}
+
 var File_darkstat_proto protoreflect.FileDescriptor
 
 var file_darkstat_proto_rawDesc = string([]byte{
@@ -6024,49 +7093,202 @@ var file_darkstat_proto_rawDesc = string([]byte{
 	0x63, 0x61, 0x72, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
 	0x49, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69,
-	0x6e, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x0c, 0x49, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x4c,
+	0x6e, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x0c, 0x49, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x4c,
 	0x69, 0x6e, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x50, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52,
-	0x07, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x0e, 0x49, 0x6e, 0x66, 0x6f,
-	0x63, 0x61, 0x72, 0x64, 0x50, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68,
-	0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x72, 0x61,
-	0x73, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x62,
-	0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x62, 0x6f, 0x6c, 0x64, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x2c, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x71,
-	0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x14,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x67,
-	0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x2e,
-	0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x12, 0x28,
-	0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x66,
-	0x75, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x54, 0x6f, 0x55, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x75, 0x6e, 0x73, 0x49,
+	0x07, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6a, 0x75, 0x73, 0x74,
+	0x69, 0x66, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x79, 0x22, 0xb9, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x50,
+	0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x6c, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x62, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74,
+	0x61, 0x6c, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x74, 0x61, 0x6c,
+	0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x6c, 0x69, 0x6e, 0x6b, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x2c,
+	0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x22, 0xca, 0x01, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47,
+	0x6f, 0x6f, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74,
+	0x6f, 0x5f, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x12, 0x29,
+	0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x47, 0x75, 0x6e, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x67, 0x6f, 0x6f,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f,
+	0x55, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x67, 0x6f, 0x6f, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x55,
+	0x73, 0x65, 0x66, 0x75, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x3d, 0x0a, 0x1b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x18, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22,
+	0x99, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x49,
 	0x6e, 0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
 	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x65, 0x63, 0x68,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
 	0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x66, 0x75, 0x6c,
-	0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x61, 0x6d, 0x61,
-	0x67, 0x65, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x42,
-	0x6f, 0x6e, 0x75, 0x73, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x22, 0xd5, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x49, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x36, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74,
+	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xbd, 0x05, 0x0a, 0x04, 0x42, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x65, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69,
+	0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x72, 0x69, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x66, 0x6f, 0x63,
+	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e,
+	0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x10,
+	0x62, 0x67, 0x63, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x62, 0x79,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x67, 0x63, 0x73, 0x42, 0x61, 0x73, 0x65,
+	0x52, 0x75, 0x6e, 0x42, 0x79, 0x12, 0x20, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x6f, 0x73, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x73,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x61,
+	0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x69, 0x73,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x62, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x50, 0x6f, 0x62, 0x12, 0x5a, 0x0a, 0x15, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6e, 0x69,
+	0x63, 0x6b, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x47, 0x6f, 0x6f, 0x64, 0x73, 0x50, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x12, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x50, 0x65,
+	0x72, 0x4e, 0x69, 0x63, 0x6b, 0x1a, 0x5c, 0x0a, 0x17, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47,
+	0x6f, 0x6f, 0x64, 0x73, 0x50, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xe2, 0x01, 0x0a, 0x0a, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x6c, 0x6f,
+	0x6f, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c, 0x6f, 0x6f, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x6c, 0x6f, 0x6f, 0x74, 0x5f, 0x6d, 0x61, 0x78,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c,
+	0x6f, 0x6f, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
+	0x63, 0x5f, 0x6c, 0x6f, 0x6f, 0x74, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x4c, 0x6f, 0x6f, 0x74, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x39,
+	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e,
+	0x65, 0x64, 0x47, 0x6f, 0x6f, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69,
+	0x6e, 0x65, 0x64, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x22, 0x96, 0x05, 0x0a, 0x0a, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x70,
+	0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68,
+	0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72,
+	0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x13, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x75, 0x79, 0x73, 0x5f, 0x66, 0x6f,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x10, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x42, 0x61, 0x73, 0x65, 0x42, 0x75, 0x79, 0x73, 0x46, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2f,
+	0x0a, 0x14, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6c,
+	0x6c, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x46, 0x6f, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x69, 0x70, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x68, 0x69,
+	0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73,
+	0x65, 0x6c, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x53, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x69, 0x64, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x75, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x42, 0x75, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x38, 0x0a,
+	0x18, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x16, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x72, 0x65,
+	0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x08, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x75, 0x79, 0x73, 0x5f, 0x66, 0x6f,
+	0x72, 0x22, 0xf6, 0x01, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23,
+	0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x73, 0x52, 0x07,
+	0x62, 0x61, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x22, 0x2f, 0x0a, 0x03, 0x50, 0x6f,
+	0x73, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12,
+	0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a,
+	0x01, 0x7a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0x9c, 0x01, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e,
 	0x70, 0x75, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d,
 	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
@@ -6075,1184 +7297,1237 @@ var file_darkstat_proto_rawDesc = string([]byte{
 	0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x12,
 	0x29, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
 	0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x67, 0x6f, 0x6f, 0x64,
-	0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x18, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f,
-	0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x99, 0x01, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x30,
-	0x0a, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73,
-	0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73,
-	0x65, 0x66, 0x75, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x36, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xbd, 0x05,
-	0x0a, 0x04, 0x42, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x72,
-	0x63, 0x68, 0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x61, 0x72, 0x63, 0x68, 0x65, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73,
-	0x74, 0x65, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x64, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64,
-	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x62, 0x67, 0x63, 0x73, 0x5f, 0x62,
-	0x61, 0x73, 0x65, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x62, 0x67, 0x63, 0x73, 0x42, 0x61, 0x73, 0x65, 0x52, 0x75, 0x6e, 0x42, 0x79, 0x12,
-	0x20, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x73, 0x52, 0x03, 0x70, 0x6f,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6f, 0x72,
-	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43,
-	0x6f, 0x6f, 0x72, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x69, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
-	0x6f, 0x72, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x06,
-	0x69, 0x73, 0x5f, 0x70, 0x6f, 0x62, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73,
-	0x50, 0x6f, 0x62, 0x12, 0x5a, 0x0a, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x67, 0x6f,
-	0x6f, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x14, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
-	0x61, 0x73, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x50,
-	0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x50, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x1a,
-	0x5c, 0x0a, 0x17, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x50, 0x65,
-	0x72, 0x4e, 0x69, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f,
-	0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe2, 0x01,
-	0x0a, 0x0a, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x0a, 0x10,
-	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x6c, 0x6f, 0x6f, 0x74, 0x5f, 0x6d, 0x69, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c,
-	0x6f, 0x6f, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69,
-	0x63, 0x5f, 0x6c, 0x6f, 0x6f, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c, 0x6f, 0x6f, 0x74, 0x4d, 0x61, 0x78,
-	0x12, 0x36, 0x0a, 0x17, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x6c, 0x6f, 0x6f, 0x74,
-	0x5f, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x15, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c, 0x6f, 0x6f, 0x74, 0x44, 0x69,
-	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65,
-	0x64, 0x5f, 0x67, 0x6f, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47,
-	0x6f, 0x6f, 0x64, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x47, 0x6f, 0x6f, 0x64,
-	0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x67, 0x6f,
-	0x6f, 0x64, 0x22, 0x96, 0x05, 0x0a, 0x0a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x70, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x13, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x62, 0x75, 0x79, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x00, 0x52, 0x10, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65, 0x42, 0x75,
-	0x79, 0x73, 0x46, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x14, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x73, 0x5f, 0x66, 0x6f, 0x72,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x73,
-	0x65, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x46, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x68, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x12,
-	0x35, 0x0a, 0x17, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x64,
-	0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x14, 0x69, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x69, 0x64, 0x65, 0x4f, 0x76,
-	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x75,
-	0x79, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x6f, 0x74,
-	0x42, 0x75, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x73, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x69, 0x73, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x30, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x42, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x62, 0x75, 0x79, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x22, 0xf6, 0x01, 0x0a, 0x08,
-	0x42, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70,
-	0x6f, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x73, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x50, 0x6f,
-	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6f, 0x72,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43,
-	0x6f, 0x6f, 0x72, 0x64, 0x22, 0x2f, 0x0a, 0x03, 0x50, 0x6f, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x78,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x7a, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x01, 0x7a, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x64, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x30, 0x0a,
-	0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f,
-	0x67, 0x6f, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12,
-	0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65,
-	0x66, 0x75, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x64, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61,
+	0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x64, 0x69, 0x74, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xa0, 0x04,
+	0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x42, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6d, 0x62, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x68, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x62,
+	0x61, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x74, 0x61,
 	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x79,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xa0, 0x04, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x61, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x68, 0x69, 0x70, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x73, 0x68, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e,
-	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x61,
-	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64,
-	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x63,
-	0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x09,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x79, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x18,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x62, 0x75, 0x79, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x65, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x42, 0x75, 0x79,
-	0x73, 0x46, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x19, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x65,
-	0x73, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x73, 0x5f, 0x66, 0x6f,
-	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x65,
-	0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x46, 0x6f, 0x72, 0x12, 0x25,
-	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x66, 0x69, 0x74, 0x5f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x66, 0x69, 0x74, 0x4d,
-	0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x41, 0x6d, 0x6d, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x74,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6d, 0x6f, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x22, 0x85, 0x05, 0x0a, 0x04, 0x41, 0x6d, 0x6d, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x69, 0x74, 0x50, 0x74, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x10, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17,
-	0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x5f,
-	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x65, 0x6b, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x65, 0x6b, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x6b, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x6e, 0x67,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65, 0x65, 0x6b, 0x65, 0x72, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65, 0x65, 0x6b, 0x65, 0x72, 0x5f, 0x66,
-	0x6f, 0x76, 0x5f, 0x64, 0x65, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65,
-	0x65, 0x6b, 0x65, 0x72, 0x46, 0x6f, 0x76, 0x44, 0x65, 0x67, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x61,
-	0x73, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6d, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73,
+	0x65, 0x73, 0x12, 0x36, 0x0a, 0x18, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x73, 0x74,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x75, 0x79, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x65, 0x73, 0x74, 0x42,
+	0x61, 0x73, 0x65, 0x42, 0x75, 0x79, 0x73, 0x46, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x19, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65,
+	0x6c, 0x6c, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x42, 0x65, 0x73, 0x74, 0x42, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6c, 0x6c,
+	0x73, 0x46, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x66, 0x66, 0x69, 0x74, 0x5f,
+	0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x72,
+	0x6f, 0x66, 0x66, 0x69, 0x74, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x61, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x1a,
+	0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x35, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6d, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6d, 0x6f,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x85, 0x05, 0x0a, 0x04, 0x41, 0x6d, 0x6d, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x69,
+	0x74, 0x5f, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x69, 0x74,
+	0x50, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x69, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x65, 0x6b, 0x65, 0x72,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x65,
+	0x6b, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x6b, 0x65,
+	0x72, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73,
+	0x65, 0x65, 0x6b, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x65,
+	0x65, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x76, 0x5f, 0x64, 0x65, 0x67, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x65, 0x6b, 0x65, 0x72, 0x46, 0x6f, 0x76, 0x44, 0x65, 0x67,
+	0x12, 0x30, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6d, 0x6f,
+	0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73,
+	0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65,
+	0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0a, 0x61,
+	0x6d, 0x6d, 0x6f, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6d, 0x6f,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x61, 0x6d, 0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x6d, 0x61, 0x73, 0x73, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22,
+	0xd3, 0x01, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63,
+	0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x12, 0x5c, 0x0a, 0x10, 0x74, 0x65, 0x63, 0x68, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x74, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x42, 0x79, 0x49, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x74, 0x65, 0x63, 0x68, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x65,
+	0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x63, 0x68, 0x43, 0x65,
+	0x6c, 0x6c, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x65, 0x63, 0x68, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x42, 0x79, 0x49, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa9, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x74, 0x65,
+	0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x63, 0x68,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x32, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x63, 0x68,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x73, 0x22, 0x4a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xfa,
+	0x04, 0x0a, 0x0e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68,
+	0x69, 0x74, 0x5f, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x69,
+	0x74, 0x50, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x69, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x69, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x63, 0x74, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x63, 0x74, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66,
+	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x2e, 0x42, 0x61, 0x73,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57,
+	0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68,
+	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52,
+	0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x6d, 0x6d, 0x6f, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74,
+	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x09, 0x61, 0x6d, 0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x61, 0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73,
+	0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22, 0x3a, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xca, 0x05, 0x0a, 0x06, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x72, 0x75, 0x69, 0x73, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x75, 0x69, 0x73, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12,
+	0x2c, 0x0a, 0x12, 0x63, 0x72, 0x75, 0x69, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x72, 0x75,
+	0x69, 0x73, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x64, 0x72, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x44, 0x72, 0x61, 0x67, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x75, 0x6c, 0x73,
+	0x65, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69,
+	0x6d, 0x70, 0x75, 0x6c, 0x73, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68,
+	0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x61, 0x6d,
+	0x65, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x69, 0x6c,
+	0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x69, 0x6c, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15,
 	0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74,
 	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
 	0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64,
 	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70,
-	0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x6d, 0x6d, 0x6f, 0x5f, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x74,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
-	0x09, 0x61, 0x6d, 0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61,
-	0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x1a, 0x4f,
-	0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
-	0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65,
-	0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x13, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x74, 0x12, 0x5c, 0x0a, 0x10, 0x74, 0x65, 0x63, 0x68, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x5f,
-	0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x2e, 0x54, 0x65, 0x63, 0x68,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x42, 0x79, 0x49, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0e, 0x74, 0x65, 0x63, 0x68, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12,
-	0x1b, 0x0a, 0x09, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x63, 0x68, 0x43, 0x65, 0x6c, 0x6c, 0x1a, 0x41, 0x0a, 0x13,
-	0x54, 0x65, 0x63, 0x68, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x42, 0x79, 0x49, 0x64, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xa9, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x41, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54,
-	0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x65, 0x63,
-	0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x32, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x49, 0x6e, 0x70, 0x75,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22,
-	0x4b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x41, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x4a, 0x0a, 0x17,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xfa, 0x04, 0x0a, 0x0e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x74, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x69, 0x74, 0x50, 0x74, 0x73, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x69, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x61, 0x69, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x69, 0x66,
-	0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x69, 0x66,
-	0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64,
-	0x69, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x63, 0x74, 0x67, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x69, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x63,
-	0x74, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61,
-	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x61, 0x6d,
-	0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x05,
-	0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4d,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63,
-	0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01,
+	0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
+	0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x62, 0x72, 0x69, 0x62, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x72, 0x69, 0x62, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x75,
+	0x73, 0x65, 0x66, 0x75, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x22, 0x3c, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x46, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xa3, 0x03, 0x0a, 0x07, 0x46, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x64,
+	0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x11, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x66, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0b,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
+	0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x62, 0x72, 0x69, 0x62, 0x65, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x42, 0x72, 0x69, 0x62, 0x65, 0x52, 0x06, 0x62, 0x72, 0x69, 0x62, 0x65, 0x73, 0x22,
+	0x68, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x72, 0x65, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6d, 0x70, 0x61, 0x74, 0x68, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x65, 0x6d, 0x70, 0x61, 0x74, 0x68, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x76, 0x0a, 0x05, 0x42, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x30, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x34, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x47, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x75, 0x6e,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xaf, 0x0e, 0x0a, 0x03, 0x47, 0x75, 0x6e, 0x12,
+	0x2f, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x75, 0x6e, 0x2e, 0x42,
+	0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73,
+	0x12, 0x57, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65,
+	0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48,
+	0x00, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x70, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x64, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x69, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x68, 0x69, 0x74, 0x50, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x69, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x66, 0x69,
+	0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x75, 0x67,
+	0x68, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x75,
+	0x67, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74,
+	0x6f, 0x5f, 0x74, 0x75, 0x72, 0x72, 0x65, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x69, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x54, 0x75, 0x72, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6d, 0x6f, 0x12, 0x1f, 0x0a,
+	0x0b, 0x68, 0x75, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x68, 0x75, 0x6c, 0x6c, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x44, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x65,
+	0x6c, 0x64, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f,
+	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x76, 0x67, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x75, 0x6e, 0x5f,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x67, 0x75, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x68, 0x75, 0x6c, 0x6c, 0x5f,
+	0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x68, 0x75, 0x6c, 0x6c, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x38, 0x0a, 0x19, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x61, 0x76, 0x67, 0x53, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63,
+	0x12, 0x31, 0x0a, 0x15, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x12, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x13, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x10, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x53,
+	0x65, 0x63, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x67, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x21, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x45,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x75, 0x6c,
+	0x6c, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x22, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x68, 0x75, 0x6c, 0x6c, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x65, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x23, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x73,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x38, 0x0a, 0x18, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x24, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x16, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x45,
+	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x64, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x65, 0x73, 0x18, 0x25, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x52, 0x0d, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6c, 0x65, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x67, 0x75, 0x6e, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x75, 0x6e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x52, 0x0b, 0x67, 0x75, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x12, 0x24, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x61, 0x72, 0x72, 0x65, 0x6c, 0x73,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x42, 0x61, 0x72,
+	0x72, 0x65, 0x6c, 0x73, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x62, 0x75, 0x72, 0x73, 0x74,
+	0x5f, 0x66, 0x69, 0x72, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74,
+	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x72, 0x73, 0x74, 0x46, 0x69, 0x72,
+	0x65, 0x48, 0x02, 0x52, 0x09, 0x62, 0x75, 0x72, 0x73, 0x74, 0x46, 0x69, 0x72, 0x65, 0x88, 0x01,
 	0x01, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x6d, 0x6d, 0x6f, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
+	0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x41, 0x6d, 0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x61, 0x6d, 0x6d,
-	0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61,
-	0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22, 0x3a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x22, 0xca, 0x05, 0x0a, 0x06, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x75, 0x69, 0x73, 0x65,
-	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72,
-	0x75, 0x69, 0x73, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x75,
-	0x69, 0x73, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x72, 0x75, 0x69, 0x73, 0x65, 0x43, 0x68, 0x61,
-	0x72, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x61,
-	0x72, 0x5f, 0x64, 0x72, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x69,
-	0x6e, 0x65, 0x61, 0x72, 0x44, 0x72, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78,
-	0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x75, 0x6c, 0x73, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x65,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x75, 0x6c, 0x73, 0x65,
-	0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x66, 0x6c, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c, 0x61, 0x6d, 0x65, 0x45, 0x66, 0x66, 0x65, 0x63,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63,
-	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x45, 0x66,
-	0x66, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66,
-	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f,
-	0x49, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d,
-	0x61, 0x73, 0x73, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22, 0x94,
-	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72,
-	0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x62, 0x72, 0x69, 0x62, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x72, 0x69, 0x62, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x54, 0x6f, 0x55,
-	0x73, 0x65, 0x66, 0x75, 0x6c, 0x22, 0x3c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x22, 0xa3, 0x03, 0x0a, 0x07, 0x46, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d,
-	0x0a, 0x12, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x44, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a,
-	0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
-	0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41,
-	0x62, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x66, 0x6f, 0x6e, 0x61, 0x6d, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x6e,
-	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x66, 0x6f,
-	0x63, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x28, 0x0a, 0x06, 0x62, 0x72, 0x69, 0x62, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x06, 0x62, 0x72, 0x69, 0x62, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x0a, 0x52, 0x65, 0x70,
-	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72,
-	0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x72, 0x65, 0x70, 0x12, 0x18, 0x0a,
-	0x07, 0x65, 0x6d, 0x70, 0x61, 0x74, 0x68, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
-	0x65, 0x6d, 0x70, 0x61, 0x74, 0x68, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x76, 0x0a, 0x05, 0x42, 0x72, 0x69, 0x62, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x34, 0x0a, 0x0c, 0x47,
-	0x65, 0x74, 0x47, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x75, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x22, 0xaf, 0x0e, 0x0a, 0x03, 0x47, 0x75, 0x6e, 0x12, 0x2f, 0x0a, 0x05, 0x62, 0x61, 0x73,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x75, 0x6e, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e,
+	0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x2b,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x5f, 0x67, 0x75, 0x6e, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x47,
+	0x75, 0x6e, 0x48, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x47, 0x75, 0x6e, 0x88, 0x01,
+	0x01, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x61, 0x72, 0x72, 0x65, 0x6c, 0x73, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x5f, 0x67, 0x75, 0x6e, 0x22, 0x3d, 0x0a, 0x0b, 0x44, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x07, 0x4d, 0x69, 0x73, 0x73,
+	0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6e, 0x67, 0x75, 0x6c,
+	0x61, 0x72, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x41, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x56, 0x65, 0x6c,
+	0x6f, 0x63, 0x69, 0x74, 0x79, 0x22, 0x90, 0x01, 0x0a, 0x0b, 0x47, 0x75, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x5f, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x69, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x22, 0x82, 0x03, 0x0a, 0x09, 0x42, 0x75, 0x72,
+	0x73, 0x74, 0x46, 0x69, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x66, 0x69, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0f, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x66, 0x69, 0x72,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x61, 0x6d, 0x6d, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x1d, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x64, 0x5f, 0x68, 0x75, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x73,
+	0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x48, 0x75, 0x6c, 0x6c, 0x44, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x4b, 0x0a, 0x23, 0x73, 0x75, 0x73, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1e, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64,
+	0x41, 0x76, 0x67, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x44, 0x0a, 0x1f, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x64, 0x5f, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1b,
+	0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x44,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x40, 0x0a, 0x1d, 0x73,
+	0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x19, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x22, 0x27, 0x0a,
+	0x08, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x47, 0x75, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x6d,
+	0x6f, 0x72, 0x5f, 0x70, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x72,
+	0x6d, 0x6f, 0x72, 0x50, 0x65, 0x6e, 0x22, 0x36, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xae,
+	0x08, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6d, 0x6f, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x6d, 0x6d, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x14,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x65,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x41, 0x72, 0x63, 0x68, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x64, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x69, 0x64, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64,
+	0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x75, 0x6c, 0x6c, 0x5f, 0x64, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x75, 0x6c, 0x6c,
+	0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79,
+	0x5f, 0x64, 0x61, 0x6d, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x44, 0x61, 0x6d, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x66,
+	0x69, 0x72, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x66, 0x69, 0x72,
+	0x65, 0x12, 0x2f, 0x0a, 0x13, 0x64, 0x65, 0x74, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12,
+	0x64, 0x65, 0x74, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x65, 0x6b, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x73, 0x65, 0x65, 0x6b, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x64, 0x72, 0x61, 0x67, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x44, 0x72, 0x61,
+	0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x61, 0x66, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x53, 0x61, 0x66, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x6f, 0x75, 0x67, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x74, 0x6f, 0x75, 0x67, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x68,
+	0x69, 0x74, 0x5f, 0x70, 0x74, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x69,
+	0x74, 0x50, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x33, 0x0a, 0x0a, 0x61, 0x6d, 0x6d, 0x6f, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x6d, 0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x61, 0x6d, 0x6d, 0x6f,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x61, 0x73,
+	0x65, 0x73, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x74, 0x88, 0x01, 0x01, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22,
+	0x91, 0x01, 0x0a, 0x09, 0x41, 0x6d, 0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a,
+	0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x74, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x10, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43, 0x61, 0x74, 0x72, 0x69, 0x64, 0x67, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x28, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x74, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x43, 0x61,
+	0x74, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x74, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x74, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0xea, 0x03, 0x0a, 0x07, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a,
+	0x10, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x53, 0x63,
+	0x61, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x69,
 	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x70, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54,
 	0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69, 0x73,
 	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c,
-	0x61, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x69, 0x64, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x69, 0x64, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x73, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x69,
-	0x74, 0x5f, 0x70, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x69, 0x74,
-	0x50, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x55,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x69, 0x72, 0x65, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x66, 0x69, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72, 0x61, 0x6e,
-	0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x75, 0x67, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x75, 0x67, 0x68, 0x6e, 0x65, 0x73, 0x73,
-	0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x74, 0x75, 0x72, 0x72,
-	0x65, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x41, 0x75, 0x74, 0x6f,
-	0x54, 0x75, 0x72, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61,
-	0x6d, 0x6d, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x41, 0x6d, 0x6d, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x75, 0x6c, 0x6c, 0x5f,
-	0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x75,
-	0x6c, 0x6c, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x65, 0x72,
-	0x67, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x6d, 0x61,
-	0x67, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61,
-	0x76, 0x67, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x18, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x19, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x08, 0x6c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65,
-	0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x75, 0x6e, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x67, 0x75, 0x6e, 0x54, 0x75,
-	0x72, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x70, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x6e, 0x67, 0x6c,
-	0x65, 0x12, 0x2d, 0x0a, 0x13, 0x68, 0x75, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
-	0x68, 0x75, 0x6c, 0x6c, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63,
-	0x12, 0x38, 0x0a, 0x19, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64,
-	0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x1e, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x15, 0x61, 0x76, 0x67, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61,
-	0x6d, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x31, 0x0a, 0x15, 0x65, 0x6e,
-	0x65, 0x72, 0x67, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x73, 0x65, 0x63, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x65, 0x6e, 0x65, 0x72, 0x67,
-	0x79, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a,
-	0x13, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x73, 0x65, 0x63, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x25, 0x0a, 0x0e,
-	0x61, 0x76, 0x67, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x21,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x67, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65,
-	0x6e, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x75, 0x6c, 0x6c, 0x5f, 0x65, 0x66, 0x66, 0x69,
-	0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x22, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x68, 0x75,
-	0x6c, 0x6c, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63,
-	0x79, 0x18, 0x23, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x45,
-	0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x38, 0x0a, 0x18, 0x65, 0x6e, 0x65,
-	0x72, 0x67, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x66, 0x66, 0x69, 0x63,
-	0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x24, 0x20, 0x01, 0x28, 0x01, 0x52, 0x16, 0x65, 0x6e, 0x65,
-	0x72, 0x67, 0x79, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x45, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65,
-	0x6e, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x0e, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6f,
-	0x6e, 0x75, 0x73, 0x65, 0x73, 0x18, 0x25, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x6f,
-	0x6e, 0x75, 0x73, 0x52, 0x0d, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73,
-	0x65, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6c, 0x65, 0x18, 0x26, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6c, 0x65,
-	0x12, 0x39, 0x0a, 0x0c, 0x67, 0x75, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x75, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x0b,
-	0x67, 0x75, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x6e,
-	0x75, 0x6d, 0x5f, 0x62, 0x61, 0x72, 0x72, 0x65, 0x6c, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03,
-	0x48, 0x01, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x42, 0x61, 0x72, 0x72, 0x65, 0x6c, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x38, 0x0a, 0x0a, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x18,
-	0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x42, 0x75, 0x72, 0x73, 0x74, 0x46, 0x69, 0x72, 0x65, 0x48, 0x02, 0x52, 0x09, 0x62,
-	0x75, 0x72, 0x73, 0x74, 0x46, 0x69, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0a, 0x61,
-	0x6d, 0x6d, 0x6f, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6d, 0x6f,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x61, 0x6d, 0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
-	0x6d, 0x61, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x5f, 0x67, 0x75,
-	0x6e, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x47, 0x75, 0x6e, 0x48, 0x03, 0x52, 0x08,
-	0x64, 0x69, 0x73, 0x63, 0x6f, 0x47, 0x75, 0x6e, 0x88, 0x01, 0x01, 0x1a, 0x4f, 0x0a, 0x0a, 0x42,
-	0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f,
-	0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x18, 0x0a, 0x16,
-	0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x75, 0x6d, 0x5f, 0x62,
-	0x61, 0x72, 0x72, 0x65, 0x6c, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74,
-	0x5f, 0x66, 0x69, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x5f,
-	0x67, 0x75, 0x6e, 0x22, 0x3d, 0x0a, 0x0b, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x6e,
-	0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x22, 0x3b, 0x0a, 0x07, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a,
-	0x14, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x76, 0x65, 0x6c,
-	0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78,
-	0x41, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x22,
-	0x90, 0x01, 0x0a, 0x0b, 0x47, 0x75, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12,
-	0x2e, 0x0a, 0x13, 0x66, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66, 0x6c,
-	0x61, 0x73, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x45, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68,
-	0x69, 0x74, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x74, 0x45, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x22, 0x82, 0x03, 0x0a, 0x09, 0x42, 0x75, 0x72, 0x73, 0x74, 0x46, 0x69, 0x72, 0x65,
-	0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65,
-	0x66, 0x69, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73, 0x75, 0x73, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x66, 0x69, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x6d, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x61, 0x6d, 0x6d, 0x6f, 0x12,
-	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x40, 0x0a, 0x1d, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x75,
-	0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
-	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x64, 0x48, 0x75, 0x6c, 0x6c, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x53,
-	0x65, 0x63, 0x12, 0x4b, 0x0a, 0x23, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f,
-	0x61, 0x76, 0x67, 0x5f, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67,
-	0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x1e, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x76, 0x67, 0x53, 0x68, 0x69,
-	0x65, 0x6c, 0x64, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12,
-	0x44, 0x0a, 0x1f, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x65,
-	0x72, 0x67, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
-	0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1b, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x64, 0x45, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x50,
-	0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x40, 0x0a, 0x1d, 0x73, 0x75, 0x73, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x64, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x70,
-	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x19, 0x73, 0x75,
-	0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x22, 0x27, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x47, 0x75, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x50, 0x65, 0x6e,
-	0x22, 0x36, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x6e,
-	0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xae, 0x08, 0x0a, 0x04, 0x4d, 0x69, 0x6e,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
-	0x6d, 0x6d, 0x6f, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x61, 0x6d, 0x6d, 0x6f, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6c, 0x65, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65,
-	0x41, 0x72, 0x63, 0x68, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x73,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x73,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x1f, 0x0a, 0x0b, 0x68, 0x75, 0x6c, 0x6c, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x75, 0x6c, 0x6c, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x6e, 0x65, 0x72, 0x67, 0x79,
-	0x44, 0x61, 0x6d, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x69, 0x65, 0x6c,
-	0x64, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
-	0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x69, 0x72, 0x65, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x65, 0x66, 0x69, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x64,
-	0x65, 0x74, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x64, 0x65, 0x74, 0x6f, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x61,
-	0x64, 0x69, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x65, 0x6b, 0x5f, 0x64, 0x69, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x65,
-	0x6b, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70,
-	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x6f,
-	0x70, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x6c, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x63,
-	0x63, 0x65, 0x6c, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69,
-	0x6e, 0x65, 0x61, 0x72, 0x5f, 0x64, 0x72, 0x61, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x44, 0x72, 0x61, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
-	0x69, 0x66, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
-	0x6c, 0x69, 0x66, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x5f, 0x73, 0x61, 0x66, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x53, 0x61, 0x66, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x75, 0x67, 0x68,
-	0x6e, 0x65, 0x73, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x75, 0x67,
-	0x68, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x74, 0x73,
-	0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x69, 0x74, 0x50, 0x74, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x6d,
-	0x6d, 0x6f, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x6d, 0x6d, 0x6f, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x61, 0x6d, 0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d,
-	0x61, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x1b, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
-	0x69, 0x6e, 0x65, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
-	0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x1c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f,
-	0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x1a, 0x4f,
+	0x88, 0x01, 0x01, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22, 0x3a,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xad, 0x06, 0x0a, 0x06, 0x53,
+	0x68, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x44, 0x72,
+	0x61, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x6f, 0x77,
+	0x65, 0x72, 0x44, 0x72, 0x61, 0x77, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x66, 0x66, 0x5f, 0x72, 0x65,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x6f, 0x66, 0x66, 0x52, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x75, 0x67, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x75, 0x67, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x69, 0x74, 0x50, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x68, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x68, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x73, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x73, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61,
+	0x73, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x1a,
+	0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74,
+	0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22, 0x36, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x53, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x61,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0x93, 0x0b, 0x0a, 0x04, 0x53, 0x68, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x72, 0x6d, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x72, 0x6d, 0x6f,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6e, 0x61, 0x6e, 0x6f, 0x62, 0x6f, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x72, 0x75, 0x69, 0x73, 0x65, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x75, 0x69, 0x73,
+	0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72,
+	0x5f, 0x64, 0x72, 0x61, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x69, 0x6e,
+	0x65, 0x61, 0x72, 0x44, 0x72, 0x61, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x4d, 0x61, 0x78, 0x46, 0x6f, 0x72, 0x63,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x75, 0x6c, 0x73, 0x65, 0x5f, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x75, 0x6c, 0x73,
+	0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x11, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d,
+	0x74, 0x68, 0x72, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x75,
+	0x73, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x75, 0x73, 0x74, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x75,
+	0x73, 0x74, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x17, 0x6d, 0x61,
+	0x78, 0x5f, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x67, 0x5f, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6d, 0x61, 0x78,
+	0x41, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x44, 0x65, 0x67, 0x53,
+	0x12, 0x49, 0x0a, 0x22, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x30, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x61,
+	0x6c, 0x66, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1d, 0x61, 0x6e,
+	0x67, 0x75, 0x6c, 0x61, 0x72, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x30, 0x54, 0x6f, 0x48, 0x61, 0x6c, 0x66, 0x53, 0x65, 0x63, 0x12, 0x3c, 0x0a, 0x1b, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x39, 0x30, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6e, 0x67,
+	0x75, 0x6c, 0x61, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x17, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x39, 0x30, 0x4d, 0x61, 0x78, 0x41, 0x6e, 0x67,
+	0x75, 0x6c, 0x61, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x64,
+	0x67, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x6e, 0x75, 0x64, 0x67, 0x65, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x72, 0x61, 0x66, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x73, 0x74, 0x72, 0x61, 0x66, 0x65, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x62, 0x69, 0x67, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x72, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x62, 0x69, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x48, 0x61, 0x72, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0d,
+	0x73, 0x68, 0x69, 0x70, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x1e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x68, 0x69, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x73, 0x68, 0x69,
+	0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x61, 0x73,
+	0x65, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x5f, 0x73, 0x68,
+	0x69, 0x70, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x53, 0x68, 0x69, 0x70, 0x48, 0x01,
+	0x52, 0x09, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x53, 0x68, 0x69, 0x70, 0x88, 0x01, 0x01, 0x1a, 0x4f,
 	0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
 	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b,
 	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
 	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
 	0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
 	0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65,
-	0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x41, 0x6d,
-	0x6d, 0x6f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x12, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x74, 0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x10, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x43,
-	0x61, 0x74, 0x72, 0x69, 0x64, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x6d, 0x61,
-	0x78, 0x5f, 0x63, 0x61, 0x74, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x74, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x73, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x6e, 0x5f, 0x63, 0x61, 0x74, 0x72, 0x69, 0x64, 0x67, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
-	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x74, 0x72, 0x69, 0x64, 0x67, 0x65, 0x73, 0x22, 0x3c, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x61,
-	0x6e, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xea, 0x03, 0x0a, 0x07,
-	0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x61, 0x72, 0x67, 0x6f,
-	0x5f, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0e, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d,
-	0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12,
-	0x33, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x6e,
-	0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62,
-	0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
-	0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x1a, 0x4f, 0x0a,
-	0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47,
-	0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x18,
-	0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63,
-	0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22, 0x3a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
-	0x68, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x22, 0xad, 0x06, 0x0a, 0x06, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x5f, 0x73, 0x68, 0x69, 0x70, 0x22, 0x51, 0x0a, 0x0d, 0x45, 0x71, 0x75, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6c, 0x6f,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6c,
+	0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x45, 0x71, 0x75, 0x69, 0x70, 0x22, 0x29, 0x0a, 0x0b, 0x53,
+	0x68, 0x69, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2a, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x53,
+	0x68, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x4d, 0x75,
+	0x6c, 0x74, 0x22, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x68, 0x72, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x22, 0xb9, 0x04, 0x0a, 0x08, 0x54, 0x68, 0x72, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
-	0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12,
-	0x2b, 0x0a, 0x11, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x13,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x64,
-	0x72, 0x61, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x74, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x44, 0x72, 0x61, 0x77, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
-	0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x44, 0x72, 0x61, 0x77,
-	0x12, 0x28, 0x0a, 0x10, 0x6f, 0x66, 0x66, 0x5f, 0x72, 0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x52,
-	0x65, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f,
-	0x75, 0x67, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74,
-	0x6f, 0x75, 0x67, 0x68, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x69, 0x74, 0x5f,
-	0x70, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x69, 0x74, 0x50, 0x74,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x70, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x70, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x64, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x69, 0x64, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x69, 0x64, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x69, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73,
-	0x18, 0x13, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x05,
-	0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x42,
-	0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73,
-	0x12, 0x57, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65,
-	0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48,
-	0x00, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68,
-	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x74, 0x22, 0x36, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x68, 0x69, 0x70, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x68, 0x69, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x93, 0x0b, 0x0a,
-	0x04, 0x53, 0x68, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x68,
-	0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x61, 0x6e, 0x6f,
-	0x62, 0x6f, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x61, 0x6e, 0x6f,
-	0x62, 0x6f, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x61, 0x74, 0x74, 0x65, 0x72, 0x69,
-	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f,
-	0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a,
-	0x13, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x52, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x63, 0x72, 0x75, 0x69, 0x73, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x75, 0x69, 0x73, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x64, 0x72, 0x61, 0x67, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x44, 0x72, 0x61,
-	0x67, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
-	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x4d, 0x61, 0x78, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69,
-	0x6d, 0x70, 0x75, 0x6c, 0x73, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x75, 0x6c, 0x73, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x70, 0x65,
-	0x65, 0x64, 0x18, 0x11, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x68, 0x72, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x68, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x70,
-	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x68, 0x72,
-	0x75, 0x73, 0x74, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x74,
-	0x68, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x14,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x68, 0x72, 0x75, 0x73, 0x74, 0x52, 0x65, 0x63, 0x68,
-	0x61, 0x72, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6e, 0x67, 0x75,
-	0x6c, 0x61, 0x72, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x67, 0x5f, 0x73, 0x18,
-	0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x41, 0x6e, 0x67, 0x75, 0x6c, 0x61,
-	0x72, 0x53, 0x70, 0x65, 0x65, 0x64, 0x44, 0x65, 0x67, 0x53, 0x12, 0x49, 0x0a, 0x22, 0x61, 0x6e,
-	0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66,
-	0x72, 0x6f, 0x6d, 0x30, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x73, 0x65, 0x63,
-	0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1d, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x44,
-	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x30, 0x54, 0x6f, 0x48, 0x61,
-	0x6c, 0x66, 0x53, 0x65, 0x63, 0x12, 0x3c, 0x0a, 0x1b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f,
-	0x39, 0x30, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x5f, 0x73,
-	0x70, 0x65, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x74, 0x69, 0x6d, 0x65,
-	0x54, 0x6f, 0x39, 0x30, 0x4d, 0x61, 0x78, 0x41, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x53, 0x70,
-	0x65, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x64, 0x67, 0x65, 0x5f, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6e, 0x75, 0x64, 0x67, 0x65, 0x46,
-	0x6f, 0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x61, 0x66, 0x65, 0x5f, 0x66,
-	0x6f, 0x72, 0x63, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x61,
-	0x66, 0x65, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x6c, 0x6f,
-	0x74, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6c,
-	0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x62, 0x69, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x72, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x1d,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x62, 0x69, 0x67, 0x67, 0x65, 0x73, 0x74, 0x48, 0x61, 0x72,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x70,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x50, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x73, 0x68, 0x69, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x1f, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x68, 0x69, 0x70, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
-	0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x20,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f,
-	0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38,
-	0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x5f, 0x73, 0x68, 0x69, 0x70, 0x18, 0x21, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x69, 0x73, 0x63, 0x6f, 0x53, 0x68, 0x69, 0x70, 0x48, 0x01, 0x52, 0x09, 0x64, 0x69, 0x73, 0x63,
-	0x6f, 0x53, 0x68, 0x69, 0x70, 0x88, 0x01, 0x01, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x66, 0x66, 0x69, 0x63,
+	0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x68, 0x69, 0x74, 0x5f, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x69, 0x74, 0x50, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x5f,
+	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x6d, 0x61, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x68, 0x72, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x69,
 	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x5f, 0x73, 0x68,
-	0x69, 0x70, 0x22, 0x51, 0x0a, 0x0d, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x6c, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6c, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x65, 0x71, 0x75, 0x69,
-	0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
-	0x45, 0x71, 0x75, 0x69, 0x70, 0x22, 0x29, 0x0a, 0x0b, 0x53, 0x68, 0x69, 0x70, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x2a, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x53, 0x68, 0x69, 0x70, 0x12, 0x1d, 0x0a,
-	0x0a, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x09, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x22, 0x3e, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x68, 0x72,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xb9, 0x04, 0x0a,
-	0x08, 0x54, 0x68, 0x72, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x6f, 0x72, 0x63, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x63,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x69, 0x74, 0x5f, 0x70,
-	0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x69, 0x74, 0x50, 0x74, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61,
-	0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x34,
-	0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x68, 0x72, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62,
-	0x61, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d,
-	0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
-	0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x88, 0x01, 0x01, 0x1a, 0x4f, 0x0a,
-	0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47,
-	0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x18,
-	0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x74, 0x65, 0x63,
-	0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54,
-	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x9e, 0x03, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
-	0x65, 0x61, 0x63, 0x68, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x72, 0x65, 0x61, 0x63, 0x68, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e,
-	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x61,
-	0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x69, 0x64, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6f, 0x49, 0x64, 0x12, 0x33, 0x0a,
-	0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
-	0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x62, 0x61, 0x73,
-	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x51, 0x0a, 0x0e, 0x68, 0x61,
-	0x73, 0x68, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x48, 0x61,
-	0x73, 0x68, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x69, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0c, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x69, 0x63, 0x6b, 0x1a, 0x50, 0x0a,
-	0x11, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x69, 0x63, 0x6b, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x48, 0x61, 0x73, 0x68, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x46, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x68, 0x65, 0x78, 0x22, 0x34, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x42, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x42, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x87, 0x06,
-	0x0a, 0x07, 0x50, 0x6f, 0x42, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63,
-	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63,
-	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x70, 0x6f, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x19, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6d,
-	0x6f, 0x6e, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x05, 0x6d, 0x6f,
-	0x6e, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x66,
-	0x65, 0x6e, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x05, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x69, 0x63, 0x6b, 0x88, 0x01,
-	0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52,
-	0x0b, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12,
-	0x26, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x0b, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x75, 0x6d,
-	0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x09, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
-	0x48, 0x0a, 0x52, 0x0e, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x53, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x65,
-	0x66, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x6f,
-	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x73, 0x48, 0x0b, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x50,
-	0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
-	0x63, 0x6f, 0x6f, 0x72, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0c, 0x52, 0x0b, 0x73,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
-	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0d, 0x52,
-	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70,
-	0x6f, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6d, 0x6f,
-	0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x69,
-	0x63, 0x6b, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
-	0x69, 0x63, 0x6b, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74,
-	0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x61,
-	0x72, 0x67, 0x6f, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x03, 0x50, 0x6f, 0x42, 0x12, 0x26,
-	0x0a, 0x04, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x42, 0x43, 0x6f, 0x72, 0x65,
-	0x52, 0x04, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x09, 0x73, 0x68, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x08, 0x53,
-	0x68, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x63,
-	0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63,
-	0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x22, 0x3c,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x70,
+	0x70, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54,
+	0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x48, 0x00, 0x52, 0x13, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x54, 0x65, 0x63, 0x68, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x88, 0x01, 0x01, 0x1a, 0x4f, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x22, 0x3c,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70,
 	0x6c, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x9e, 0x03, 0x0a,
+	0x07, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x63, 0x68, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x63, 0x68, 0x53, 0x70, 0x65,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e,
+	0x66, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x66,
+	0x6f, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x1a, 0x4f, 0x0a, 0x0a,
+	0x42, 0x61, 0x73, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x74,
+	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x47, 0x6f,
+	0x6f, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb5, 0x01,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x51, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x69,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x69, 0x63, 0x6b,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x42, 0x79, 0x4e,
+	0x69, 0x63, 0x6b, 0x1a, 0x50, 0x0a, 0x11, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x42, 0x79, 0x4e,
+	0x69, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x46, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x68,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x68, 0x65, 0x78, 0x22, 0x2c, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x11, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd7, 0x01, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x10, 0x0a, 0x03, 0x68, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x68, 0x65,
+	0x78, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x7d, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f,
+	0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x59, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x68, 0x65, 0x78, 0x12, 0x36, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x34,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x42, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x42, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x87, 0x06, 0x0a, 0x07, 0x50, 0x6f, 0x42, 0x43, 0x6f, 0x72, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x15, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x03, 0x70, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x02, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x65,
+	0x66, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x69, 0x63,
+	0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x4e, 0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52,
+	0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26,
+	0x0a, 0x0c, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0b, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x69, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x0b,
+	0x66, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d,
+	0x0a, 0x10, 0x66, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x75,
+	0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
+	0x10, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x65, 0x66,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0a, 0x52, 0x0e, 0x63, 0x61, 0x72, 0x67, 0x6f,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x65, 0x66, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x73, 0x48, 0x0b,
+	0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c,
+	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x0c, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6f, 0x72,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x0d, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x70, 0x6f, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x65, 0x66,
+	0x65, 0x6e, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x66, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x75, 0x72, 0x6c,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x5f, 0x6c, 0x65, 0x66, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70,
+	0x6f, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x6f, 0x72, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x61,
+	0x0a, 0x03, 0x50, 0x6f, 0x42, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x6f, 0x42, 0x43, 0x6f, 0x72, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a,
+	0x0a, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68,
+	0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0xf1, 0x01, 0x0a, 0x08, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x22, 0x3c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x42, 0x47,
+	0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xcc, 0x03, 0x0a, 0x07, 0x50, 0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x37, 0x0a, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x75, 0x79, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x75, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x42, 0x61, 0x73, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x65, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x42, 0x61, 0x73, 0x65, 0x73, 0x12,
+	0x2e, 0x0a, 0x11, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f,
+	0x5f, 0x62, 0x75, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x42, 0x75, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x30, 0x0a, 0x12, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f,
+	0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0f, 0x62,
+	0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a,
+	0x0e, 0x61, 0x6e, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6e, 0x79, 0x42, 0x61, 0x73, 0x65, 0x53, 0x65,
+	0x6c, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6e, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x62, 0x75, 0x79, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6e, 0x79, 0x42,
+	0x61, 0x73, 0x65, 0x42, 0x75, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64, 0x42, 0x61, 0x73, 0x65, 0x52, 0x05,
+	0x62, 0x61, 0x73, 0x65, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x75, 0x79, 0x42, 0x15, 0x0a, 0x13, 0x5f,
+	0x62, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x65,
+	0x6c, 0x6c, 0x22, 0x67, 0x0a, 0x0b, 0x50, 0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64, 0x42, 0x61, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x70, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f,
-	0x42, 0x47, 0x6f, 0x6f, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xcc, 0x03, 0x0a,
-	0x07, 0x50, 0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x42, 0x43, 0x6f, 0x72, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x33, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x50, 0x61, 0x74, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x17, 0x0a,
+	0x04, 0x73, 0x68, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x73,
+	0x68, 0x69, 0x70, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4c,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x52,
+	0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3e, 0x0a, 0x10,
+	0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x73, 0x68, 0x69, 0x70, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x07,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x2f,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50,
+	0x61, 0x74, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x31, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50,
+	0x61, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xbe, 0x01, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x67, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x07, 0x66, 0x72, 0x69, 0x67, 0x61, 0x74,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x09, 0x66, 0x72, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x68, 0x69, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x04, 0x73, 0x68, 0x69, 0x70, 0x88, 0x01, 0x01,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x66, 0x72, 0x69, 0x67, 0x61, 0x74, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66,
+	0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x68, 0x69,
+	0x70, 0x22, 0x5c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f,
+	0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22,
+	0x99, 0x03, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x2f,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50,
+	0x61, 0x74, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x3f, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x3b, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x67, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48,
+	0x01, 0x52, 0x07, 0x66, 0x72, 0x69, 0x67, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a,
+	0x09, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x02,
+	0x52, 0x09, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x04, 0x73, 0x68, 0x69,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x04, 0x52, 0x04, 0x73, 0x68, 0x69, 0x70, 0x88, 0x01, 0x01,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x66, 0x72, 0x69, 0x67, 0x61, 0x74, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66,
+	0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x68, 0x69, 0x70, 0x22, 0x64, 0x0a, 0x11, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x57, 0x61,
+	0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x77, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0x81, 0x02, 0x0a, 0x12, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x57, 0x61, 0x79, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
 	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x62, 0x75, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62,
-	0x61, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x42, 0x75, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x73, 0x65,
-	0x73, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x6c, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x54, 0x6f, 0x42, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x11, 0x62, 0x65, 0x73, 0x74,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x75, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x54, 0x6f, 0x42, 0x75, 0x79, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x12, 0x62, 0x65, 0x73, 0x74,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0f, 0x62, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x6e, 0x79, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x61, 0x6e, 0x79, 0x42, 0x61, 0x73, 0x65, 0x53, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0d,
-	0x61, 0x6e, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x75, 0x79, 0x73, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6e, 0x79, 0x42, 0x61, 0x73, 0x65, 0x42, 0x75, 0x79, 0x73,
-	0x12, 0x2c, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x42, 0x47,
-	0x6f, 0x6f, 0x64, 0x42, 0x61, 0x73, 0x65, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x42, 0x14,
-	0x0a, 0x12, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f,
-	0x5f, 0x62, 0x75, 0x79, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x6c, 0x6c, 0x22, 0x67, 0x0a, 0x0b, 0x50,
-	0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64, 0x42, 0x61, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x68,
-	0x6f, 0x70, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x68, 0x6f, 0x70, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x70, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x26, 0x0a, 0x04,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x42, 0x43, 0x6f, 0x72, 0x65, 0x52, 0x04,
-	0x62, 0x61, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68,
-	0x50, 0x61, 0x74, 0x68, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x71, 0x75,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74,
-	0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22,
-	0x34, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70,
-	0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x61,
-	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70,
-	0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61,
-	0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x2f, 0x0a,
-	0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61,
-	0x74, 0x68, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x31,
-	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61,
-	0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x9c, 0x01, 0x0a, 0x0d, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x67, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x07, 0x66, 0x72, 0x69, 0x67, 0x61, 0x74, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x09, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x70, 0x6f, 0x72, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x72, 0x69, 0x67, 0x61, 0x74, 0x65,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x66, 0x72, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x72, 0x32, 0xa6,
-	0x0c, 0x0a, 0x08, 0x44, 0x61, 0x72, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x47,
-	0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x12, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x4e, 0x70,
-	0x63, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65,
-	0x73, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74,
-	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x42, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65,
-	0x73, 0x50, 0x6f, 0x42, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
-	0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x42, 0x73, 0x12, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x42, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x3c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x12, 0x10,
-	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x52, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
-	0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
-	0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x3d, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x75, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x6e, 0x73,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6f,
+	0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x6f, 0x73, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x9f, 0x03, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x17, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x64,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x64, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x61,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0b,
+	0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x2e, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x3e, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x64, 0x69, 0x74, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x22, 0x65, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x6c, 0x64,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x68, 0x6f, 0x6c,
+	0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x68, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x6c,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x75, 0x6e, 0x52,
+	0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88,
+	0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa8, 0x03, 0x0a,
+	0x0d, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x75, 0x6e, 0x12, 0x2d,
+	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x64, 0x69, 0x74, 0x79, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x61, 0x73, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
+	0x42, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x74, 0x6f, 0x42, 0x61, 0x73, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x42, 0x61, 0x73, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x75, 0x79, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x50, 0x65, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x75, 0x6e, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x50, 0x65, 0x72, 0x48, 0x6f,
+	0x75, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x32, 0xa4, 0x0e, 0x0a, 0x08, 0x44, 0x61, 0x72, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x12, 0x35,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x10, 0x2e, 0x73, 0x74,
+	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65,
+	0x73, 0x4e, 0x70, 0x63, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x18,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x73, 0x65, 0x73, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x18,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x42, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x73, 0x65, 0x73, 0x50, 0x6f, 0x42, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x42, 0x73, 0x12, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x42, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x3c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64,
+	0x73, 0x12, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x42, 0x47, 0x6f, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x52, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x69, 0x65, 0x73, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x64, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x75, 0x6e, 0x73, 0x12,
 	0x17, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47,
 	0x75, 0x6e, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6d, 0x6f, 0x73, 0x12,
-	0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45,
-	0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e,
-	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6d,
-	0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1c,
-	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x71,
-	0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x22, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x65, 0x72, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73,
-	0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
-	0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1a,
-	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x47, 0x75, 0x6e, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x74,
+	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x75, 0x6e, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x41, 0x6d, 0x6d,
+	0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x6d, 0x6d, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
 	0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
 	0x74, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
-	0x1b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1c, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x71, 0x75, 0x69,
-	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53,
-	0x68, 0x69, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74,
+	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x71, 0x75, 0x69, 0x70,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2e, 0x47, 0x65, 0x74, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70,
-	0x75, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c,
-	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x71,
-	0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x74,
+	0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45,
+	0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1a, 0x2e,
+	0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x53, 0x68, 0x69, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x1c, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x68, 0x72, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74,
 	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x38, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
-	0x10, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
-	0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4a, 0x0a, 0x0c,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1c, 0x2e, 0x73,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x12, 0x10, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x4d, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x12, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a,
+	0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4a,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1c,
+	0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x73,
 	0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x63, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x74, 0x61,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x63, 0x61,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47,
-	0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61,
-	0x74, 0x68, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74,
-	0x68, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x72, 0x6b, 0x6c, 0x61, 0x62, 0x38, 0x2f, 0x66,
-	0x6c, 0x2d, 0x64, 0x61, 0x72, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64, 0x61, 0x72, 0x6b, 0x61,
-	0x70, 0x69, 0x73, 0x2f, 0x64, 0x61, 0x72, 0x6b, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74, 0x61,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4d, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x74,
+	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x50, 0x61, 0x74, 0x68, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x74, 0x61,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x1d, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x61, 0x74, 0x68, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x1a, 0x24, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x50, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x72, 0x6b, 0x6c, 0x61, 0x62, 0x38, 0x2f,
+	0x66, 0x6c, 0x2d, 0x64, 0x61, 0x72, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64, 0x61, 0x72, 0x6b,
+	0x61, 0x70, 0x69, 0x73, 0x2f, 0x64, 0x61, 0x72, 0x6b, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -7267,95 +8542,111 @@ func file_darkstat_proto_rawDescGZIP() []byte {
 	return file_darkstat_proto_rawDescData
 }
 
-var file_darkstat_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
+var file_darkstat_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
 var file_darkstat_proto_goTypes = []any{
-	(*Empty)(nil),                   // 0: statproto.Empty
-	(*GetInfocardsInput)(nil),       // 1: statproto.GetInfocardsInput
-	(*GetInfocardsReply)(nil),       // 2: statproto.GetInfocardsReply
-	(*GetInfocardAnswer)(nil),       // 3: statproto.GetInfocardAnswer
-	(*Infocard)(nil),                // 4: statproto.Infocard
-	(*InfocardLine)(nil),            // 5: statproto.InfocardLine
-	(*InfocardPhrase)(nil),          // 6: statproto.InfocardPhrase
-	(*HealthReply)(nil),             // 7: statproto.HealthReply
-	(*GetEquipmentInput)(nil),       // 8: statproto.GetEquipmentInput
-	(*GetGunsInput)(nil),            // 9: statproto.GetGunsInput
-	(*GetBasesInput)(nil),           // 10: statproto.GetBasesInput
-	(*GetTractorsInput)(nil),        // 11: statproto.GetTractorsInput
-	(*GetBasesReply)(nil),           // 12: statproto.GetBasesReply
-	(*Base)(nil),                    // 13: statproto.Base
-	(*MiningInfo)(nil),              // 14: statproto.MiningInfo
-	(*MarketGood)(nil),              // 15: statproto.MarketGood
-	(*BaseInfo)(nil),                // 16: statproto.BaseInfo
-	(*Pos)(nil),                     // 17: statproto.Pos
-	(*GetCommoditiesInput)(nil),     // 18: statproto.GetCommoditiesInput
-	(*GetCommoditiesReply)(nil),     // 19: statproto.GetCommoditiesReply
-	(*Commodity)(nil),               // 20: statproto.Commodity
-	(*GetAmmoReply)(nil),            // 21: statproto.GetAmmoReply
-	(*Ammo)(nil),                    // 22: statproto.Ammo
-	(*DiscoveryTechCompat)(nil),     // 23: statproto.DiscoveryTechCompat
-	(*TechCompatAnswer)(nil),        // 24: statproto.TechCompatAnswer
-	(*GetTechCompatInput)(nil),      // 25: statproto.GetTechCompatInput
-	(*GetTechCompatReply)(nil),      // 26: statproto.GetTechCompatReply
-	(*GetCounterMeasuresReply)(nil), // 27: statproto.GetCounterMeasuresReply
-	(*CounterMeasure)(nil),          // 28: statproto.CounterMeasure
-	(*GetEnginesReply)(nil),         // 29: statproto.GetEnginesReply
-	(*Engine)(nil),                  // 30: statproto.Engine
-	(*GetFactionsInput)(nil),        // 31: statproto.GetFactionsInput
-	(*GetFactionsReply)(nil),        // 32: statproto.GetFactionsReply
-	(*Faction)(nil),                 // 33: statproto.Faction
-	(*Reputation)(nil),              // 34: statproto.Reputation
-	(*Bribe)(nil),                   // 35: statproto.Bribe
-	(*GetGunsReply)(nil),            // 36: statproto.GetGunsReply
-	(*Gun)(nil),                     // 37: statproto.Gun
-	(*DamageBonus)(nil),             // 38: statproto.DamageBonus
-	(*Missile)(nil),                 // 39: statproto.Missile
-	(*GunDetailed)(nil),             // 40: statproto.GunDetailed
-	(*BurstFire)(nil),               // 41: statproto.BurstFire
-	(*DiscoGun)(nil),                // 42: statproto.DiscoGun
-	(*GetMinesReply)(nil),           // 43: statproto.GetMinesReply
-	(*Mine)(nil),                    // 44: statproto.Mine
-	(*AmmoLimit)(nil),               // 45: statproto.AmmoLimit
-	(*GetScannersReply)(nil),        // 46: statproto.GetScannersReply
-	(*Scanner)(nil),                 // 47: statproto.Scanner
-	(*GetShieldsReply)(nil),         // 48: statproto.GetShieldsReply
-	(*Shield)(nil),                  // 49: statproto.Shield
-	(*GetShipsReply)(nil),           // 50: statproto.GetShipsReply
-	(*Ship)(nil),                    // 51: statproto.Ship
-	(*EquipmentSlot)(nil),           // 52: statproto.EquipmentSlot
-	(*ShipPackage)(nil),             // 53: statproto.ShipPackage
-	(*DiscoShip)(nil),               // 54: statproto.DiscoShip
-	(*GetThrustersReply)(nil),       // 55: statproto.GetThrustersReply
-	(*Thruster)(nil),                // 56: statproto.Thruster
-	(*GetTractorsReply)(nil),        // 57: statproto.GetTractorsReply
-	(*Tractor)(nil),                 // 58: statproto.Tractor
-	(*GetHashesReply)(nil),          // 59: statproto.GetHashesReply
-	(*Hash)(nil),                    // 60: statproto.Hash
-	(*GetPoBsReply)(nil),            // 61: statproto.GetPoBsReply
-	(*PoBCore)(nil),                 // 62: statproto.PoBCore
-	(*PoB)(nil),                     // 63: statproto.PoB
-	(*ShopItem)(nil),                // 64: statproto.ShopItem
-	(*GetPoBGoodsReply)(nil),        // 65: statproto.GetPoBGoodsReply
-	(*PoBGood)(nil),                 // 66: statproto.PoBGood
-	(*PoBGoodBase)(nil),             // 67: statproto.PoBGoodBase
-	(*GetGraphPathsInput)(nil),      // 68: statproto.GetGraphPathsInput
-	(*GraphPathQuery)(nil),          // 69: statproto.GraphPathQuery
-	(*GetGraphPathsReply)(nil),      // 70: statproto.GetGraphPathsReply
-	(*GetGraphPathsAnswer)(nil),     // 71: statproto.GetGraphPathsAnswer
-	(*GraphPathTime)(nil),           // 72: statproto.GraphPathTime
-	nil,                             // 73: statproto.Base.MarketGoodsPerNickEntry
-	nil,                             // 74: statproto.Commodity.BasesEntry
-	nil,                             // 75: statproto.Ammo.BasesEntry
-	nil,                             // 76: statproto.DiscoveryTechCompat.TechcompatByIdEntry
-	nil,                             // 77: statproto.CounterMeasure.BasesEntry
-	nil,                             // 78: statproto.Engine.BasesEntry
-	nil,                             // 79: statproto.Gun.BasesEntry
-	nil,                             // 80: statproto.Mine.BasesEntry
-	nil,                             // 81: statproto.Scanner.BasesEntry
-	nil,                             // 82: statproto.Shield.BasesEntry
-	nil,                             // 83: statproto.Ship.BasesEntry
-	nil,                             // 84: statproto.Thruster.BasesEntry
-	nil,                             // 85: statproto.Tractor.BasesEntry
-	nil,                             // 86: statproto.GetHashesReply.HashesByNickEntry
+	(*Empty)(nil),                      // 0: statproto.Empty
+	(*GetInfocardsInput)(nil),          // 1: statproto.GetInfocardsInput
+	(*GetInfocardsReply)(nil),          // 2: statproto.GetInfocardsReply
+	(*GetInfocardAnswer)(nil),          // 3: statproto.GetInfocardAnswer
+	(*Infocard)(nil),                   // 4: statproto.Infocard
+	(*InfocardLine)(nil),               // 5: statproto.InfocardLine
+	(*InfocardPhrase)(nil),             // 6: statproto.InfocardPhrase
+	(*HealthReply)(nil),                // 7: statproto.HealthReply
+	(*GetEquipmentInput)(nil),          // 8: statproto.GetEquipmentInput
+	(*GetGunsInput)(nil),               // 9: statproto.GetGunsInput
+	(*GetBasesInput)(nil),              // 10: statproto.GetBasesInput
+	(*GetTractorsInput)(nil),           // 11: statproto.GetTractorsInput
+	(*GetBasesReply)(nil),              // 12: statproto.GetBasesReply
+	(*Base)(nil),                       // 13: statproto.Base
+	(*MiningInfo)(nil),                 // 14: statproto.MiningInfo
+	(*MarketGood)(nil),                 // 15: statproto.MarketGood
+	(*BaseInfo)(nil),                   // 16: statproto.BaseInfo
+	(*Pos)(nil),                        // 17: statproto.Pos
+	(*GetCommoditiesInput)(nil),        // 18: statproto.GetCommoditiesInput
+	(*GetCommoditiesReply)(nil),        // 19: statproto.GetCommoditiesReply
+	(*Commodity)(nil),                  // 20: statproto.Commodity
+	(*GetAmmoReply)(nil),               // 21: statproto.GetAmmoReply
+	(*Ammo)(nil),                       // 22: statproto.Ammo
+	(*DiscoveryTechCompat)(nil),        // 23: statproto.DiscoveryTechCompat
+	(*TechCompatAnswer)(nil),           // 24: statproto.TechCompatAnswer
+	(*GetTechCompatInput)(nil),         // 25: statproto.GetTechCompatInput
+	(*GetTechCompatReply)(nil),         // 26: statproto.GetTechCompatReply
+	(*GetCounterMeasuresReply)(nil),    // 27: statproto.GetCounterMeasuresReply
+	(*CounterMeasure)(nil),             // 28: statproto.CounterMeasure
+	(*GetEnginesReply)(nil),            // 29: statproto.GetEnginesReply
+	(*Engine)(nil),                     // 30: statproto.Engine
+	(*GetFactionsInput)(nil),           // 31: statproto.GetFactionsInput
+	(*GetFactionsReply)(nil),           // 32: statproto.GetFactionsReply
+	(*Faction)(nil),                    // 33: statproto.Faction
+	(*Reputation)(nil),                 // 34: statproto.Reputation
+	(*Bribe)(nil),                      // 35: statproto.Bribe
+	(*GetGunsReply)(nil),               // 36: statproto.GetGunsReply
+	(*Gun)(nil),                        // 37: statproto.Gun
+	(*DamageBonus)(nil),                // 38: statproto.DamageBonus
+	(*Missile)(nil),                    // 39: statproto.Missile
+	(*GunDetailed)(nil),                // 40: statproto.GunDetailed
+	(*BurstFire)(nil),                  // 41: statproto.BurstFire
+	(*DiscoGun)(nil),                   // 42: statproto.DiscoGun
+	(*GetMinesReply)(nil),              // 43: statproto.GetMinesReply
+	(*Mine)(nil),                       // 44: statproto.Mine
+	(*AmmoLimit)(nil),                  // 45: statproto.AmmoLimit
+	(*GetScannersReply)(nil),           // 46: statproto.GetScannersReply
+	(*Scanner)(nil),                    // 47: statproto.Scanner
+	(*GetShieldsReply)(nil),            // 48: statproto.GetShieldsReply
+	(*Shield)(nil),                     // 49: statproto.Shield
+	(*GetShipsReply)(nil),              // 50: statproto.GetShipsReply
+	(*Ship)(nil),                       // 51: statproto.Ship
+	(*EquipmentSlot)(nil),              // 52: statproto.EquipmentSlot
+	(*ShipPackage)(nil),                // 53: statproto.ShipPackage
+	(*DiscoShip)(nil),                  // 54: statproto.DiscoShip
+	(*GetThrustersReply)(nil),          // 55: statproto.GetThrustersReply
+	(*Thruster)(nil),                   // 56: statproto.Thruster
+	(*GetTractorsReply)(nil),           // 57: statproto.GetTractorsReply
+	(*Tractor)(nil),                    // 58: statproto.Tractor
+	(*GetHashesReply)(nil),             // 59: statproto.GetHashesReply
+	(*Hash)(nil),                       // 60: statproto.Hash
+	(*ResolveHashesInput)(nil),         // 61: statproto.ResolveHashesInput
+	(*ResolvedHashEntry)(nil),          // 62: statproto.ResolvedHashEntry
+	(*ResolvedHash)(nil),               // 63: statproto.ResolvedHash
+	(*ResolveHashesReply)(nil),         // 64: statproto.ResolveHashesReply
+	(*HashCollision)(nil),              // 65: statproto.HashCollision
+	(*GetPoBsReply)(nil),               // 66: statproto.GetPoBsReply
+	(*PoBCore)(nil),                    // 67: statproto.PoBCore
+	(*PoB)(nil),                        // 68: statproto.PoB
+	(*ShopItem)(nil),                   // 69: statproto.ShopItem
+	(*GetPoBGoodsReply)(nil),           // 70: statproto.GetPoBGoodsReply
+	(*PoBGood)(nil),                    // 71: statproto.PoBGood
+	(*PoBGoodBase)(nil),                // 72: statproto.PoBGoodBase
+	(*GetGraphPathsInput)(nil),         // 73: statproto.GetGraphPathsInput
+	(*GraphPathQuery)(nil),             // 74: statproto.GraphPathQuery
+	(*GetGraphPathsReply)(nil),         // 75: statproto.GetGraphPathsReply
+	(*GetGraphPathsAnswer)(nil),        // 76: statproto.GetGraphPathsAnswer
+	(*GraphPathTime)(nil),              // 77: statproto.GraphPathTime
+	(*GetGraphRouteDetailsReply)(nil),  // 78: statproto.GetGraphRouteDetailsReply
+	(*GetGraphRouteDetailsAnswer)(nil), // 79: statproto.GetGraphRouteDetailsAnswer
+	(*GraphRouteDetails)(nil),          // 80: statproto.GraphRouteDetails
+	(*GraphRouteWaypoint)(nil),         // 81: statproto.GraphRouteWaypoint
+	(*GetTradeRoutesInput)(nil),        // 82: statproto.GetTradeRoutesInput
+	(*TradeCapacity)(nil),              // 83: statproto.TradeCapacity
+	(*GetTradeRoutesReply)(nil),        // 84: statproto.GetTradeRoutesReply
+	(*TradeRouteRun)(nil),              // 85: statproto.TradeRouteRun
+	(*TradeRun)(nil),                   // 86: statproto.TradeRun
+	nil,                                // 87: statproto.Base.MarketGoodsPerNickEntry
+	nil,                                // 88: statproto.Commodity.BasesEntry
+	nil,                                // 89: statproto.Ammo.BasesEntry
+	nil,                                // 90: statproto.DiscoveryTechCompat.TechcompatByIdEntry
+	nil,                                // 91: statproto.CounterMeasure.BasesEntry
+	nil,                                // 92: statproto.Engine.BasesEntry
+	nil,                                // 93: statproto.Gun.BasesEntry
+	nil,                                // 94: statproto.Mine.BasesEntry
+	nil,                                // 95: statproto.Scanner.BasesEntry
+	nil,                                // 96: statproto.Shield.BasesEntry
+	nil,                                // 97: statproto.Ship.BasesEntry
+	nil,                                // 98: statproto.Thruster.BasesEntry
+	nil,                                // 99: statproto.Tractor.BasesEntry
+	nil,                                // 100: statproto.GetHashesReply.HashesByNickEntry
+	nil,                                // 101: statproto.GraphPathQuery.ReputationsEntry
+	nil,                                // 102: statproto.GetTradeRoutesInput.ReputationsEntry
 }
 var file_darkstat_proto_depIdxs = []int32{
 	3,   // 0: statproto.GetInfocardsReply.answers:type_name -> statproto.GetInfocardAnswer
@@ -7364,32 +8655,32 @@ var file_darkstat_proto_depIdxs = []int32{
 	6,   // 3: statproto.InfocardLine.phrases:type_name -> statproto.InfocardPhrase
 	13,  // 4: statproto.GetBasesReply.items:type_name -> statproto.Base
 	17,  // 5: statproto.Base.pos:type_name -> statproto.Pos
-	73,  // 6: statproto.Base.market_goods_per_nick:type_name -> statproto.Base.MarketGoodsPerNickEntry
+	87,  // 6: statproto.Base.market_goods_per_nick:type_name -> statproto.Base.MarketGoodsPerNickEntry
 	15,  // 7: statproto.MiningInfo.mined_good:type_name -> statproto.MarketGood
 	16,  // 8: statproto.MarketGood.base_info:type_name -> statproto.BaseInfo
 	17,  // 9: statproto.BaseInfo.base_pos:type_name -> statproto.Pos
 	20,  // 10: statproto.GetCommoditiesReply.items:type_name -> statproto.Commodity
-	74,  // 11: statproto.Commodity.bases:type_name -> statproto.Commodity.BasesEntry
+	88,  // 11: statproto.Commodity.bases:type_name -> statproto.Commodity.BasesEntry
 	22,  // 12: statproto.GetAmmoReply.items:type_name -> statproto.Ammo
-	75,  // 13: statproto.Ammo.bases:type_name -> statproto.Ammo.BasesEntry
+	89,  // 13: statproto.Ammo.bases:type_name -> statproto.Ammo.BasesEntry
 	23,  // 14: statproto.Ammo.discovery_tech_compat:type_name -> statproto.DiscoveryTechCompat
 	45,  // 15: statproto.Ammo.ammo_limit:type_name -> statproto.AmmoLimit
-	76,  // 16: statproto.DiscoveryTechCompat.techcompat_by_id:type_name -> statproto.DiscoveryTechCompat.TechcompatByIdEntry
+	90,  // 16: statproto.DiscoveryTechCompat.techcompat_by_id:type_name -> statproto.DiscoveryTechCompat.TechcompatByIdEntry
 	23,  // 17: statproto.TechCompatAnswer.tech_compat:type_name -> statproto.DiscoveryTechCompat
 	24,  // 18: statproto.GetTechCompatReply.answers:type_name -> statproto.TechCompatAnswer
 	28,  // 19: statproto.GetCounterMeasuresReply.items:type_name -> statproto.CounterMeasure
-	77,  // 20: statproto.CounterMeasure.bases:type_name -> statproto.CounterMeasure.BasesEntry
+	91,  // 20: statproto.CounterMeasure.bases:type_name -> statproto.CounterMeasure.BasesEntry
 	23,  // 21: statproto.CounterMeasure.discovery_tech_compat:type_name -> statproto.DiscoveryTechCompat
 	45,  // 22: statproto.CounterMeasure.ammo_limit:type_name -> statproto.AmmoLimit
 	30,  // 23: statproto.GetEnginesReply.items:type_name -> statproto.Engine
-	78,  // 24: statproto.Engine.bases:type_name -> statproto.Engine.BasesEntry
+	92,  // 24: statproto.Engine.bases:type_name -> statproto.Engine.BasesEntry
 	23,  // 25: statproto.Engine.discovery_tech_compat:type_name -> statproto.DiscoveryTechCompat
 	33,  // 26: statproto.GetFactionsReply.items:type_name -> statproto.Faction
 	34,  // 27: statproto.Faction.reputations:type_name -> statproto.Reputation
 	35,  // 28: statproto.Faction.bribes:type_name -> statproto.Bribe
 	16,  // 29: statproto.Bribe.base_info:type_name -> statproto.BaseInfo
 	37,  // 30: statproto.GetGunsReply.items:type_name -> statproto.Gun
-	79,  // 31: statproto.Gun.bases:type_name -> statproto.Gun.BasesEntry
+	93,  // 31: statproto.Gun.bases:type_name -> statproto.Gun.BasesEntry
 	23,  // 32: statproto.Gun.discovery_tech_compat:type_name -> statproto.DiscoveryTechCompat
 	38,  // 33: statproto.Gun.damage_bonuses:type_name -> statproto.DamageBonus
 	39,  // 34: statproto.Gun.missile:type_name -> statproto.Missile
@@ -7399,100 +8690,123 @@ var file_darkstat_proto_depIdxs = []int32{
 	42,  // 38: statproto.Gun.disco_gun:type_name -> statproto.DiscoGun
 	44,  // 39: statproto.GetMinesReply.items:type_name -> statproto.Mine
 	45,  // 40: statproto.Mine.ammo_limit:type_name -> statproto.AmmoLimit
-	80,  // 41: statproto.Mine.bases:type_name -> statproto.Mine.BasesEntry
+	94,  // 41: statproto.Mine.bases:type_name -> statproto.Mine.BasesEntry
 	23,  // 42: statproto.Mine.discovery_tech_compat:type_name -> statproto.DiscoveryTechCompat
 	47,  // 43: statproto.GetScannersReply.items:type_name -> statproto.Scanner
-	81,  // 44: statproto.Scanner.bases:type_name -> statproto.Scanner.BasesEntry
+	95,  // 44: statproto.Scanner.bases:type_name -> statproto.Scanner.BasesEntry
 	23,  // 45: statproto.Scanner.discovery_tech_compat:type_name -> statproto.DiscoveryTechCompat
 	49,  // 46: statproto.GetShieldsReply.items:type_name -> statproto.Shield
-	82,  // 47: statproto.Shield.bases:type_name -> statproto.Shield.BasesEntry
+	96,  // 47: statproto.Shield.bases:type_name -> statproto.Shield.BasesEntry
 	23,  // 48: statproto.Shield.discovery_tech_compat:type_name -> statproto.DiscoveryTechCompat
 	51,  // 49: statproto.GetShipsReply.items:type_name -> statproto.Ship
 	52,  // 50: statproto.Ship.slots:type_name -> statproto.EquipmentSlot
 	53,  // 51: statproto.Ship.ship_packages:type_name -> statproto.ShipPackage
-	83,  // 52: statproto.Ship.bases:type_name -> statproto.Ship.BasesEntry
+	97,  // 52: statproto.Ship.bases:type_name -> statproto.Ship.BasesEntry
 	23,  // 53: statproto.Ship.discovery_tech_compat:type_name -> statproto.DiscoveryTechCompat
 	54,  // 54: statproto.Ship.disco_ship:type_name -> statproto.DiscoShip
 	56,  // 55: statproto.GetThrustersReply.items:type_name -> statproto.Thruster
-	84,  // 56: statproto.Thruster.bases:type_name -> statproto.Thruster.BasesEntry
+	98,  // 56: statproto.Thruster.bases:type_name -> statproto.Thruster.BasesEntry
 	23,  // 57: statproto.Thruster.discovery_tech_compat:type_name -> statproto.DiscoveryTechCompat
 	58,  // 58: statproto.GetTractorsReply.items:type_name -> statproto.Tractor
-	85,  // 59: statproto.Tractor.bases:type_name -> statproto.Tractor.BasesEntry
-	86,  // 60: statproto.GetHashesReply.hashes_by_nick:type_name -> statproto.GetHashesReply.HashesByNickEntry
-	63,  // 61: statproto.GetPoBsReply.items:type_name -> statproto.PoB
-	17,  // 62: statproto.PoBCore.base_pos:type_name -> statproto.Pos
-	62,  // 63: statproto.PoB.core:type_name -> statproto.PoBCore
-	64,  // 64: statproto.PoB.shop_items:type_name -> statproto.ShopItem
-	66,  // 65: statproto.GetPoBGoodsReply.items:type_name -> statproto.PoBGood
-	67,  // 66: statproto.PoBGood.bases:type_name -> statproto.PoBGoodBase
-	64,  // 67: statproto.PoBGoodBase.shop_item:type_name -> statproto.ShopItem
-	62,  // 68: statproto.PoBGoodBase.base:type_name -> statproto.PoBCore
-	69,  // 69: statproto.GetGraphPathsInput.queries:type_name -> statproto.GraphPathQuery
-	71,  // 70: statproto.GetGraphPathsReply.answers:type_name -> statproto.GetGraphPathsAnswer
-	69,  // 71: statproto.GetGraphPathsAnswer.route:type_name -> statproto.GraphPathQuery
-	72,  // 72: statproto.GetGraphPathsAnswer.time:type_name -> statproto.GraphPathTime
-	15,  // 73: statproto.Base.MarketGoodsPerNickEntry.value:type_name -> statproto.MarketGood
-	15,  // 74: statproto.Commodity.BasesEntry.value:type_name -> statproto.MarketGood
-	15,  // 75: statproto.Ammo.BasesEntry.value:type_name -> statproto.MarketGood
-	15,  // 76: statproto.CounterMeasure.BasesEntry.value:type_name -> statproto.MarketGood
-	15,  // 77: statproto.Engine.BasesEntry.value:type_name -> statproto.MarketGood
-	15,  // 78: statproto.Gun.BasesEntry.value:type_name -> statproto.MarketGood
-	15,  // 79: statproto.Mine.BasesEntry.value:type_name -> statproto.MarketGood
-	15,  // 80: statproto.Scanner.BasesEntry.value:type_name -> statproto.MarketGood
-	15,  // 81: statproto.Shield.BasesEntry.value:type_name -> statproto.MarketGood
-	15,  // 82: statproto.Ship.BasesEntry.value:type_name -> statproto.MarketGood
-	15,  // 83: statproto.Thruster.BasesEntry.value:type_name -> statproto.MarketGood
-	15,  // 84: statproto.Tractor.BasesEntry.value:type_name -> statproto.MarketGood
-	60,  // 85: statproto.GetHashesReply.HashesByNickEntry.value:type_name -> statproto.Hash
-	0,   // 86: statproto.Darkstat.GetHealth:input_type -> statproto.Empty
-	10,  // 87: statproto.Darkstat.GetBasesNpc:input_type -> statproto.GetBasesInput
-	10,  // 88: statproto.Darkstat.GetBasesMiningOperations:input_type -> statproto.GetBasesInput
-	10,  // 89: statproto.Darkstat.GetBasesPoBs:input_type -> statproto.GetBasesInput
-	0,   // 90: statproto.Darkstat.GetPoBs:input_type -> statproto.Empty
-	0,   // 91: statproto.Darkstat.GetPoBGoods:input_type -> statproto.Empty
-	18,  // 92: statproto.Darkstat.GetCommodities:input_type -> statproto.GetCommoditiesInput
-	9,   // 93: statproto.Darkstat.GetGuns:input_type -> statproto.GetGunsInput
-	9,   // 94: statproto.Darkstat.GetMissiles:input_type -> statproto.GetGunsInput
-	8,   // 95: statproto.Darkstat.GetAmmos:input_type -> statproto.GetEquipmentInput
-	8,   // 96: statproto.Darkstat.GetCounterMeasures:input_type -> statproto.GetEquipmentInput
-	8,   // 97: statproto.Darkstat.GetEngines:input_type -> statproto.GetEquipmentInput
-	8,   // 98: statproto.Darkstat.GetMines:input_type -> statproto.GetEquipmentInput
-	8,   // 99: statproto.Darkstat.GetScanners:input_type -> statproto.GetEquipmentInput
-	8,   // 100: statproto.Darkstat.GetShields:input_type -> statproto.GetEquipmentInput
-	8,   // 101: statproto.Darkstat.GetShips:input_type -> statproto.GetEquipmentInput
-	8,   // 102: statproto.Darkstat.GetThrusters:input_type -> statproto.GetEquipmentInput
-	31,  // 103: statproto.Darkstat.GetFactions:input_type -> statproto.GetFactionsInput
-	11,  // 104: statproto.Darkstat.GetTractors:input_type -> statproto.GetTractorsInput
-	0,   // 105: statproto.Darkstat.GetHashes:input_type -> statproto.Empty
-	1,   // 106: statproto.Darkstat.GetInfocards:input_type -> statproto.GetInfocardsInput
-	68,  // 107: statproto.Darkstat.GetGraphPaths:input_type -> statproto.GetGraphPathsInput
-	7,   // 108: statproto.Darkstat.GetHealth:output_type -> statproto.HealthReply
-	12,  // 109: statproto.Darkstat.GetBasesNpc:output_type -> statproto.GetBasesReply
-	12,  // 110: statproto.Darkstat.GetBasesMiningOperations:output_type -> statproto.GetBasesReply
-	12,  // 111: statproto.Darkstat.GetBasesPoBs:output_type -> statproto.GetBasesReply
-	61,  // 112: statproto.Darkstat.GetPoBs:output_type -> statproto.GetPoBsReply
-	65,  // 113: statproto.Darkstat.GetPoBGoods:output_type -> statproto.GetPoBGoodsReply
-	19,  // 114: statproto.Darkstat.GetCommodities:output_type -> statproto.GetCommoditiesReply
-	36,  // 115: statproto.Darkstat.GetGuns:output_type -> statproto.GetGunsReply
-	36,  // 116: statproto.Darkstat.GetMissiles:output_type -> statproto.GetGunsReply
-	21,  // 117: statproto.Darkstat.GetAmmos:output_type -> statproto.GetAmmoReply
-	27,  // 118: statproto.Darkstat.GetCounterMeasures:output_type -> statproto.GetCounterMeasuresReply
-	29,  // 119: statproto.Darkstat.GetEngines:output_type -> statproto.GetEnginesReply
-	43,  // 120: statproto.Darkstat.GetMines:output_type -> statproto.GetMinesReply
-	46,  // 121: statproto.Darkstat.GetScanners:output_type -> statproto.GetScannersReply
-	48,  // 122: statproto.Darkstat.GetShields:output_type -> statproto.GetShieldsReply
-	50,  // 123: statproto.Darkstat.GetShips:output_type -> statproto.GetShipsReply
-	55,  // 124: statproto.Darkstat.GetThrusters:output_type -> statproto.GetThrustersReply
-	32,  // 125: statproto.Darkstat.GetFactions:output_type -> statproto.GetFactionsReply
-	57,  // 126: statproto.Darkstat.GetTractors:output_type -> statproto.GetTractorsReply
-	59,  // 127: statproto.Darkstat.GetHashes:output_type -> statproto.GetHashesReply
-	2,   // 128: statproto.Darkstat.GetInfocards:output_type -> statproto.GetInfocardsReply
-	70,  // 129: statproto.Darkstat.GetGraphPaths:output_type -> statproto.GetGraphPathsReply
-	108, // [108:130] is the sub-list for method output_type
-	86,  // [86:108] is the sub-list for method input_type
-	86,  // [86:86] is the sub-list for extension type_name
-	86,  // [86:86] is the sub-list for extension extendee
-	0,   // [0:86] is the sub-list for field type_name
+	99,  // 59: statproto.Tractor.bases:type_name -> statproto.Tractor.BasesEntry
+	100, // 60: statproto.GetHashesReply.hashes_by_nick:type_name -> statproto.GetHashesReply.HashesByNickEntry
+	62,  // 61: statproto.ResolvedHash.entries:type_name -> statproto.ResolvedHashEntry
+	63,  // 62: statproto.ResolveHashesReply.items:type_name -> statproto.ResolvedHash
+	65,  // 63: statproto.ResolveHashesReply.collisions:type_name -> statproto.HashCollision
+	62,  // 64: statproto.HashCollision.entries:type_name -> statproto.ResolvedHashEntry
+	68,  // 65: statproto.GetPoBsReply.items:type_name -> statproto.PoB
+	17,  // 66: statproto.PoBCore.base_pos:type_name -> statproto.Pos
+	67,  // 67: statproto.PoB.core:type_name -> statproto.PoBCore
+	69,  // 68: statproto.PoB.shop_items:type_name -> statproto.ShopItem
+	71,  // 69: statproto.GetPoBGoodsReply.items:type_name -> statproto.PoBGood
+	72,  // 70: statproto.PoBGood.bases:type_name -> statproto.PoBGoodBase
+	69,  // 71: statproto.PoBGoodBase.shop_item:type_name -> statproto.ShopItem
+	67,  // 72: statproto.PoBGoodBase.base:type_name -> statproto.PoBCore
+	74,  // 73: statproto.GetGraphPathsInput.queries:type_name -> statproto.GraphPathQuery
+	101, // 74: statproto.GraphPathQuery.reputations:type_name -> statproto.GraphPathQuery.ReputationsEntry
+	76,  // 75: statproto.GetGraphPathsReply.answers:type_name -> statproto.GetGraphPathsAnswer
+	74,  // 76: statproto.GetGraphPathsAnswer.route:type_name -> statproto.GraphPathQuery
+	77,  // 77: statproto.GetGraphPathsAnswer.time:type_name -> statproto.GraphPathTime
+	79,  // 78: statproto.GetGraphRouteDetailsReply.answers:type_name -> statproto.GetGraphRouteDetailsAnswer
+	74,  // 79: statproto.GetGraphRouteDetailsAnswer.route:type_name -> statproto.GraphPathQuery
+	80,  // 80: statproto.GetGraphRouteDetailsAnswer.transport:type_name -> statproto.GraphRouteDetails
+	80,  // 81: statproto.GetGraphRouteDetailsAnswer.frigate:type_name -> statproto.GraphRouteDetails
+	80,  // 82: statproto.GetGraphRouteDetailsAnswer.freighter:type_name -> statproto.GraphRouteDetails
+	80,  // 83: statproto.GetGraphRouteDetailsAnswer.ship:type_name -> statproto.GraphRouteDetails
+	81,  // 84: statproto.GraphRouteDetails.waypoints:type_name -> statproto.GraphRouteWaypoint
+	17,  // 85: statproto.GraphRouteWaypoint.pos:type_name -> statproto.Pos
+	83,  // 86: statproto.GetTradeRoutesInput.capacity:type_name -> statproto.TradeCapacity
+	102, // 87: statproto.GetTradeRoutesInput.reputations:type_name -> statproto.GetTradeRoutesInput.ReputationsEntry
+	85,  // 88: statproto.GetTradeRoutesReply.routes:type_name -> statproto.TradeRouteRun
+	86,  // 89: statproto.TradeRouteRun.run:type_name -> statproto.TradeRun
+	15,  // 90: statproto.Base.MarketGoodsPerNickEntry.value:type_name -> statproto.MarketGood
+	15,  // 91: statproto.Commodity.BasesEntry.value:type_name -> statproto.MarketGood
+	15,  // 92: statproto.Ammo.BasesEntry.value:type_name -> statproto.MarketGood
+	15,  // 93: statproto.CounterMeasure.BasesEntry.value:type_name -> statproto.MarketGood
+	15,  // 94: statproto.Engine.BasesEntry.value:type_name -> statproto.MarketGood
+	15,  // 95: statproto.Gun.BasesEntry.value:type_name -> statproto.MarketGood
+	15,  // 96: statproto.Mine.BasesEntry.value:type_name -> statproto.MarketGood
+	15,  // 97: statproto.Scanner.BasesEntry.value:type_name -> statproto.MarketGood
+	15,  // 98: statproto.Shield.BasesEntry.value:type_name -> statproto.MarketGood
+	15,  // 99: statproto.Ship.BasesEntry.value:type_name -> statproto.MarketGood
+	15,  // 100: statproto.Thruster.BasesEntry.value:type_name -> statproto.MarketGood
+	15,  // 101: statproto.Tractor.BasesEntry.value:type_name -> statproto.MarketGood
+	60,  // 102: statproto.GetHashesReply.HashesByNickEntry.value:type_name -> statproto.Hash
+	0,   // 103: statproto.Darkstat.GetHealth:input_type -> statproto.Empty
+	10,  // 104: statproto.Darkstat.GetBasesNpc:input_type -> statproto.GetBasesInput
+	10,  // 105: statproto.Darkstat.GetBasesMiningOperations:input_type -> statproto.GetBasesInput
+	10,  // 106: statproto.Darkstat.GetBasesPoBs:input_type -> statproto.GetBasesInput
+	0,   // 107: statproto.Darkstat.GetPoBs:input_type -> statproto.Empty
+	0,   // 108: statproto.Darkstat.GetPoBGoods:input_type -> statproto.Empty
+	18,  // 109: statproto.Darkstat.GetCommodities:input_type -> statproto.GetCommoditiesInput
+	9,   // 110: statproto.Darkstat.GetGuns:input_type -> statproto.GetGunsInput
+	9,   // 111: statproto.Darkstat.GetMissiles:input_type -> statproto.GetGunsInput
+	8,   // 112: statproto.Darkstat.GetAmmos:input_type -> statproto.GetEquipmentInput
+	8,   // 113: statproto.Darkstat.GetCounterMeasures:input_type -> statproto.GetEquipmentInput
+	8,   // 114: statproto.Darkstat.GetEngines:input_type -> statproto.GetEquipmentInput
+	8,   // 115: statproto.Darkstat.GetMines:input_type -> statproto.GetEquipmentInput
+	8,   // 116: statproto.Darkstat.GetScanners:input_type -> statproto.GetEquipmentInput
+	8,   // 117: statproto.Darkstat.GetShields:input_type -> statproto.GetEquipmentInput
+	8,   // 118: statproto.Darkstat.GetShips:input_type -> statproto.GetEquipmentInput
+	8,   // 119: statproto.Darkstat.GetThrusters:input_type -> statproto.GetEquipmentInput
+	31,  // 120: statproto.Darkstat.GetFactions:input_type -> statproto.GetFactionsInput
+	11,  // 121: statproto.Darkstat.GetTractors:input_type -> statproto.GetTractorsInput
+	0,   // 122: statproto.Darkstat.GetHashes:input_type -> statproto.Empty
+	61,  // 123: statproto.Darkstat.ResolveHashes:input_type -> statproto.ResolveHashesInput
+	1,   // 124: statproto.Darkstat.GetInfocards:input_type -> statproto.GetInfocardsInput
+	73,  // 125: statproto.Darkstat.GetGraphPaths:input_type -> statproto.GetGraphPathsInput
+	73,  // 126: statproto.Darkstat.GetGraphRouteDetails:input_type -> statproto.GetGraphPathsInput
+	82,  // 127: statproto.Darkstat.GetTradeRoutes:input_type -> statproto.GetTradeRoutesInput
+	7,   // 128: statproto.Darkstat.GetHealth:output_type -> statproto.HealthReply
+	12,  // 129: statproto.Darkstat.GetBasesNpc:output_type -> statproto.GetBasesReply
+	12,  // 130: statproto.Darkstat.GetBasesMiningOperations:output_type -> statproto.GetBasesReply
+	12,  // 131: statproto.Darkstat.GetBasesPoBs:output_type -> statproto.GetBasesReply
+	66,  // 132: statproto.Darkstat.GetPoBs:output_type -> statproto.GetPoBsReply
+	70,  // 133: statproto.Darkstat.GetPoBGoods:output_type -> statproto.GetPoBGoodsReply
+	19,  // 134: statproto.Darkstat.GetCommodities:output_type -> statproto.GetCommoditiesReply
+	36,  // 135: statproto.Darkstat.GetGuns:output_type -> statproto.GetGunsReply
+	36,  // 136: statproto.Darkstat.GetMissiles:output_type -> statproto.GetGunsReply
+	21,  // 137: statproto.Darkstat.GetAmmos:output_type -> statproto.GetAmmoReply
+	27,  // 138: statproto.Darkstat.GetCounterMeasures:output_type -> statproto.GetCounterMeasuresReply
+	29,  // 139: statproto.Darkstat.GetEngines:output_type -> statproto.GetEnginesReply
+	43,  // 140: statproto.Darkstat.GetMines:output_type -> statproto.GetMinesReply
+	46,  // 141: statproto.Darkstat.GetScanners:output_type -> statproto.GetScannersReply
+	48,  // 142: statproto.Darkstat.GetShields:output_type -> statproto.GetShieldsReply
+	50,  // 143: statproto.Darkstat.GetShips:output_type -> statproto.GetShipsReply
+	55,  // 144: statproto.Darkstat.GetThrusters:output_type -> statproto.GetThrustersReply
+	32,  // 145: statproto.Darkstat.GetFactions:output_type -> statproto.GetFactionsReply
+	57,  // 146: statproto.Darkstat.GetTractors:output_type -> statproto.GetTractorsReply
+	59,  // 147: statproto.Darkstat.GetHashes:output_type -> statproto.GetHashesReply
+	64,  // 148: statproto.Darkstat.ResolveHashes:output_type -> statproto.ResolveHashesReply
+	2,   // 149: statproto.Darkstat.GetInfocards:output_type -> statproto.GetInfocardsReply
+	75,  // 150: statproto.Darkstat.GetGraphPaths:output_type -> statproto.GetGraphPathsReply
+	78,  // 151: statproto.Darkstat.GetGraphRouteDetails:output_type -> statproto.GetGraphRouteDetailsReply
+	84,  // 152: statproto.Darkstat.GetTradeRoutes:output_type -> statproto.GetTradeRoutesReply
+	128, // [128:153] is the sub-list for method output_type
+	103, // [103:128] is the sub-list for method input_type
+	103, // [103:103] is the sub-list for extension type_name
+	103, // [103:103] is the sub-list for extension extendee
+	0,   // [0:103] is the sub-list for field type_name
 }
 
 func init() { file_darkstat_proto_init() }
@@ -7515,17 +8829,22 @@ func file_darkstat_proto_init() {
 	file_darkstat_proto_msgTypes[49].OneofWrappers = []any{}
 	file_darkstat_proto_msgTypes[51].OneofWrappers = []any{}
 	file_darkstat_proto_msgTypes[56].OneofWrappers = []any{}
-	file_darkstat_proto_msgTypes[62].OneofWrappers = []any{}
-	file_darkstat_proto_msgTypes[66].OneofWrappers = []any{}
+	file_darkstat_proto_msgTypes[63].OneofWrappers = []any{}
+	file_darkstat_proto_msgTypes[67].OneofWrappers = []any{}
 	file_darkstat_proto_msgTypes[71].OneofWrappers = []any{}
-	file_darkstat_proto_msgTypes[72].OneofWrappers = []any{}
+	file_darkstat_proto_msgTypes[74].OneofWrappers = []any{}
+	file_darkstat_proto_msgTypes[76].OneofWrappers = []any{}
+	file_darkstat_proto_msgTypes[77].OneofWrappers = []any{}
+	file_darkstat_proto_msgTypes[79].OneofWrappers = []any{}
+	file_darkstat_proto_msgTypes[82].OneofWrappers = []any{}
+	file_darkstat_proto_msgTypes[84].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_darkstat_proto_rawDesc), len(file_darkstat_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   87,
+			NumMessages:   103,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
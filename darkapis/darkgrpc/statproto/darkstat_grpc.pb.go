@@ -39,8 +39,11 @@ const (
 	Darkstat_GetFactions_FullMethodName              = "/statproto.Darkstat/GetFactions"
 	Darkstat_GetTractors_FullMethodName              = "/statproto.Darkstat/GetTractors"
 	Darkstat_GetHashes_FullMethodName                = "/statproto.Darkstat/GetHashes"
+	Darkstat_ResolveHashes_FullMethodName            = "/statproto.Darkstat/ResolveHashes"
 	Darkstat_GetInfocards_FullMethodName             = "/statproto.Darkstat/GetInfocards"
 	Darkstat_GetGraphPaths_FullMethodName            = "/statproto.Darkstat/GetGraphPaths"
+	Darkstat_GetGraphRouteDetails_FullMethodName     = "/statproto.Darkstat/GetGraphRouteDetails"
+	Darkstat_GetTradeRoutes_FullMethodName           = "/statproto.Darkstat/GetTradeRoutes"
 )
 
 // DarkstatClient is the client API for Darkstat service.
@@ -75,8 +78,14 @@ type DarkstatClient interface {
 	// Get Tractors. For Discovery those are IDs
 	GetTractors(ctx context.Context, in *GetTractorsInput, opts ...grpc.CallOption) (*GetTractorsReply, error)
 	GetHashes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetHashesReply, error)
+	// Resolve hashes from save files, logs or PoB data back to nicknames. Accepts signed, unsigned or hex form
+	ResolveHashes(ctx context.Context, in *ResolveHashesInput, opts ...grpc.CallOption) (*ResolveHashesReply, error)
 	GetInfocards(ctx context.Context, in *GetInfocardsInput, opts ...grpc.CallOption) (*GetInfocardsReply, error)
 	GetGraphPaths(ctx context.Context, in *GetGraphPathsInput, opts ...grpc.CallOption) (*GetGraphPathsReply, error)
+	// Turn by turn routes with waypoints, segment types and time per segment
+	GetGraphRouteDetails(ctx context.Context, in *GetGraphPathsInput, opts ...grpc.CallOption) (*GetGraphRouteDetailsReply, error)
+	// Trade routes buying at base or of commodity, with amount of units fitting into cargo hold and credits. Ranked by profit per hour
+	GetTradeRoutes(ctx context.Context, in *GetTradeRoutesInput, opts ...grpc.CallOption) (*GetTradeRoutesReply, error)
 }
 
 type darkstatClient struct {
@@ -287,6 +296,16 @@ func (c *darkstatClient) GetHashes(ctx context.Context, in *Empty, opts ...grpc.
 	return out, nil
 }
 
+func (c *darkstatClient) ResolveHashes(ctx context.Context, in *ResolveHashesInput, opts ...grpc.CallOption) (*ResolveHashesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveHashesReply)
+	err := c.cc.Invoke(ctx, Darkstat_ResolveHashes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *darkstatClient) GetInfocards(ctx context.Context, in *GetInfocardsInput, opts ...grpc.CallOption) (*GetInfocardsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetInfocardsReply)
@@ -307,6 +326,26 @@ func (c *darkstatClient) GetGraphPaths(ctx context.Context, in *GetGraphPathsInp
 	return out, nil
 }
 
+func (c *darkstatClient) GetGraphRouteDetails(ctx context.Context, in *GetGraphPathsInput, opts ...grpc.CallOption) (*GetGraphRouteDetailsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGraphRouteDetailsReply)
+	err := c.cc.Invoke(ctx, Darkstat_GetGraphRouteDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *darkstatClient) GetTradeRoutes(ctx context.Context, in *GetTradeRoutesInput, opts ...grpc.CallOption) (*GetTradeRoutesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTradeRoutesReply)
+	err := c.cc.Invoke(ctx, Darkstat_GetTradeRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DarkstatServer is the server API for Darkstat service.
 // All implementations must embed UnimplementedDarkstatServer
 // for forward compatibility.
@@ -339,8 +378,14 @@ type DarkstatServer interface {
 	// Get Tractors. For Discovery those are IDs
 	GetTractors(context.Context, *GetTractorsInput) (*GetTractorsReply, error)
 	GetHashes(context.Context, *Empty) (*GetHashesReply, error)
+	// Resolve hashes from save files, logs or PoB data back to nicknames. Accepts signed, unsigned or hex form
+	ResolveHashes(context.Context, *ResolveHashesInput) (*ResolveHashesReply, error)
 	GetInfocards(context.Context, *GetInfocardsInput) (*GetInfocardsReply, error)
 	GetGraphPaths(context.Context, *GetGraphPathsInput) (*GetGraphPathsReply, error)
+	// Turn by turn routes with waypoints, segment types and time per segment
+	GetGraphRouteDetails(context.Context, *GetGraphPathsInput) (*GetGraphRouteDetailsReply, error)
+	// Trade routes buying at base or of commodity, with amount of units fitting into cargo hold and credits. Ranked by profit per hour
+	GetTradeRoutes(context.Context, *GetTradeRoutesInput) (*GetTradeRoutesReply, error)
 	mustEmbedUnimplementedDarkstatServer()
 }
 
@@ -411,12 +456,21 @@ func (UnimplementedDarkstatServer) GetTractors(context.Context, *GetTractorsInpu
 func (UnimplementedDarkstatServer) GetHashes(context.Context, *Empty) (*GetHashesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHashes not implemented")
 }
+func (UnimplementedDarkstatServer) ResolveHashes(context.Context, *ResolveHashesInput) (*ResolveHashesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveHashes not implemented")
+}
 func (UnimplementedDarkstatServer) GetInfocards(context.Context, *GetInfocardsInput) (*GetInfocardsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfocards not implemented")
 }
 func (UnimplementedDarkstatServer) GetGraphPaths(context.Context, *GetGraphPathsInput) (*GetGraphPathsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGraphPaths not implemented")
 }
+func (UnimplementedDarkstatServer) GetGraphRouteDetails(context.Context, *GetGraphPathsInput) (*GetGraphRouteDetailsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGraphRouteDetails not implemented")
+}
+func (UnimplementedDarkstatServer) GetTradeRoutes(context.Context, *GetTradeRoutesInput) (*GetTradeRoutesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTradeRoutes not implemented")
+}
 func (UnimplementedDarkstatServer) mustEmbedUnimplementedDarkstatServer() {}
 func (UnimplementedDarkstatServer) testEmbeddedByValue()                  {}
 
@@ -798,6 +852,24 @@ func _Darkstat_GetHashes_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Darkstat_ResolveHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveHashesInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DarkstatServer).ResolveHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Darkstat_ResolveHashes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DarkstatServer).ResolveHashes(ctx, req.(*ResolveHashesInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Darkstat_GetInfocards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfocardsInput)
 	if err := dec(in); err != nil {
@@ -834,6 +906,42 @@ func _Darkstat_GetGraphPaths_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Darkstat_GetGraphRouteDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGraphPathsInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DarkstatServer).GetGraphRouteDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Darkstat_GetGraphRouteDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DarkstatServer).GetGraphRouteDetails(ctx, req.(*GetGraphPathsInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Darkstat_GetTradeRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTradeRoutesInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DarkstatServer).GetTradeRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Darkstat_GetTradeRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DarkstatServer).GetTradeRoutes(ctx, req.(*GetTradeRoutesInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Darkstat_ServiceDesc is the grpc.ServiceDesc for Darkstat service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -921,6 +1029,10 @@ var Darkstat_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHashes",
 			Handler:    _Darkstat_GetHashes_Handler,
 		},
+		{
+			MethodName: "ResolveHashes",
+			Handler:    _Darkstat_ResolveHashes_Handler,
+		},
 		{
 			MethodName: "GetInfocards",
 			Handler:    _Darkstat_GetInfocards_Handler,
@@ -929,6 +1041,14 @@ var Darkstat_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGraphPaths",
 			Handler:    _Darkstat_GetGraphPaths_Handler,
 		},
+		{
+			MethodName: "GetGraphRouteDetails",
+			Handler:    _Darkstat_GetGraphRouteDetails_Handler,
+		},
+		{
+			MethodName: "GetTradeRoutes",
+			Handler:    _Darkstat_GetTradeRoutes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "darkstat.proto",
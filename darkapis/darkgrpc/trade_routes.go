@@ -0,0 +1,64 @@
+package darkgrpc
+
+import (
+	"context"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	pb "github.com/darklab8/fl-darkstat/darkapis/darkgrpc/statproto"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
+	"github.com/darklab8/go-utils/utils/ptr"
+)
+
+func (s *Server) GetTradeRoutes(_ context.Context, in *pb.GetTradeRoutesInput) (*pb.GetTradeRoutesReply, error) {
+	if s.app_data != nil {
+		s.app_data.RLock()
+		defer s.app_data.RUnlock()
+	}
+
+	input := configs_export.TradeRoutesInput{
+		From:      in.From,
+		Commodity: in.Commodity,
+		RouteType: in.RouteType,
+		Capacity:  configs_export.TradeCapacity{ShipClass: -1},
+		Limit:     int(in.Limit),
+
+		DiscoveryID: in.DiscoveryId,
+		Reputations: in.Reputations,
+	}
+	if in.Capacity != nil {
+		input.Capacity = configs_export.TradeCapacity{
+			HoldSize:  int(in.Capacity.HoldSize),
+			Credits:   int(in.Capacity.Credits),
+			ShipClass: cfg.ShipClass(in.Capacity.ShipClass),
+		}
+	}
+
+	routes, err := s.app_data.Configs.GetTradeRoutes(input)
+	if err != nil {
+		return &pb.GetTradeRoutesReply{Error: ptr.Ptr(err.Error())}, nil
+	}
+
+	var grpc_routes []*pb.TradeRouteRun
+	for _, route := range routes {
+		grpc_routes = append(grpc_routes, &pb.TradeRouteRun{
+			CommodityNickname: route.CommodityNickname,
+			CommodityName:     route.CommodityName,
+			FromBaseNickname:  string(route.FromBaseNickname),
+			FromBaseName:      route.FromBaseName,
+			ToBaseNickname:    string(route.ToBaseNickname),
+			ToBaseName:        route.ToBaseName,
+			BuyPrice:          int64(route.BuyPrice),
+			SellPrice:         int64(route.SellPrice),
+			Time:              int64(route.Time),
+			ProfitPerVolume:   route.ProfitPerVolume,
+			Run: &pb.TradeRun{
+				Units:         int64(route.Run.Units),
+				Volume:        route.Run.Volume,
+				Profit:        int64(route.Run.Profit),
+				ProfitPerHour: route.Run.ProfitPerHour,
+				LimitedBy:     string(route.Run.LimitedBy),
+			},
+		})
+	}
+	return &pb.GetTradeRoutesReply{Routes: grpc_routes}, nil
+}
@@ -52,8 +52,8 @@ func GetAmmos(webapp *web.Web, api *Api) *registry.Endpoint {
 		Url: "" + ApiRoute + "/ammos",
 		Handler: func(w http.ResponseWriter, r *http.Request) {
 			if webapp.AppDataMutex != nil {
-				webapp.AppDataMutex.Lock()
-				defer webapp.AppDataMutex.Unlock()
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
 			}
 
 			var in *pb.GetEquipmentInput
@@ -173,6 +173,37 @@ func TestApi(t *testing.T) {
 			assert.Nil(t, items[0].Error)
 		})
 
+		t.Run("GetGraphRouteDetails", func(t *testing.T) {
+			nicknames := []appdata.GraphPathReq{
+				{
+					From: string(items[0].Nickname),
+					To:   string(items[1].Nickname),
+				},
+			}
+
+			post_body, err := json.Marshal(nicknames)
+			logus.Log.CheckPanic(err, "unable to marshal post body", typelog.OptError(err))
+
+			res, err := httpc.Post("http://localhost/api/graph/route_details", ApplicationJson, bytes.NewBuffer(post_body))
+			logus.Log.CheckPanic(err, "error making http request: %s\n", typelog.OptError(err))
+
+			resBody, err := io.ReadAll(res.Body)
+			logus.Log.CheckPanic(err, "client: could not read response body: %s\n", typelog.OptError(err))
+
+			var items []appdata.GraphRouteDetailsResp
+			err = json.Unmarshal(resBody, &items)
+			logus.Log.CheckPanic(err, "can not unmarshal", typelog.OptError(err))
+
+			assert.Equal(t, 1, len(items))
+			assert.Nil(t, items[0].Error)
+			if assert.NotNil(t, items[0].Freighter) {
+				waypoints := items[0].Freighter.Waypoints
+				assert.Equal(t, nicknames[0].From, waypoints[0].Nickname)
+				assert.Equal(t, nicknames[0].To, waypoints[len(waypoints)-1].Nickname)
+				assert.Equal(t, configs_export.SegmentDock, waypoints[len(waypoints)-1].Segment)
+			}
+		})
+
 		t.Run("GetInfocards", func(t *testing.T) {
 			var nickname []string = []string{
 				items[0].GetNickname(),
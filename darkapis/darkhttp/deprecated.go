@@ -20,8 +20,8 @@ func PostBaseMarketGoods(webapp *web.Web, api *Api) *registry.Endpoint {
 		Url: "POST " + ApiRoute + "/npc_bases/market_goods",
 		Handler: func(w http.ResponseWriter, r *http.Request) {
 			if webapp.AppDataMutex != nil {
-				webapp.AppDataMutex.Lock()
-				defer webapp.AppDataMutex.Unlock()
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
 			}
 
 			var base_nicknames []cfg.BaseUniNick
@@ -24,8 +24,8 @@ func GetFactions(webapp *web.Web, api *Api) *registry.Endpoint {
 		Url: "" + ApiRoute + "/factions",
 		Handler: func(w http.ResponseWriter, r *http.Request) {
 			if webapp.AppDataMutex != nil {
-				webapp.AppDataMutex.Lock()
-				defer webapp.AppDataMutex.Unlock()
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
 			}
 
 			var in *pb.GetFactionsInput = &pb.GetFactionsInput{}
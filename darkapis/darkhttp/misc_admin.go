@@ -0,0 +1,103 @@
+package darkhttp
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/darklab8/fl-darkstat/darkcore/web"
+	"github.com/darklab8/fl-darkstat/darkcore/web/registry"
+	"github.com/darklab8/fl-darkstat/darkstat/appdata"
+	"github.com/darklab8/fl-darkstat/darkstat/settings"
+)
+
+const (
+	AdminRoute       = "/admin"
+	AdminReloadRoute = AdminRoute + "/reload" // checks admin token on its own, so web has to pass it through password auth
+)
+
+// isAdmin checks bearer token. Admin routes are disabled unless DARKSTAT_ADMIN_TOKEN is set
+func isAdmin(resp http.ResponseWriter, r *http.Request) bool {
+	if settings.Env.AdminToken == "" {
+		resp.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(resp, "admin routes are disabled, set DARKSTAT_ADMIN_TOKEN to enable them")
+		return false
+	}
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(settings.Env.AdminToken)) != 1 {
+		resp.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(resp, "invalid admin token")
+		return false
+	}
+	return true
+}
+
+func writeReloadStatus(resp http.ResponseWriter, status appdata.ReloadStatus) {
+	JsonResponseHeader(&resp)
+	data, _ := json.Marshal(status)
+	resp.Write(data)
+}
+
+// ShowAccount godoc
+// @Summary      Reload game data
+// @Description  Rebuilds all data from game folder in background. Current data is served until new one is fully built.
+// @Description  Requires Authorization: Bearer header with DARKSTAT_ADMIN_TOKEN
+// @Tags         admin
+// @Produce      json
+// @Success      202  {object}  	appdata.ReloadStatus
+// @Failure      409  {string}  string "reload is already in progress"
+// @Failure      500  {string}  string "failed to start reload"
+// @Router       /admin/reload [post]
+func PostAdminReload(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "POST " + AdminReloadRoute,
+		Handler: func(resp http.ResponseWriter, r *http.Request) {
+			if !isAdmin(resp, r) {
+				return
+			}
+			if api.reloader == nil {
+				resp.WriteHeader(http.StatusNotImplemented)
+				fmt.Fprint(resp, "reloading is not available for this server")
+				return
+			}
+			if err := api.reloader.StartReloadAll(); errors.Is(err, appdata.ErrReloadInProgress) {
+				resp.WriteHeader(http.StatusConflict)
+				fmt.Fprint(resp, err.Error())
+				return
+			} else if err != nil {
+				resp.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprint(resp, err.Error())
+				return
+			}
+			resp.WriteHeader(http.StatusAccepted)
+			writeReloadStatus(resp, api.reloader.Status())
+		},
+	}
+}
+
+// ShowAccount godoc
+// @Summary      Reload status
+// @Description  Tells if reload is running, and how previous one finished
+// @Tags         admin
+// @Produce      json
+// @Success      200  {object}  	appdata.ReloadStatus
+// @Router       /admin/reload [get]
+func GetAdminReload(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "GET " + AdminReloadRoute,
+		Handler: func(resp http.ResponseWriter, r *http.Request) {
+			if !isAdmin(resp, r) {
+				return
+			}
+			if api.reloader == nil {
+				resp.WriteHeader(http.StatusNotImplemented)
+				fmt.Fprint(resp, "reloading is not available for this server")
+				return
+			}
+			writeReloadStatus(resp, api.reloader.Status())
+		},
+	}
+}
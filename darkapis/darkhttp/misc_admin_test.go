@@ -0,0 +1,41 @@
+package darkhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/darkstat/appdata"
+	"github.com/darklab8/fl-darkstat/darkstat/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminReloadAuth(t *testing.T) {
+	app_data := &appdata.AppData{}
+	api := &Api{
+		app_data: app_data,
+		reloader: appdata.NewReloader(app_data, func(fresh *appdata.AppData) func() { return func() {} }),
+	}
+	status := GetAdminReload(nil, api)
+
+	call := func(token string) int {
+		req := httptest.NewRequest(http.MethodGet, "/admin/reload", nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp := httptest.NewRecorder()
+		status.Handler(resp, req)
+		return resp.Code
+	}
+
+	previous := settings.Env.AdminToken
+	defer func() { settings.Env.AdminToken = previous }()
+
+	settings.Env.AdminToken = ""
+	assert.Equal(t, http.StatusNotFound, call("anything"), "admin routes are disabled without token")
+
+	settings.Env.AdminToken = "secret"
+	assert.Equal(t, http.StatusUnauthorized, call(""))
+	assert.Equal(t, http.StatusUnauthorized, call("wrong"))
+	assert.Equal(t, http.StatusOK, call("secret"))
+}
@@ -0,0 +1,58 @@
+package darkhttp
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/darklab8/fl-darkstat/darkcore/web"
+	"github.com/darklab8/fl-darkstat/darkcore/web/registry"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
+	"github.com/darklab8/fl-darkstat/darkstat/front"
+	"github.com/darklab8/fl-darkstat/darkstat/settings/logus"
+)
+
+// ShowAccount godoc
+// @Summary      Changelog
+// @Description  Entity level changes of currently served game data against previous version set in DARKSTAT_DIFF_BASELINE
+// @Description  Guns, ships, shields, commodities, bases and market prices are compared by nickname
+// @Tags         misc
+// @Produce      json
+// @Produce      html
+// @Produce      plain
+// @Param        format  query  string  false  "json (default), markdown or html"
+// @Success      200  {object}  	configs_export.ChangeSet
+// @Router       /api/diff [get]
+func GetDiff(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "GET " + ApiRoute + "/diff",
+		Handler: func(resp http.ResponseWriter, r *http.Request) {
+			format, err := configs_export.ParseDiffFormat(r.URL.Query().Get("format"))
+			if err != nil {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(resp, err.Error())
+				return
+			}
+			if webapp.AppDataMutex != nil {
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
+			}
+			if api.app_data.DiffBaseline == nil {
+				resp.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(resp, "diff baseline is not configured, set DARKSTAT_DIFF_BASELINE to folder or archive of previous game version")
+				return
+			}
+			changes := configs_export.Diff(api.app_data.DiffBaseline, api.app_data.Configs)
+
+			switch format {
+			case configs_export.DiffFormatJson:
+				JsonResponseHeader(&resp)
+			case configs_export.DiffFormatMarkdown:
+				resp.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+			case configs_export.DiffFormatHtml:
+				resp.Header().Set("Content-Type", "text/html; charset=utf-8")
+			}
+			err = front.WriteChangeSet(r.Context(), resp, changes, format)
+			logus.Log.CheckError(err, "failed to write changeset")
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package darkhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/darkcore/web"
+	"github.com/darklab8/fl-darkstat/darkstat/appdata"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDiff(t *testing.T) {
+	app_data := &appdata.AppData{Configs: &configs_export.Exporter{
+		Guns: []configs_export.Gun{{Nickname: "gun_added", Name: "Added"}},
+	}}
+	endpoint := GetDiff(&web.Web{}, &Api{app_data: app_data})
+
+	call := func() *httptest.ResponseRecorder {
+		resp := httptest.NewRecorder()
+		endpoint.Handler(resp, httptest.NewRequest(http.MethodGet, "/api/diff", nil))
+		return resp
+	}
+
+	assert.Equal(t, http.StatusNotFound, call().Code)
+
+	// baseline is exported with app data, requests only compare it
+	app_data.DiffBaseline = &configs_export.Exporter{}
+	resp := call()
+	assert.Equal(t, http.StatusOK, resp.Code)
+	var changes configs_export.ChangeSet
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), &changes))
+	if assert.Len(t, changes.Changes, 1) {
+		assert.Equal(t, configs_export.ChangeAdded, changes.Changes[0].Kind)
+		assert.Equal(t, "gun_added", changes.Changes[0].Nickname)
+	}
+}
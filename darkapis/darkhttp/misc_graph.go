@@ -18,6 +18,8 @@ import (
 // @Description  You query by nicknames of objects from which base/pob/ore fields to which one
 // @Description  You receive result how many seconds it takes to reach destination for Transport, Frigate and Freighter
 // @Description  If destination is not reachable, you get time equal to Maximum of int32 = 9223372036854775807
+// @Description  Optionally query by ship nickname, to receive also time for its cruise speed and docking abilities
+// @Description  Optionally query by Discovery ID nickname or manual reputations per faction. Routes to or from hostile bases return error. Path between them is not checked for hostile factions
 // @Tags         misc
 // @Accept       json
 // @Produce      json
@@ -29,8 +31,8 @@ func PostGraphPaths(webapp *web.Web, api *Api) *registry.Endpoint {
 		Url: "POST " + ApiRoute + "/graph/paths",
 		Handler: func(resp http.ResponseWriter, r *http.Request) {
 			if webapp.AppDataMutex != nil {
-				webapp.AppDataMutex.Lock()
-				defer webapp.AppDataMutex.Unlock()
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
 			}
 
 			var input_routes []appdata.GraphPathReq
@@ -53,3 +55,47 @@ func PostGraphPaths(webapp *web.Web, api *Api) *registry.Endpoint {
 		},
 	}
 }
+
+// ShowAccount godoc
+// @Summary      Turn by turn routes between two NPC bases/PoBs/Ore fields and etc.
+// @Description  You query by nicknames of objects from which base/pob/ore fields to which one
+// @Description  You receive ordered waypoints for Transport, Frigate and Freighter, each with system, object nickname and name, sector coordinates,
+// @Description  segment type how waypoint is reached (cruise, tradelane, jump_gate, jump_hole, dock) and seconds spent on the segment
+// @Description  If destination is not reachable by ship class, route for it is omitted
+// @Description  Optionally query by ship nickname, to receive also route for its cruise speed and docking abilities
+// @Description  Optionally query by Discovery ID nickname or manual reputations per faction. Routes to or from hostile bases return error. Path between them is not checked for hostile factions
+// @Tags         misc
+// @Accept       json
+// @Produce      json
+// @Param request body []appdata.GraphPathReq true "Request body"
+// @Success      200  {array}  	appdata.GraphRouteDetailsResp
+// @Router       /api/graph/route_details [post]
+func PostGraphRouteDetails(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "POST " + ApiRoute + "/graph/route_details",
+		Handler: func(resp http.ResponseWriter, r *http.Request) {
+			if webapp.AppDataMutex != nil {
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
+			}
+
+			var input_routes []appdata.GraphPathReq
+			body, err := io.ReadAll(r.Body)
+			if logus.Log.CheckError(err, "failed to read body") {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "err to ready body")
+				return
+			}
+			json.Unmarshal(body, &input_routes)
+
+			if len(input_routes) == 0 {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "input at least some routes into request body")
+				return
+			}
+			output_routes := api.app_data.GetGraphRouteDetails(input_routes)
+
+			apiutils.ReturnJson(&resp, output_routes)
+		},
+	}
+}
@@ -1,12 +1,16 @@
 package darkhttp
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/darklab8/fl-darkstat/darkapis/darkgrpc"
 	"github.com/darklab8/fl-darkstat/darkapis/darkhttp/apiutils"
 	"github.com/darklab8/fl-darkstat/darkcore/web"
 	"github.com/darklab8/fl-darkstat/darkcore/web/registry"
+	"github.com/darklab8/fl-darkstat/darkstat/settings/logus"
 )
 
 // ShowAccount godoc
@@ -21,11 +25,49 @@ func GetHashes(webapp *web.Web, api *Api) *registry.Endpoint {
 		Url: "" + ApiRoute + "/hashes",
 		Handler: func(w http.ResponseWriter, r *http.Request) {
 			if webapp.AppDataMutex != nil {
-				webapp.AppDataMutex.Lock()
-				defer webapp.AppDataMutex.Unlock()
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
 			}
 			hashes := darkgrpc.GetHashesData(api.app_data)
 			apiutils.ReturnJson(&w, darkgrpc.Hashes{HashesByNick: hashes})
 		},
 	}
 }
+
+// ShowAccount godoc
+// @Summary      Resolve hashes
+// @Description  Resolves nickname and faction hashes from save files, server logs and PoB data back to nicknames
+// @Description  Hashes are accepted as signed int32, unsigned int32 or hex strings
+// @Tags         misc
+// @Accept       json
+// @Produce      json
+// @Param request body []string true "Hashes"
+// @Success      200  {array}  	configs_export.ResolvedHash
+// @Router       /api/hashes/resolve [post]
+func PostResolveHashes(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "POST " + ApiRoute + "/hashes/resolve",
+		Handler: func(resp http.ResponseWriter, r *http.Request) {
+			if webapp.AppDataMutex != nil {
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
+			}
+
+			var queries []string
+			body, err := io.ReadAll(r.Body)
+			if logus.Log.CheckError(err, "failed to read body") {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "err to ready body")
+				return
+			}
+			json.Unmarshal(body, &queries)
+
+			if len(queries) == 0 {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "input at least some hashes into request body")
+				return
+			}
+			apiutils.ReturnJson(&resp, api.app_data.Configs.ResolveHashes(queries))
+		},
+	}
+}
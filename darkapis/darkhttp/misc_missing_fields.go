@@ -0,0 +1,35 @@
+package darkhttp
+
+import (
+	"net/http"
+
+	"github.com/darklab8/fl-darkstat/darkapis/darkhttp/apiutils"
+	"github.com/darklab8/fl-darkstat/darkcore/web"
+	"github.com/darklab8/fl-darkstat/darkcore/web/registry"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
+)
+
+// ShowAccount godoc
+// @Summary      Missing fields
+// @Description  Entities of served configs, which miss values expected by darkstat or have them broken
+// @Description  Such values are exported as zero values, so it helps to find incomplete mod configs
+// @Tags         misc
+// @Produce      json
+// @Success      200  {array}  	configs_export.MissingFields
+// @Router       /api/missing_fields [get]
+func GetMissingFields(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "GET " + ApiRoute + "/missing_fields",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			if webapp.AppDataMutex != nil {
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
+			}
+			missing := api.app_data.Configs.MissingFields
+			if missing == nil {
+				missing = []configs_export.MissingFields{}
+			}
+			apiutils.ReturnJson(&w, missing)
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package darkhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
+	"github.com/darklab8/fl-darkstat/darkcore/web"
+	"github.com/darklab8/fl-darkstat/darkstat/appdata"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMissingFields(t *testing.T) {
+	app_data := &appdata.AppData{Configs: &configs_export.Exporter{}}
+	endpoint := GetMissingFields(&web.Web{}, &Api{app_data: app_data})
+
+	call := func() []configs_export.MissingFields {
+		resp := httptest.NewRecorder()
+		endpoint.Handler(resp, httptest.NewRequest(http.MethodGet, "/api/missing_fields", nil))
+		assert.Equal(t, http.StatusOK, resp.Code)
+		var result []configs_export.MissingFields
+		assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), &result))
+		return result
+	}
+
+	assert.Equal(t, []configs_export.MissingFields{}, call())
+
+	app_data.Configs.MissingFields = []configs_export.MissingFields{
+		{Category: "guns", Nickname: "li_gun01_mark01", Fields: []semantic.FieldError{{Field: "MuzzleVelosity", Error: "missing key"}}},
+	}
+	assert.Equal(t, app_data.Configs.MissingFields, call())
+}
@@ -0,0 +1,99 @@
+package darkhttp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/darklab8/fl-darkstat/configs/filesave"
+	"github.com/darklab8/fl-darkstat/darkapis/darkhttp/apiutils"
+	"github.com/darklab8/fl-darkstat/darkcore/core_types"
+	"github.com/darklab8/fl-darkstat/darkcore/web"
+	"github.com/darklab8/fl-darkstat/darkcore/web/registry"
+	"github.com/darklab8/fl-darkstat/darkstat/front"
+	"github.com/darklab8/fl-darkstat/darkstat/settings/logus"
+)
+
+const savegameMaxSize = 10 << 20
+
+// readSaveGameUpload accepts save as form file "savegame" or as raw request body
+func readSaveGameUpload(r *http.Request) ([]byte, error) {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := r.ParseMultipartForm(savegameMaxSize); err != nil {
+			return nil, err
+		}
+		uploaded, _, err := r.FormFile("savegame")
+		if err != nil {
+			return nil, err
+		}
+		defer uploaded.Close()
+		return io.ReadAll(io.LimitReader(uploaded, savegameMaxSize))
+	}
+	return io.ReadAll(io.LimitReader(r.Body, savegameMaxSize))
+}
+
+// ShowAccount godoc
+// @Summary      Player save game
+// @Description  Upload player .fl save file, plain or FLS1 encrypted, as request body or as form file "savegame"
+// @Description  Returns its ship, loadout, cargo and reputations resolved against game data,
+// @Description  with bases player can dock at and goods it can buy there given its reputation and rank
+// @Tags         misc
+// @Accept       octet-stream
+// @Produce      json
+// @Success      200  {object}  	configs_export.SaveGame
+// @Router       /api/savegame [post]
+func PostSaveGame(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "POST " + ApiRoute + "/savegame",
+		Handler: func(resp http.ResponseWriter, r *http.Request) {
+			data, err := readSaveGameUpload(r)
+			if logus.Log.CheckError(err, "failed to read body") {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "err to read save file")
+				return
+			}
+			player, err := filesave.Read(data)
+			if err != nil {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "failed to read save: %s", err.Error())
+				return
+			}
+
+			if webapp.AppDataMutex != nil {
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
+			}
+			apiutils.ReturnJson(&resp, api.app_data.Configs.ReadSaveGame(player))
+		},
+	}
+}
+
+// PostSaveGamePage renders same data as html for save game tab
+func PostSaveGamePage(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "POST " + front.SaveGameRenderUrl,
+		Handler: func(resp http.ResponseWriter, r *http.Request) {
+			resp.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+			if webapp.AppDataMutex != nil {
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
+			}
+			ctx := context.WithValue(r.Context(), core_types.GlobalParamsCtxKey, api.app_data.Build.GetParams())
+
+			data, err := readSaveGameUpload(r)
+			if err != nil {
+				front.SaveGameError(err).Render(ctx, resp)
+				return
+			}
+			player, err := filesave.Read(data)
+			if err != nil {
+				front.SaveGameError(err).Render(ctx, resp)
+				return
+			}
+			front.SaveGameResult(api.app_data.Configs.ReadSaveGame(player)).Render(ctx, resp)
+		},
+	}
+}
@@ -0,0 +1,125 @@
+package darkhttp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/darklab8/fl-darkstat/darkapis/darkhttp/apiutils"
+	"github.com/darklab8/fl-darkstat/darkcore/core_types"
+	"github.com/darklab8/fl-darkstat/darkcore/web"
+	"github.com/darklab8/fl-darkstat/darkcore/web/registry"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
+	"github.com/darklab8/fl-darkstat/darkstat/front"
+	"github.com/darklab8/fl-darkstat/darkstat/settings/logus"
+	"github.com/darklab8/go-utils/utils/ptr"
+)
+
+// ShowAccount godoc
+// @Summary      Multi leg trade loops from base
+// @Description  Searches chains of 2 to max_legs trades starting at base, where every sell base becomes next buy base
+// @Description  Amount bought in every leg is limited by hold size and budget, and profit of every leg is added to budget for the next one
+// @Description  Chains are ranked by profit per hour. is_loop marks chains ending at starting base
+// @Description  Optionally query by ship nickname, to use its hold size, cruise speed and docking abilities
+// @Description  Optionally query by Discovery ID nickname or manual reputations per faction, to skip hostile bases and goods with unmet required reputation
+// @Tags         misc
+// @Accept       json
+// @Produce      json
+// @Param request body configs_export.TradeLoopInput true "Request body"
+// @Success      200  {array}  	configs_export.TradeLoop
+// @Router       /api/trade_loops [post]
+func PostTradeLoops(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "POST " + ApiRoute + "/trade_loops",
+		Handler: func(resp http.ResponseWriter, r *http.Request) {
+			if webapp.AppDataMutex != nil {
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
+			}
+
+			var input configs_export.TradeLoopInput
+			body, err := io.ReadAll(r.Body)
+			if logus.Log.CheckError(err, "failed to read body") {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "err to ready body")
+				return
+			}
+			if err := json.Unmarshal(body, &input); err != nil {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "failed to parse body: %s", err.Error())
+				return
+			}
+
+			loops, err := api.app_data.Configs.PlanTradeLoops(input)
+			if err != nil {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "failed to plan trade loops: %s", err.Error())
+				return
+			}
+			apiutils.ReturnJson(&resp, loops)
+		},
+	}
+}
+
+// readTradeLoopsForm reads planner input from form of trade loops tab
+func readTradeLoopsForm(r *http.Request) (configs_export.TradeLoopInput, error) {
+	var input configs_export.TradeLoopInput
+	if err := r.ParseForm(); err != nil {
+		return input, err
+	}
+	input.From = r.FormValue("from")
+	input.ShipClass = r.FormValue("ship_class")
+	if ship := r.FormValue("ship"); ship != "" {
+		input.Ship = ptr.Ptr(ship)
+	}
+	if discovery_id := r.FormValue("discovery_id"); discovery_id != "" {
+		input.DiscoveryID = ptr.Ptr(discovery_id)
+	}
+
+	for name, value := range map[string]*int{
+		"hold_size": &input.HoldSize,
+		"budget":    &input.Budget,
+		"max_legs":  &input.MaxLegs,
+	} {
+		if r.FormValue(name) == "" {
+			continue
+		}
+		number, err := strconv.Atoi(r.FormValue(name))
+		if err != nil {
+			return input, fmt.Errorf("%s is not a number", name)
+		}
+		*value = number
+	}
+	return input, nil
+}
+
+// PostTradeLoopsPage renders same data as html for trade loops tab
+func PostTradeLoopsPage(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "POST " + front.TradeLoopsRenderUrl,
+		Handler: func(resp http.ResponseWriter, r *http.Request) {
+			resp.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+			if webapp.AppDataMutex != nil {
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
+			}
+			ctx := context.WithValue(r.Context(), core_types.GlobalParamsCtxKey, api.app_data.Build.GetParams())
+
+			input, err := readTradeLoopsForm(r)
+			if err != nil {
+				front.TradeLoopsError(err).Render(ctx, resp)
+				return
+			}
+			loops, err := api.app_data.Configs.PlanTradeLoops(input)
+			if err != nil {
+				front.TradeLoopsError(err).Render(ctx, resp)
+				return
+			}
+			front.TradeLoopsResult(loops).Render(ctx, resp)
+		},
+	}
+}
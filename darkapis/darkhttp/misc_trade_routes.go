@@ -0,0 +1,61 @@
+package darkhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/darklab8/fl-darkstat/darkapis/darkhttp/apiutils"
+	"github.com/darklab8/fl-darkstat/darkcore/web"
+	"github.com/darklab8/fl-darkstat/darkcore/web/registry"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
+	"github.com/darklab8/fl-darkstat/darkstat/settings/logus"
+)
+
+// ShowAccount godoc
+// @Summary      Trade routes for cargo hold and credits
+// @Description  Returns trade routes buying at base or of commodity, with amount of units fitting into capacity of pilot
+// @Description  Units are limited by hold size, credits and stock of PoBs on both buying and selling side. limited_by tells which one
+// @Description  ship_class of capacity is Discovery specific, as commodity volumes depend on it. Use -1 if not known
+// @Description  Optionally query by Discovery ID nickname or manual reputations per faction, to skip hostile bases and goods with unmet required reputation
+// @Description  Trade routes of bases and commodities pages are exported without reputation, use this endpoint for it
+// @Description  Routes are ranked by profit per hour
+// @Tags         misc
+// @Accept       json
+// @Produce      json
+// @Param request body configs_export.TradeRoutesInput true "Request body"
+// @Success      200  {array}  	configs_export.TradeRouteRun
+// @Router       /api/trade_routes [post]
+func PostTradeRoutes(webapp *web.Web, api *Api) *registry.Endpoint {
+	return &registry.Endpoint{
+		Url: "POST " + ApiRoute + "/trade_routes",
+		Handler: func(resp http.ResponseWriter, r *http.Request) {
+			if webapp.AppDataMutex != nil {
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
+			}
+
+			var input configs_export.TradeRoutesInput
+			body, err := io.ReadAll(r.Body)
+			if logus.Log.CheckError(err, "failed to read body") {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "err to ready body")
+				return
+			}
+			if err := json.Unmarshal(body, &input); err != nil {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "failed to parse body: %s", err.Error())
+				return
+			}
+
+			routes, err := api.app_data.Configs.GetTradeRoutes(input)
+			if err != nil {
+				resp.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(resp, "failed to get trade routes: %s", err.Error())
+				return
+			}
+			apiutils.ReturnJson(&resp, routes)
+		},
+	}
+}
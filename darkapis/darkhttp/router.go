@@ -13,21 +13,41 @@ const ApiRoute = "/api"
 
 type Api struct {
 	app_data *appdata.AppData
+	reloader *appdata.Reloader
 }
 
 func JsonResponseHeader(w *http.ResponseWriter) {
 	(*w).Header().Set("Content-Type", "application/json")
 }
 
-func RegisterApiRoutes(w *web.Web, app_data *appdata.AppData) *web.Web {
+type ApiOpt func(a *Api)
+
+// WithReloader enables admin routes rebuilding served data
+func WithReloader(reloader *appdata.Reloader) ApiOpt {
+	return func(a *Api) { a.reloader = reloader }
+}
+
+func RegisterApiRoutes(w *web.Web, app_data *appdata.AppData, opts ...ApiOpt) *web.Web {
 	api := &Api{
 		app_data: app_data,
 	}
+	for _, opt := range opts {
+		opt(api)
+	}
 	api_routes := registry.NewRegister()
 	api_routes.Register(GetPoBs(w, api))
 	api_routes.Register(GetPobGoods(w, api))
 	api_routes.Register(GetHashes(w, api))
+	api_routes.Register(PostResolveHashes(w, api))
+	api_routes.Register(PostSaveGame(w, api))
+	api_routes.Register(PostSaveGamePage(w, api))
+	api_routes.Register(PostTradeLoops(w, api))
+	api_routes.Register(PostTradeLoopsPage(w, api))
+	api_routes.Register(PostTradeRoutes(w, api))
 	api_routes.Register(PostGraphPaths(w, api))
+	api_routes.Register(PostGraphRouteDetails(w, api))
+	api_routes.Register(GetDiff(w, api))
+	api_routes.Register(GetMissingFields(w, api))
 	api_routes.Register(GetBases(w, api))
 	api_routes.Register(GetOreFields(w, api))
 	api_routes.Register(PostBaseMarketGoods(w, api))
@@ -68,6 +88,8 @@ func RegisterApiRoutes(w *web.Web, app_data *appdata.AppData) *web.Web {
 	api_routes.Register(PostThrustersTechcompatibilities(w, api))
 	api_routes.Register(GetPoBBases(w, api))
 	api_routes.Register(GetInfocards(w, api.app_data, api))
+	api_routes.Register(PostAdminReload(w, api))
+	api_routes.Register(GetAdminReload(w, api))
 
 	w.GetMux().Handle("GET /swagger/", httpSwagger.Handler(
 		httpSwagger.URL("/swagger/doc.json"),
@@ -33,8 +33,8 @@ func GetTractors(webapp *web.Web, api *Api) *registry.Endpoint {
 		Url: "" + ApiRoute + "/tractors",
 		Handler: func(w http.ResponseWriter, r *http.Request) {
 			if webapp.AppDataMutex != nil {
-				webapp.AppDataMutex.Lock()
-				defer webapp.AppDataMutex.Unlock()
+				webapp.AppDataMutex.RLock()
+				defer webapp.AppDataMutex.RUnlock()
 			}
 
 			var in *pb.GetTractorsInput = &pb.GetTractorsInput{}
@@ -113,6 +113,8 @@ func (t *ServerRpc) NewInfoFound(Nickname string, Name string, Entity string, Ob
 }
 
 func (t *ServerRpc) GetInfo(args GetInfoArgs, reply *GetInfoReply) error {
+	t.app_data.RLock()
+	defer t.app_data.RUnlock()
 
 	if strings.ReplaceAll(args.Query, " ", "") == "" {
 		reply.Content = []string{}
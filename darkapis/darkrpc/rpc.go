@@ -67,6 +67,8 @@ type RpcI interface {
 }
 
 func (t *ServerRpc) GetBases(args Args, reply *Reply) error {
+	t.app_data.RLock()
+	defer t.app_data.RUnlock()
 	reply.Bases = t.app_data.Configs.Bases
 	return nil
 }
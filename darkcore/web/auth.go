@@ -13,11 +13,19 @@ import (
 	"github.com/darklab8/go-typelog/typelog"
 )
 
-func AuthMiddleware(next http.Handler) http.Handler {
+// isTokenAuthPath checks path without site root, as endpoints are registered without it
+func (web *Web) isTokenAuthPath(path string) bool {
+	if site_root := strings.TrimSuffix(web.site_root, "/"); strings.HasPrefix(site_root, "/") {
+		path = strings.TrimPrefix(path, site_root)
+	}
+	return web.token_auth_paths[path]
+}
+
+func (web *Web) AuthMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
 		logus.Log.Warn("auth for page url", typelog.String("r.URL.Path", r.URL.Path))
-		if strings.HasPrefix(r.URL.Path, "/oauth") {
+		if strings.HasPrefix(r.URL.Path, "/oauth") || web.isTokenAuthPath(r.URL.Path) {
 			next.ServeHTTP(w, r)
 			return
 		}
@@ -0,0 +1,37 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/darkcore/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthSkipsOnlyTokenAuthPaths(t *testing.T) {
+	password := settings.Env.Password
+	settings.Env.Password = "test_password"
+	defer func() { settings.Env.Password = password }()
+
+	call := func(w *Web, path string) int {
+		handler := w.AuthMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, path, nil))
+		return resp.Code
+	}
+
+	w := NewWebBasic(nil, WithSiteRoot("/fl-darkstat/"), WithTokenAuthPaths("/admin/reload"))
+	assert.Equal(t, http.StatusOK, call(w, "/admin/reload"))
+	assert.Equal(t, http.StatusOK, call(w, "/fl-darkstat/admin/reload"))
+	assert.Equal(t, http.StatusForbidden, call(w, "/admin/other"))
+	assert.Equal(t, http.StatusForbidden, call(w, "/fl-darkstat/admin/other"))
+	assert.Equal(t, http.StatusForbidden, call(w, "/admin/reload/other"))
+	assert.Equal(t, http.StatusForbidden, call(w, "/index.html"))
+
+	// paths are not skipped unless app registers them
+	w = NewWebBasic(nil, WithSiteRoot("/"))
+	assert.Equal(t, http.StatusForbidden, call(w, "/admin/reload"))
+}
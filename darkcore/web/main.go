@@ -18,9 +18,10 @@ import (
 	"github.com/darklab8/fl-darkstat/darkstat/appdata"
 )
 
+// Mutex guards served data. Handlers only read it, so they do not block each other
 type Mutex interface {
-	Lock()
-	Unlock()
+	RLock()
+	RUnlock()
 }
 
 type Web struct {
@@ -30,7 +31,8 @@ type Web struct {
 	mux          *http.ServeMux
 	AppDataMutex Mutex
 
-	site_root string
+	site_root        string
+	token_auth_paths map[string]bool
 }
 
 func (w *Web) GetMux() *http.ServeMux { return w.mux }
@@ -55,11 +57,22 @@ func WithSiteRoot(site_root string) WebOpt {
 	}
 }
 
+// WithTokenAuthPaths lets endpoints checking their own token, like admin ones for deployment automation, pass password auth
+func WithTokenAuthPaths(paths ...string) WebOpt {
+	return func(w *Web) {
+		for _, path := range paths {
+			w.token_auth_paths[path] = true
+		}
+	}
+}
+
 func NewWebBasic(filesystems []*builder.Filesystem, opts ...WebOpt) *Web {
 	w := &Web{
 		filesystems: filesystems,
 		registry:    registry.NewRegister(),
 		mux:         http.NewServeMux(),
+
+		token_auth_paths: make(map[string]bool),
 	}
 
 	for _, opt := range opts {
@@ -110,7 +123,7 @@ func (w *Web) Serve(opts WebServeOpts) ServerClose {
 	}
 
 	fmt.Printf("launching web server, visit http://localhost:%d to check it!\n", port)
-	hander := AuthMiddleware(CorsMiddleware(w.mux))
+	hander := w.AuthMiddleware(CorsMiddleware(w.mux))
 
 	var sock_listener net.Listener
 	var sock_server http.Server
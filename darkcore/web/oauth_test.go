@@ -91,7 +91,7 @@ func OauthTestClient(opts WebServeOpts) {
 	w.registry.Foreach(func(e *registry.Endpoint) {
 		w.mux.HandleFunc(string(e.Url), e.Handler)
 	})
-	hander := AuthMiddleware(CorsMiddleware(w.mux))
+	hander := w.AuthMiddleware(CorsMiddleware(w.mux))
 	// hander := CorsMiddleware(w.mux)
 	var err error
 	tcp_listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", "0.0.0.0", 8881))
@@ -21,8 +21,8 @@ func NewEndpointStatic(w *Web) *registry.Endpoint {
 		Url: UrlStatic,
 		Handler: func(resp http.ResponseWriter, req *http.Request) {
 			if w.AppDataMutex != nil {
-				w.AppDataMutex.Lock()
-				defer w.AppDataMutex.Unlock()
+				w.AppDataMutex.RLock()
+				defer w.AppDataMutex.RUnlock()
 			}
 			switch req.Method {
 			case http.MethodOptions:
@@ -91,8 +91,8 @@ func NewEndpointStatic(w *Web) *registry.Endpoint {
 // 		Handler: func(resp http.ResponseWriter, req *http.Request) {
 // 			fmt.Println("Getting Requested Base Travel Routes")
 // 			if w.AppDataMutex != nil {
-// 				w.AppDataMutex.Lock()
-// 				defer w.AppDataMutex.Unlock()
+// 				w.AppDataMutex.RLock()
+// 				defer w.AppDataMutex.RUnlock()
 // 			}
 // 			base_nickname := req.PathValue("base_nickname")
 
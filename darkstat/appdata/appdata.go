@@ -17,6 +17,7 @@ import (
 	"github.com/darklab8/fl-darkstat/darkstat/settings/logus"
 	"github.com/darklab8/go-utils/utils/timeit"
 	"github.com/darklab8/go-utils/utils/utils_logus"
+	"github.com/darklab8/go-utils/utils/utils_types"
 )
 
 type AppData struct {
@@ -24,11 +25,18 @@ type AppData struct {
 	Configs *configs_export.Exporter
 	Shared  *types.SharedData
 
-	mu sync.Mutex
+	// Previous game version, which /api/diff compares current one against. Nil if it is not configured
+	DiffBaseline *configs_export.Exporter
+
+	// Data is never changed in place once served. New version is built aside,
+	// and write lock is held only to put it in place, so readers barely wait.
+	mu sync.RWMutex
 }
 
-func (a *AppData) Lock()   { a.mu.Lock() }
-func (a *AppData) Unlock() { a.mu.Unlock() }
+func (a *AppData) Lock()    { a.mu.Lock() }
+func (a *AppData) Unlock()  { a.mu.Unlock() }
+func (a *AppData) RLock()   { a.mu.RLock() }
+func (a *AppData) RUnlock() { a.mu.RUnlock() }
 
 type IsDiscovery bool
 
@@ -96,17 +104,31 @@ func NewMapped() *configs_mapped.MappedConfigs {
 		mapped = configs_mapped.NewMappedConfigs()
 	}, timeit.WithMsg("MappedConfigs creation"))
 	logus.Log.Debug("scanning freelancer folder", utils_logus.FilePath(freelancer_folder))
-	mapped.Read(freelancer_folder)
+	mapped.Read(freelancer_folder, configs_mapped.WithEnvLayers())
 	return mapped
 }
 
 func NewAppData() *AppData {
-	mapped := NewMapped()
+	data := newAppData(NewMapped(), configs_export.ExportOptions{})
+	data.DiffBaseline = NewDiffBaseline()
+	return data
+}
+
+// NewDiffBaseline exports previous game version set in DARKSTAT_DIFF_BASELINE, so requests only compare it
+func NewDiffBaseline() *configs_export.Exporter {
+	if settings.Env.DiffBaselineFolder == "" {
+		return nil
+	}
+	logus.Log.Info("exporting diff baseline", utils_logus.FilePath(settings.Env.DiffBaselineFolder))
+	return configs_export.ExportFolder(settings.Env.DiffBaselineFolder, configs_mapped.WithEnvLayers())
+}
+
+func newAppData(mapped *configs_mapped.MappedConfigs, options configs_export.ExportOptions) *AppData {
 	configs := configs_export.NewExporter(mapped)
 	build := NewBuilder(mapped.Discovery != nil)
 
 	var data *configs_export.Exporter
-	timeit.NewTimerMF("exporting data", func() { data = configs.Export(configs_export.ExportOptions{}) })
+	timeit.NewTimerMF("exporting data", func() { data = configs.Export(options) })
 
 	var shared *types.SharedData = &types.SharedData{
 		AverageTradeLaneSpeed: mapped.GetAvgTradeLaneSpeed(),
@@ -142,6 +164,57 @@ func NewAppData() *AppData {
 	}
 }
 
+/*
+ReloadAll makes new app data rereading all configs from scratch.
+Diff baseline is carried over, as it is exported once at startup out of separate folder.
+*/
+func (a *AppData) ReloadAll() *AppData {
+	a.RLock()
+	baseline := a.DiffBaseline
+	a.RUnlock()
+
+	fresh := newAppData(NewMapped(), configs_export.ExportOptions{})
+	fresh.DiffBaseline = baseline
+	return fresh
+}
+
+/*
+Reload makes new app data out of changed files.
+Only changed configs are reread, and trade graphs are reused if nothing they are built of changed.
+Current data is not modified, and it can be served until new one is put in its place with Swap.
+Mapped configs of current data should not be cleaned, as they are shared with new data.
+*/
+func (a *AppData) Reload(changed ...utils_types.FilePath) (*AppData, configs_mapped.Changes) {
+	a.RLock()
+	current := a.Configs
+	baseline := a.DiffBaseline
+	a.RUnlock()
+
+	mapped, changes := current.Mapped.Reload(changed...)
+	if len(changes) == 0 {
+		return nil, changes
+	}
+	fresh := newAppData(mapped, configs_export.ExportOptions{
+		Previous: current,
+		Changes:  changes,
+	})
+	// baseline is not part of changed files
+	fresh.DiffBaseline = baseline
+	return fresh, changes
+}
+
+// Swap puts fresh data in place of current one. Caller holds the lock
+func (a *AppData) Swap(fresh *AppData) {
+	if a.Configs != nil && a.Configs.Mapped != nil && fresh.Configs != nil && a.Configs.Mapped != fresh.Configs.Mapped {
+		// files of replaced configs are not read anymore
+		a.Configs.Mapped.Close()
+	}
+	a.Build = fresh.Build
+	a.Configs = fresh.Configs
+	a.Shared = fresh.Shared
+	a.DiffBaseline = fresh.DiffBaseline
+}
+
 func NewRelayData(app_data *AppData) *AppDataRelay {
 	return &AppDataRelay{
 		Build:   app_data.Build,
@@ -156,5 +229,38 @@ type AppDataRelay struct {
 	Configs *configs_export.ExporterRelay
 	Shared  *types.SharedData
 
-	mu *sync.Mutex
+	mu *sync.RWMutex
+}
+
+/*
+WithRefreshedPoBs builds new relay data with player owned bases fetched anew.
+It is called under read lock, and the result is put in place with SwapRelay.
+*/
+func (a *AppDataRelay) WithRefreshedPoBs() *AppDataRelay {
+	configs := a.Configs.WithRefreshedPoBs()
+	shared := *a.Shared
+	shared.Infocards = configs.Infocards
+	return &AppDataRelay{
+		Build:   a.Build,
+		Configs: configs,
+		Shared:  &shared,
+		mu:      a.mu,
+	}
+}
+
+// SwapRelay puts refreshed relay data in place. Caller holds the lock
+func (a *AppData) SwapRelay(relay_data *AppDataRelay, fresh *AppDataRelay) {
+	configs := *a.Configs
+	configs.ExporterRelay = fresh.Configs
+	a.Configs = &configs
+	a.Shared = fresh.Shared
+	relay_data.Configs = fresh.Configs
+	relay_data.Shared = fresh.Shared
+}
+
+// Swap follows app data after its Swap. Caller holds the lock
+func (a *AppDataRelay) Swap(app_data *AppData) {
+	a.Build = app_data.Build
+	a.Configs = app_data.Configs.ExporterRelay
+	a.Shared = app_data.Shared
 }
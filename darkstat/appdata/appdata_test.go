@@ -0,0 +1,75 @@
+package appdata
+
+import (
+	"testing"
+	"time"
+
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
+	"github.com/darklab8/fl-darkstat/darkstat/front/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadDuringReload(t *testing.T) {
+	app_data := &AppData{Shared: &types.SharedData{CraftableBaseName: "old"}}
+	read := func() string {
+		result := make(chan string)
+		go func() {
+			app_data.RLock()
+			defer app_data.RUnlock()
+			result <- app_data.Shared.CraftableBaseName
+		}()
+		select {
+		case name := <-result:
+			return name
+		case <-time.After(5 * time.Second):
+			t.Fatal("reader was blocked by reload")
+			return ""
+		}
+	}
+
+	building := make(chan bool)
+	release := make(chan bool)
+	reloader := NewReloader(app_data, func(fresh *AppData) func() {
+		assert.Equal(t, "old", read(), "current data is readable while fresh one is prepared")
+		return func() {}
+	})
+	done := make(chan error)
+	go func() {
+		done <- reloader.run(func() *AppData {
+			close(building)
+			<-release
+			return &AppData{Shared: &types.SharedData{CraftableBaseName: "new"}}
+		})
+	}()
+
+	<-building
+	assert.True(t, reloader.Status().InProgress)
+	assert.Equal(t, "old", read(), "current data is readable while fresh one is built")
+	close(release)
+
+	assert.Nil(t, <-done)
+	assert.Equal(t, "new", read())
+}
+
+func TestSwapRelayKeepsServedSnapshot(t *testing.T) {
+	old_relay := &configs_export.ExporterRelay{}
+	old_configs := &configs_export.Exporter{ExporterRelay: old_relay}
+	old_shared := &types.SharedData{}
+	app_data := &AppData{Configs: old_configs, Shared: old_shared}
+	relay_data := NewRelayData(app_data)
+
+	fresh_relay := &configs_export.ExporterRelay{}
+	fresh := &AppDataRelay{Configs: fresh_relay, Shared: &types.SharedData{}}
+
+	app_data.Lock()
+	app_data.SwapRelay(relay_data, fresh)
+	app_data.Unlock()
+
+	assert.Same(t, fresh_relay, app_data.Configs.ExporterRelay)
+	assert.Same(t, fresh_relay, relay_data.Configs)
+	assert.Same(t, fresh.Shared, app_data.Shared)
+
+	// whoever still holds previous snapshot, keeps reading it unchanged
+	assert.Same(t, old_relay, old_configs.ExporterRelay)
+	assert.NotSame(t, old_configs, app_data.Configs)
+}
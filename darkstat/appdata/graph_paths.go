@@ -1,20 +1,29 @@
 package appdata
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
 	"github.com/darklab8/fl-darkstat/darkstat/configs_export/trades"
 	"github.com/darklab8/go-utils/utils/ptr"
 )
 
 type GraphPathReq struct {
-	From string `json:"from" example:"li01_01_base" validate:"required"` // Write NPC base nickname, or PoB nickname (Name in base64 encoding) or Ore field name
-	To   string `json:"to" example:"br01_01_base" validate:"required"`   // Write NPC base nickname, or PoB nickname (Name in base64 encoding) or Ore field name
+	From string  `json:"from" example:"li01_01_base" validate:"required"` // Write NPC base nickname, or PoB nickname (Name in base64 encoding) or Ore field name
+	To   string  `json:"to" example:"br01_01_base" validate:"required"`   // Write NPC base nickname, or PoB nickname (Name in base64 encoding) or Ore field name
+	Ship *string `json:"ship,omitempty" example:"bw_fighter"`             // Optional ship nickname, to get time for its cruise speed and docking abilities
+
+	DiscoveryID *string            `json:"discovery_id,omitempty" example:"dsy_license_srp_28"` // Optional Discovery ID nickname. Routes starting or ending at bases hostile to its rephacks return error
+	Reputations map[string]float64 `json:"reputations,omitempty"`                               // Optional reputation per faction nickname, used if discovery id is not set
 }
 
 type GraphPathTime struct {
-	Transport *cfg.SecondsI `json:"transport"` // time in seconds
-	Frigate   *cfg.SecondsI `json:"frigate"`   // time in seconds
-	Freighter *cfg.SecondsI `json:"freighter"` // time in seconds
+	Transport *cfg.SecondsI `json:"transport"`      // time in seconds
+	Frigate   *cfg.SecondsI `json:"frigate"`        // time in seconds
+	Freighter *cfg.SecondsI `json:"freighter"`      // time in seconds
+	Ship      *cfg.SecondsI `json:"ship,omitempty"` // time in seconds for requested ship
 }
 
 type GraphPathsResp struct {
@@ -23,6 +32,32 @@ type GraphPathsResp struct {
 	Error *string        `json:"error,omitempty"` // writes error if requesting not existing nicknames in from/to fields
 }
 
+var ErrShipNotFound = errors.New("ship is not found")
+var ErrBaseHostile = errors.New("base is hostile for reputation")
+
+// checkReputation refuses routes starting or ending at bases, which pilot can not dock at.
+// Systems and bases passed on the way are not checked
+func (app_data *AppData) checkReputation(route GraphPathReq) error {
+	rep, err := app_data.Configs.GetRepProfile(route.DiscoveryID, route.Reputations)
+	if err != nil {
+		return err
+	}
+	for _, base_nickname := range []string{route.From, route.To} {
+		if !rep.CanDock(cfg.BaseUniNick(base_nickname)) {
+			return fmt.Errorf("%w: %s", ErrBaseHostile, base_nickname)
+		}
+	}
+	return nil
+}
+
+func (app_data *AppData) getShipGraph(ship_nickname string, from string) (*configs_export.GraphResults, error) {
+	profile, ok := app_data.Configs.GetShipProfileByNickname(ship_nickname)
+	if !ok {
+		return nil, ErrShipNotFound
+	}
+	return app_data.Configs.GetShipGraph(profile, from), nil
+}
+
 func (app_data *AppData) GetGraphPaths(input_routes []GraphPathReq) []GraphPathsResp {
 	var output_routes []GraphPathsResp
 
@@ -47,6 +82,15 @@ func (app_data *AppData) GetGraphPaths(input_routes []GraphPathReq) []GraphPaths
 			route.From,
 			route.To)
 
+		if err == nil {
+			err = app_data.checkReputation(route)
+		}
+
+		var ship_graph *configs_export.GraphResults
+		if err == nil && route.Ship != nil {
+			ship_graph, err = app_data.getShipGraph(*route.Ship, route.From)
+		}
+
 		if err != nil {
 			result.Error = ptr.Ptr(err.Error())
 		} else {
@@ -55,6 +99,70 @@ func (app_data *AppData) GetGraphPaths(input_routes []GraphPathReq) []GraphPaths
 				Frigate:   ptr.Ptr(int(frigate_time) / int(trades.PrecisionMultipiler)),
 				Freighter: ptr.Ptr(int(freighter_time) / int(trades.PrecisionMultipiler)),
 			}
+			if ship_graph != nil {
+				ship_time := trades.GetTimeMs2(ship_graph.Graph, ship_graph.Time, route.From, route.To)
+				result.Time.Ship = ptr.Ptr(int(ship_time) / int(trades.PrecisionMultipiler))
+			}
+		}
+
+		output_routes = append(output_routes, result)
+	}
+	return output_routes
+}
+
+type GraphRouteDetails struct {
+	Time      cfg.SecondsI                   `json:"time" validate:"required"` // total time in seconds
+	Waypoints []configs_export.RouteWaypoint `json:"waypoints" validate:"required"`
+}
+
+type GraphRouteDetailsResp struct {
+	Query     GraphPathReq       `json:"route" validate:"required"` // writes requested input
+	Transport *GraphRouteDetails `json:"transport,omitempty"`       // empty if destination is not reachable
+	Frigate   *GraphRouteDetails `json:"frigate,omitempty"`         // empty if destination is not reachable
+	Freighter *GraphRouteDetails `json:"freighter,omitempty"`       // empty if destination is not reachable
+	Ship      *GraphRouteDetails `json:"ship,omitempty"`            // for requested ship. empty if destination is not reachable
+	Error     *string            `json:"error,omitempty"`           // writes error if requesting not existing nicknames in from/to fields
+}
+
+func newGraphRouteDetails(g *configs_export.GraphResults, route GraphPathReq) *GraphRouteDetails {
+	if g == nil {
+		return nil
+	}
+	waypoints := configs_export.NewRoute(g, route.From, route.To).GetWaypoints()
+	if waypoints == nil {
+		return nil
+	}
+	result := &GraphRouteDetails{Waypoints: waypoints}
+	for _, waypoint := range waypoints {
+		result.Time += waypoint.Time
+	}
+	return result
+}
+
+func (app_data *AppData) GetGraphRouteDetails(input_routes []GraphPathReq) []GraphRouteDetailsResp {
+	var output_routes []GraphRouteDetailsResp
+
+	for _, route := range input_routes {
+		result := GraphRouteDetailsResp{Query: route}
+
+		if app_data.Configs.Freighter == nil {
+			result.Error = ptr.Ptr("trade routing is disabled")
+		} else if _, err := trades.GetTimeMs(app_data.Configs.Freighter.Graph, app_data.Configs.Freighter.Time, route.From, route.To); err != nil {
+			result.Error = ptr.Ptr(err.Error())
+		} else if err := app_data.checkReputation(route); err != nil {
+			result.Error = ptr.Ptr(err.Error())
+		} else {
+			result.Transport = newGraphRouteDetails(app_data.Configs.Transport, route)
+			result.Frigate = newGraphRouteDetails(app_data.Configs.Frigate, route)
+			result.Freighter = newGraphRouteDetails(app_data.Configs.Freighter, route)
+
+			if route.Ship != nil {
+				if ship_graph, err := app_data.getShipGraph(*route.Ship, route.From); err != nil {
+					result.Error = ptr.Ptr(err.Error())
+				} else {
+					result.Ship = newGraphRouteDetails(ship_graph, route)
+				}
+			}
 		}
 
 		output_routes = append(output_routes, result)
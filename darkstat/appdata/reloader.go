@@ -0,0 +1,129 @@
+package appdata
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/darklab8/fl-darkstat/darkstat/settings/logus"
+	"github.com/darklab8/go-typelog/typelog"
+	"github.com/darklab8/go-utils/utils/utils_types"
+)
+
+var ErrReloadInProgress = errors.New("reload is already in progress")
+
+/*
+Prepare makes everything served out of fresh app data, like static pages, while current data is still served.
+Returned commit puts prepared stuff in place, it is called with app data locked.
+*/
+type Prepare func(fresh *AppData) (commit func())
+
+type ReloadStatus struct {
+	InProgress bool      `json:"in_progress"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Error      string    `json:"error,omitempty"`
+}
+
+/*
+Reloader rebuilds app data in background, one rebuild at a time.
+Servers keep reading current data until new one is fully built, and only then it is swapped in.
+Failed rebuild leaves current data in place.
+*/
+type Reloader struct {
+	app_data *AppData
+	prepare  Prepare
+
+	mu        sync.Mutex // one rebuild at a time
+	status_mu sync.Mutex
+	status    ReloadStatus
+}
+
+func NewReloader(app_data *AppData, prepare Prepare) *Reloader {
+	return &Reloader{app_data: app_data, prepare: prepare}
+}
+
+func (r *Reloader) Status() ReloadStatus {
+	r.status_mu.Lock()
+	defer r.status_mu.Unlock()
+	return r.status
+}
+
+func (r *Reloader) setStatus(update func(status *ReloadStatus)) {
+	r.status_mu.Lock()
+	defer r.status_mu.Unlock()
+	update(&r.status)
+}
+
+func (r *Reloader) run(build func() *AppData) (err error) {
+	r.setStatus(func(status *ReloadStatus) {
+		*status = ReloadStatus{InProgress: true, StartedAt: time.Now().UTC()}
+	})
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("reload crashed: %v", rec)
+			logus.Log.Error("failed to reload app data, keeping current one", typelog.Any("recover", rec), typelog.String("stack", string(debug.Stack())))
+		}
+		r.setStatus(func(status *ReloadStatus) {
+			status.InProgress = false
+			status.FinishedAt = time.Now().UTC()
+			if err != nil {
+				status.Error = err.Error()
+			}
+		})
+	}()
+
+	fresh := build()
+	if fresh == nil {
+		return nil
+	}
+	commit := r.prepare(fresh)
+
+	r.app_data.Lock()
+	r.app_data.Swap(fresh)
+	commit()
+	r.app_data.Unlock()
+	logus.Log.Info("swapped app data")
+
+	// previous data is released
+	runtime.GC()
+	return nil
+}
+
+// ReloadAll rereads all configs from scratch, blocking until data is swapped
+func (r *Reloader) ReloadAll() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.run(r.app_data.ReloadAll)
+}
+
+// StartReloadAll rereads all configs in background. It refuses to start if other reload is running
+func (r *Reloader) StartReloadAll() error {
+	if !r.mu.TryLock() {
+		return ErrReloadInProgress
+	}
+	go func() {
+		defer r.mu.Unlock()
+		err := r.run(r.app_data.ReloadAll)
+		logus.Log.CheckError(err, "failed to reload all app data")
+	}()
+	return nil
+}
+
+// ReloadChanged rereads only configs of changed files, blocking until data is swapped
+func (r *Reloader) ReloadChanged(changed ...utils_types.FilePath) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.run(func() *AppData {
+		fresh, changes := r.app_data.Reload(changed...)
+		if fresh == nil {
+			logus.Log.Info("changed files are not part of configs")
+			return nil
+		}
+		logus.Log.Info("reloaded changed configs", typelog.Any("changes", changes))
+		return fresh
+	})
+}
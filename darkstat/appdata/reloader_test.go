@@ -0,0 +1,42 @@
+package appdata
+
+import (
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/darkstat/front/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloaderSwap(t *testing.T) {
+	current := &AppData{Shared: &types.SharedData{CraftableBaseName: "old"}}
+	committed := false
+	reloader := NewReloader(current, func(fresh *AppData) func() {
+		assert.Equal(t, "old", current.Shared.CraftableBaseName, "current data is served while fresh one is prepared")
+		return func() { committed = true }
+	})
+
+	err := reloader.run(func() *AppData {
+		return &AppData{Shared: &types.SharedData{CraftableBaseName: "new"}}
+	})
+	assert.Nil(t, err)
+	assert.True(t, committed)
+	assert.Equal(t, "new", current.Shared.CraftableBaseName)
+	assert.False(t, reloader.Status().InProgress)
+}
+
+func TestReloaderFailureKeepsData(t *testing.T) {
+	current := &AppData{Shared: &types.SharedData{CraftableBaseName: "old"}}
+	reloader := NewReloader(current, func(fresh *AppData) func() { return func() {} })
+
+	err := reloader.run(func() *AppData { panic("broken configs") })
+	assert.NotNil(t, err)
+	assert.Equal(t, "old", current.Shared.CraftableBaseName)
+	assert.Contains(t, reloader.Status().Error, "broken configs")
+}
+
+func TestReloaderOneAtTime(t *testing.T) {
+	reloader := NewReloader(&AppData{}, func(fresh *AppData) func() { return func() {} })
+	reloader.mu.Lock()
+	assert.ErrorIs(t, reloader.StartReloadAll(), ErrReloadInProgress)
+	reloader.mu.Unlock()
+}
@@ -105,6 +105,7 @@ func (e *Exporter) GetBases() []*Base {
 			Name:               name,
 			Nickname:           nickname,
 			FactionName:        factionName,
+			FactionNickname:    reputation_nickname,
 			System:             string(system_name),
 			SystemNickname:     base.System.Get(),
 			StridName:          base.StridName.Get(),
@@ -183,6 +184,7 @@ type Base struct {
 	Archetypes         []string             `json:"archetypes"  validate:"required"` // Base Archetypes
 	Nickname           cfg.BaseUniNick      `json:"nickname"  validate:"required"`
 	FactionName        string               `json:"faction_name"  validate:"required"`
+	FactionNickname    string               `json:"faction_nickname"  validate:"required"`
 	System             string               `json:"system_name"  validate:"required"`
 	SystemNickname     string               `json:"system_nickname"  validate:"required"`
 	Region             string               `json:"region_name"  validate:"required"`
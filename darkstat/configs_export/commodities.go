@@ -20,6 +20,10 @@ type MarketGood struct {
 	BaseSells            bool          `json:"base_sells" validate:"required"`
 	IsServerSideOverride bool          `json:"is_server_override" validate:"required"`
 
+	// PoB stock limits, units base can sell and buy until reaching its min and max stock. Nil for NPC bases
+	StockBaseSells *int `json:"stock_base_sells,omitempty"`
+	StockBaseBuys  *int `json:"stock_base_buys,omitempty"`
+
 	NotBuyable             bool `json:"_" swaggerignore:"true"`
 	IsTransportUnreachable bool `json:"_" swaggerignore:"true"`
 
@@ -156,6 +160,7 @@ func (e *Exporter) ServerSideMarketGoodsOverrides(commodity GetCommodityAtBasesI
 			ShipClass:            commodity.ShipClass,
 			IsServerSideOverride: true,
 		}
+		e.overridePrice(base_info)
 
 		if e.useful_bases_by_nick != nil {
 			if _, ok := e.useful_bases_by_nick[base_info.BaseNickname]; !ok {
@@ -192,6 +197,7 @@ func (e *Exporter) GetAtBasesSold(commodity GetCommodityAtBasesInput) map[cfg.Ba
 		} else {
 			base_info.PriceBaseBuysFor = ptr.Ptr(base_info.PriceBaseSellsFor)
 		}
+		e.overridePrice(base_info)
 
 		base_info.LevelRequired = market_good.LevelRequired.Get()
 		base_info.RepRequired = market_good.RepRequired.Get()
@@ -266,6 +272,8 @@ func (e *Exporter) GetAtBasesSold(commodity GetCommodityAtBasesInput) map[cfg.Ba
 					IsServerSideOverride: true,
 					PriceBaseBuysFor:     ptr.Ptr(good.SellPrice),
 					PriceBaseSellsFor:    good.Price,
+					StockBaseSells:       ptr.Ptr(good.StockBaseSells()),
+					StockBaseBuys:        ptr.Ptr(good.StockBaseBuys()),
 					Volume:               commodity.Volume,
 					ShipClass:            commodity.ShipClass,
 					BaseInfo: BaseInfo{
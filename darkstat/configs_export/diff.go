@@ -0,0 +1,357 @@
+package configs_export
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/go-utils/utils/utils_types"
+)
+
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeRemoved ChangeKind = "removed"
+	ChangeChanged ChangeKind = "changed"
+)
+
+type FieldChange struct {
+	Field string `json:"field"  validate:"required"` // json path of field, nested objects are joined with dot
+	Old   string `json:"old"  validate:"required"`
+	New   string `json:"new"  validate:"required"`
+}
+
+type EntityChange struct {
+	Category string        `json:"category"  validate:"required"`
+	Nickname string        `json:"nickname"  validate:"required"`
+	Name     string        `json:"name"  validate:"required"`
+	Kind     ChangeKind    `json:"kind"  validate:"required"`
+	Fields   []FieldChange `json:"fields"  validate:"required"` // only for changed entities
+}
+
+type ChangeSet struct {
+	Label   string         `json:"label"  validate:"required"` // Discovery patch name of new version if it is known
+	Changes []EntityChange `json:"changes"  validate:"required"`
+}
+
+const (
+	DiffCategoryGuns        = "guns"
+	DiffCategoryShips       = "ships"
+	DiffCategoryShields     = "shields"
+	DiffCategoryCommodities = "commodities"
+	DiffCategoryBases       = "bases"
+	DiffCategoryMarket      = "market prices"
+)
+
+var DiffCategories = []string{
+	DiffCategoryGuns,
+	DiffCategoryShips,
+	DiffCategoryShields,
+	DiffCategoryCommodities,
+	DiffCategoryBases,
+	DiffCategoryMarket,
+}
+
+// fields which differ between any two game folders without gameplay meaning
+var diffIgnoredFields = map[string]bool{
+	"file": true,
+}
+
+func (c *ChangeSet) Count(category string, kind ChangeKind) int {
+	count := 0
+	for _, change := range c.Changes {
+		if change.Category == category && change.Kind == kind {
+			count++
+		}
+	}
+	return count
+}
+
+func (c *ChangeSet) ByCategory(category string) []EntityChange {
+	var result []EntityChange
+	for _, change := range c.Changes {
+		if change.Category == category {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+type diffEntity struct {
+	nickname string
+	name     string
+	value    interface{}
+}
+
+type marketPrice struct {
+	PriceBaseSellsFor int     `json:"price_base_sells_for"`
+	PriceBaseBuysFor  *int    `json:"price_base_buys_for"`
+	BaseSells         bool    `json:"base_sells"`
+	RepRequired       float64 `json:"rep_required"`
+	LevelRequired     int     `json:"level_required"`
+}
+
+/*
+baseState keeps only base fields set in configs.
+Names resolved from other entities, sector derived from position and market goods compared in own category
+would report every base as changed when only something they refer to changed.
+*/
+type baseState struct {
+	Name                   string     `json:"name"`
+	Archetypes             []string   `json:"archetypes"`
+	FactionNickname        string     `json:"faction_nickname"`
+	SystemNickname         string     `json:"system_nickname"`
+	StridName              int        `json:"strid_name"`
+	InfocardID             int        `json:"infocard_id"`
+	Pos                    cfg.Vector `json:"pos"`
+	IsTransportUnreachable bool       `json:"is_transport_unreachable"`
+	Reachable              bool       `json:"is_reachhable"`
+	DynamicLootMin         int        `json:"dynamic_loot_min,omitempty"`
+	DynamicLootMax         int        `json:"dynamic_loot_max,omitempty"`
+	DynamicLootDifficulty  int        `json:"dynamic_loot_difficulty,omitempty"`
+}
+
+func newBaseState(base *Base) baseState {
+	state := baseState{
+		Name:                   base.Name,
+		Archetypes:             base.Archetypes,
+		FactionNickname:        base.FactionNickname,
+		SystemNickname:         base.SystemNickname,
+		StridName:              base.StridName,
+		InfocardID:             base.InfocardID,
+		Pos:                    base.Pos,
+		IsTransportUnreachable: base.IsTransportUnreachable,
+		Reachable:              base.Reachable,
+	}
+	if base.MiningInfo != nil {
+		state.DynamicLootMin = base.DynamicLootMin
+		state.DynamicLootMax = base.DynamicLootMax
+		state.DynamicLootDifficulty = base.DynamicLootDifficulty
+	}
+	return state
+}
+
+func marketEntities(e *Exporter) []diffEntity {
+	var result []diffEntity
+	for _, base := range e.Bases {
+		for key, good := range base.MarketGoodsPerNick {
+			result = append(result, diffEntity{
+				nickname: fmt.Sprintf("%s/%s", base.Nickname, key),
+				name:     fmt.Sprintf("%s at %s", good.Name, base.Name),
+				value: marketPrice{
+					PriceBaseSellsFor: good.PriceBaseSellsFor,
+					PriceBaseBuysFor:  good.PriceBaseBuysFor,
+					BaseSells:         good.BaseSells,
+					RepRequired:       good.RepRequired,
+					LevelRequired:     good.LevelRequired,
+				},
+			})
+		}
+	}
+	return result
+}
+
+func diffEntities(e *Exporter, category string) []diffEntity {
+	var result []diffEntity
+	switch category {
+	case DiffCategoryGuns:
+		for _, item := range e.Guns {
+			result = append(result, diffEntity{nickname: item.Nickname, name: item.Name, value: item})
+		}
+	case DiffCategoryShips:
+		for _, item := range e.Ships {
+			result = append(result, diffEntity{nickname: item.Nickname, name: item.Name, value: item})
+		}
+	case DiffCategoryShields:
+		for _, item := range e.Shields {
+			result = append(result, diffEntity{nickname: item.Nickname, name: item.Name, value: item})
+		}
+	case DiffCategoryCommodities:
+		for _, item := range e.Commodities {
+			// Discovery has commodity duplicated per ship class with different volumes
+			result = append(result, diffEntity{nickname: string(GetCommodityKey(item.Nickname, item.ShipClass)), name: item.Name, value: item})
+		}
+	case DiffCategoryBases:
+		for _, item := range e.Bases {
+			result = append(result, diffEntity{nickname: string(item.Nickname), name: item.Name, value: newBaseState(item)})
+		}
+	case DiffCategoryMarket:
+		result = marketEntities(e)
+	}
+	return result
+}
+
+// flattenJson turns value into field paths, the same way as it is visible through API
+func flattenJson(prefix string, value interface{}, out map[string]string) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typed {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			flattenJson(path, nested, out)
+		}
+	case nil:
+		out[prefix] = ""
+	case string:
+		out[prefix] = typed
+	case []interface{}:
+		data, _ := json.Marshal(typed)
+		out[prefix] = string(data)
+	default:
+		out[prefix] = fmt.Sprintf("%v", typed)
+	}
+}
+
+func diffFields(old_value interface{}, new_value interface{}) []FieldChange {
+	flatten := func(value interface{}) map[string]string {
+		out := make(map[string]string)
+		data, err := json.Marshal(value)
+		if err != nil {
+			return out
+		}
+		var decoded interface{}
+		json.Unmarshal(data, &decoded)
+		flattenJson("", decoded, out)
+		return out
+	}
+	old_fields := flatten(old_value)
+	new_fields := flatten(new_value)
+
+	var changes []FieldChange
+	for field, old := range old_fields {
+		if diffIgnoredFields[field] {
+			continue
+		}
+		if updated, ok := new_fields[field]; !ok || updated != old {
+			changes = append(changes, FieldChange{Field: field, Old: old, New: updated})
+		}
+	}
+	for field, updated := range new_fields {
+		if _, ok := old_fields[field]; !ok && !diffIgnoredFields[field] {
+			changes = append(changes, FieldChange{Field: field, New: updated})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	return changes
+}
+
+func diffCategory(category string, old_items []diffEntity, new_items []diffEntity) []EntityChange {
+	old_by_nick := make(map[string]diffEntity)
+	for _, item := range old_items {
+		old_by_nick[strings.ToLower(item.nickname)] = item
+	}
+	new_by_nick := make(map[string]diffEntity)
+	for _, item := range new_items {
+		new_by_nick[strings.ToLower(item.nickname)] = item
+	}
+
+	var changes []EntityChange
+	for nickname, item := range new_by_nick {
+		old, ok := old_by_nick[nickname]
+		if !ok {
+			changes = append(changes, EntityChange{Category: category, Nickname: item.nickname, Name: item.name, Kind: ChangeAdded})
+			continue
+		}
+		if fields := diffFields(old.value, item.value); len(fields) > 0 {
+			changes = append(changes, EntityChange{Category: category, Nickname: item.nickname, Name: item.name, Kind: ChangeChanged, Fields: fields})
+		}
+	}
+	for nickname, item := range old_by_nick {
+		if _, ok := new_by_nick[nickname]; !ok {
+			changes = append(changes, EntityChange{Category: category, Nickname: item.nickname, Name: item.name, Kind: ChangeRemoved})
+		}
+	}
+
+	kind_order := map[ChangeKind]int{ChangeAdded: 0, ChangeRemoved: 1, ChangeChanged: 2}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return kind_order[changes[i].Kind] < kind_order[changes[j].Kind]
+		}
+		return changes[i].Nickname < changes[j].Nickname
+	})
+	return changes
+}
+
+/*
+Diff compares exported data of two game versions entity by entity.
+Entities are matched by nickname, so renamed entities are shown as removed and added.
+Both exporters should already have Export done.
+*/
+func Diff(old *Exporter, new *Exporter) *ChangeSet {
+	result := &ChangeSet{Changes: make([]EntityChange, 0)}
+	if new.Mapped != nil && new.Mapped.Discovery != nil {
+		result.Label = new.Mapped.Discovery.LatestPatch.Name
+	}
+
+	for _, category := range DiffCategories {
+		result.Changes = append(result.Changes, diffCategory(category, diffEntities(old, category), diffEntities(new, category))...)
+	}
+	return result
+}
+
+func escapeMarkdown(value string) string {
+	return strings.ReplaceAll(value, "|", "\\|")
+}
+
+// Markdown renders change set as changelog suitable for forum posts and git hosting
+func (c *ChangeSet) Markdown() string {
+	var sb strings.Builder
+	title := "Changelog"
+	if c.Label != "" {
+		title = fmt.Sprintf("Changelog for %s", c.Label)
+	}
+	sb.WriteString(fmt.Sprintf("# %s\n", title))
+
+	for _, category := range DiffCategories {
+		changes := c.ByCategory(category)
+		if len(changes) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\n## %s (%d added, %d removed, %d changed)\n\n", category,
+			c.Count(category, ChangeAdded), c.Count(category, ChangeRemoved), c.Count(category, ChangeChanged)))
+		for _, change := range changes {
+			sb.WriteString(fmt.Sprintf("- **%s** %s (`%s`)\n", change.Kind, escapeMarkdown(change.Name), change.Nickname))
+			if len(change.Fields) == 0 {
+				continue
+			}
+			sb.WriteString("\n  | Field | Old | New |\n  | --- | --- | --- |\n")
+			for _, field := range change.Fields {
+				sb.WriteString(fmt.Sprintf("  | %s | %s | %s |\n", field.Field, escapeMarkdown(field.Old), escapeMarkdown(field.New)))
+			}
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+type DiffFormat string
+
+const (
+	DiffFormatJson     DiffFormat = "json"
+	DiffFormatMarkdown DiffFormat = "markdown"
+	DiffFormatHtml     DiffFormat = "html"
+)
+
+func ParseDiffFormat(value string) (DiffFormat, error) {
+	switch format := DiffFormat(strings.ToLower(value)); format {
+	case "":
+		return DiffFormatJson, nil
+	case DiffFormatJson, DiffFormatMarkdown, DiffFormatHtml:
+		return format, nil
+	case "md":
+		return DiffFormatMarkdown, nil
+	}
+	return "", fmt.Errorf("unknown diff format %q, expected json, markdown or html", value)
+}
+
+// ExportFolder reads and exports game data of folder or archive, as it is needed for Diff
+func ExportFolder(folder utils_types.FilePath, opts ...configs_mapped.ReadOption) *Exporter {
+	mapped := configs_mapped.NewMappedConfigs().Read(folder, opts...)
+	return NewExporter(mapped).Export(ExportOptions{})
+}
@@ -0,0 +1,78 @@
+package configs_export
+
+import (
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	old := &Exporter{
+		Guns: []Gun{
+			{Nickname: "gun_kept", Name: "Kept", Price: 100},
+			{Nickname: "gun_changed", Name: "Changed", Price: 100, Refire: 2},
+			{Nickname: "gun_removed", Name: "Removed"},
+		},
+		Bases: []*Base{{
+			Nickname: "li01_01_base",
+			Name:     "Manhattan",
+			File:     "/old/li01_01.ini",
+			Region:   "Liberty",
+			MarketGoodsPerNick: map[CommodityKey]*MarketGood{
+				"commodity_gold_0": {GoodInfo: GoodInfo{Name: "Gold"}, PriceBaseSellsFor: 500, BaseSells: true},
+			},
+		}, {
+			Nickname: "li01_02_base",
+			Name:     "Moved",
+			Pos:      cfg.Vector{X: 100},
+		}},
+	}
+	new := &Exporter{
+		Guns: []Gun{
+			{Nickname: "gun_kept", Name: "Kept", Price: 100},
+			{Nickname: "gun_changed", Name: "Changed", Price: 150, Refire: 2},
+			{Nickname: "gun_added", Name: "Added"},
+		},
+		Bases: []*Base{{
+			Nickname: cfg.BaseUniNick("li01_01_base"),
+			Name:     "Manhattan",
+			File:     "/new/li01_01.ini",
+			Region:   "Liberty Space",
+			MarketGoodsPerNick: map[CommodityKey]*MarketGood{
+				"commodity_gold_0": {GoodInfo: GoodInfo{Name: "Gold"}, PriceBaseSellsFor: 650, BaseSells: true},
+			},
+		}, {
+			Nickname: "li01_02_base",
+			Name:     "Moved",
+			Pos:      cfg.Vector{X: 200},
+		}},
+	}
+
+	changes := Diff(old, new)
+	guns := changes.ByCategory(DiffCategoryGuns)
+	if assert.Len(t, guns, 3) {
+		assert.Equal(t, ChangeAdded, guns[0].Kind)
+		assert.Equal(t, "gun_added", guns[0].Nickname)
+		assert.Equal(t, ChangeRemoved, guns[1].Kind)
+		assert.Equal(t, ChangeChanged, guns[2].Kind)
+		assert.Equal(t, []FieldChange{{Field: "price", Old: "100", New: "150"}}, guns[2].Fields)
+	}
+
+	// file path and names resolved from other entities are not changes of base itself
+	bases := changes.ByCategory(DiffCategoryBases)
+	if assert.Len(t, bases, 1) {
+		assert.Equal(t, "li01_02_base", bases[0].Nickname)
+		assert.Equal(t, []FieldChange{{Field: "pos.X", Old: "100", New: "200"}}, bases[0].Fields)
+	}
+
+	market := changes.ByCategory(DiffCategoryMarket)
+	if assert.Len(t, market, 1) {
+		assert.Equal(t, "li01_01_base/commodity_gold_0", market[0].Nickname)
+		assert.Equal(t, []FieldChange{{Field: "price_base_sells_for", Old: "500", New: "650"}}, market[0].Fields)
+	}
+
+	markdown := changes.Markdown()
+	assert.Contains(t, markdown, "## guns (1 added, 1 removed, 1 changed)")
+	assert.Contains(t, markdown, "| price | 100 | 150 |")
+}
@@ -1,26 +1,33 @@
 package configs_export
 
 import (
+	"reflect"
 	"strings"
 	"sync"
 
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/infocard_mapped/infocard"
 	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
 	"github.com/darklab8/fl-darkstat/darkstat/configs_export/trades"
 	"github.com/darklab8/fl-darkstat/darkstat/settings"
+	"github.com/darklab8/go-utils/utils/ptr"
 )
 
 type InfocardKey string
 
 type InfocardPhrase struct {
-	Phrase string  `json:"phrase"  validate:"required"`
-	Link   *string `json:"link"`
-	Bold   bool    `json:"bold"  validate:"required"`
+	Phrase    string  `json:"phrase"  validate:"required"`
+	Link      *string `json:"link"`
+	Bold      bool    `json:"bold"  validate:"required"`
+	Italic    bool    `json:"italic"`
+	Underline bool    `json:"underline"`
+	Color     *string `json:"color"` // #RRGGBB
 }
 
 type InfocardLine struct {
 	Phrases []InfocardPhrase `json:"phrases"  validate:"required"`
+	Justify infocard.Justify `json:"justify,omitempty"` // left, center or right
 }
 
 func (i InfocardLine) ToStr() string {
@@ -35,6 +42,28 @@ func NewInfocardSimpleLine(line string) InfocardLine {
 	return InfocardLine{Phrases: []InfocardPhrase{{Phrase: line}}}
 }
 
+func NewInfocardRichLine(line infocard.RDLLine) InfocardLine {
+	if len(line.Phrases) == 0 {
+		result := NewInfocardSimpleLine("")
+		result.Justify = line.Justify
+		return result
+	}
+	result := InfocardLine{Justify: line.Justify}
+	for _, phrase := range line.Phrases {
+		phrase_to_add := InfocardPhrase{
+			Phrase:    phrase.Text,
+			Bold:      phrase.Bold,
+			Italic:    phrase.Italic,
+			Underline: phrase.Underline,
+		}
+		if phrase.Color != "" {
+			phrase_to_add.Color = ptr.Ptr(phrase.Color)
+		}
+		result.Phrases = append(result.Phrases, phrase_to_add)
+	}
+	return result
+}
+
 func NewInfocardBuilder() InfocardBuilder {
 	return InfocardBuilder{}
 }
@@ -74,8 +103,8 @@ func (e *Exporter) exportInfocards(nickname InfocardKey, infocard_ids ...int) {
 
 	for _, info_id := range infocard_ids {
 		if value, ok := e.Mapped.Infocards.Infocards[info_id]; ok {
-			for _, line := range value.Lines {
-				e.Infocards[InfocardKey(nickname)] = append(e.Infocards[InfocardKey(nickname)], NewInfocardSimpleLine(line))
+			for _, line := range value.RichLines {
+				e.Infocards[InfocardKey(nickname)] = append(e.Infocards[InfocardKey(nickname)], NewInfocardRichLine(line))
 			}
 
 		}
@@ -106,11 +135,19 @@ type Exporter struct {
 
 	MiningOperations     []*Base
 	useful_bases_by_nick map[cfg.BaseUniNick]bool
-
-	ship_speeds trades.ShipSpeeds
-	Transport   *GraphResults
-	Freighter   *GraphResults
-	Frigate     *GraphResults
+	// volumes per commodity nickname and ship class
+	commodity_volumes map[string]map[cfg.ShipClass]float64
+	// owner factions of bases, including PoBs
+	base_factions map[cfg.BaseUniNick]cfg.FactionNick
+
+	ship_speeds   trades.ShipSpeeds
+	graph_bases   map[string][]trades.ExtraBase
+	graph_options trades.MappingOptions
+	graph_mapped  *configs_mapped.MappedConfigs
+	ship_graphs   *shipGraphs
+	Transport     *GraphResults
+	Freighter     *GraphResults
+	Frigate       *GraphResults
 
 	Factions     []Faction
 	Commodities  []*Commodity
@@ -128,6 +165,9 @@ type Exporter struct {
 	Scanners     []Scanner
 	Ammos        []Ammo
 
+	// Entities with values expected by mapped models, but absent in configs
+	MissingFields []MissingFields
+
 	findable_in_loot_cache map[string]bool
 	craftable_cached       map[string]bool
 	pob_buyable_cache      map[string][]*PobShopItem
@@ -137,8 +177,10 @@ type OptExport func(e *Exporter)
 
 func NewExporter(mapped *configs_mapped.MappedConfigs, opts ...OptExport) *Exporter {
 	e := &Exporter{
-		Mapped:      mapped,
-		ship_speeds: trades.VanillaSpeeds,
+		Mapped:       mapped,
+		ship_speeds:  trades.VanillaSpeeds,
+		graph_mapped: newGraphMapped(mapped),
+		ship_graphs:  newShipGraphs(),
 		ExporterRelay: &ExporterRelay{
 			Infocards: map[InfocardKey]Infocard{},
 			Mapped:    mapped,
@@ -188,8 +230,37 @@ func NewGraphResults(
 	}
 }
 
+// withRelay makes copy of already calculated graph for new export
+func (g *GraphResults) withRelay(e *ExporterRelay) *GraphResults {
+	if g == nil {
+		return nil
+	}
+	result := *g
+	result.e = e
+	return &result
+}
+
 type ExportOptions struct {
 	trades.MappingOptions
+
+	// Previous export of configs reloaded with Changes.
+	// Its trade graphs are reused when nothing they are built of has changed.
+	Previous *Exporter
+	Changes  configs_mapped.Changes
+}
+
+func (e *Exporter) canReuseGraphs(options ExportOptions) bool {
+	previous := options.Previous
+	if previous == nil || previous.Transport == nil || options.Changes == nil {
+		return false
+	}
+	if options.Changes.Has(configs_mapped.GraphComponents...) {
+		return false
+	}
+	// mining operations and PoBs are part of graph, and they depend on other configs
+	return reflect.DeepEqual(previous.graph_bases, e.graph_bases) &&
+		reflect.DeepEqual(previous.graph_options, e.graph_options) &&
+		previous.ship_speeds == e.ship_speeds
 }
 
 func (e *Exporter) Export(options ExportOptions) *Exporter {
@@ -210,6 +281,7 @@ func (e *Exporter) Export(options ExportOptions) *Exporter {
 	e.useful_bases_by_nick[BaseLootableNickname] = true
 
 	e.Commodities = e.GetCommodities()
+	e.commodity_volumes = getCommodityVolumes(e.Commodities)
 	EnhanceBasesWithServerOverrides(e.Bases, e.Commodities)
 
 	e.MiningOperations = e.GetOres(e.Commodities)
@@ -237,12 +309,17 @@ func (e *Exporter) Export(options ExportOptions) *Exporter {
 	if e.Mapped.Discovery != nil {
 		e.ship_speeds = trades.DiscoverySpeeds
 	}
+	e.ship_speeds = e.ship_speeds.WithOverrides(e.Mapped.Overrides.CruiseSpeeds)
 
-	if e.Mapped.FLSR != nil {
-		e.ship_speeds = trades.FLSRSpeeds
-	}
+	e.graph_bases = extra_graph_bases
+	e.graph_options = options.MappingOptions
 
-	if !settings.Env.IsDisabledTradeRouting {
+	if e.canReuseGraphs(options) {
+		logus.Log.Info("reusing trade graphs of previous export")
+		e.Transport = options.Previous.Transport.withRelay(e.ExporterRelay)
+		e.Freighter = options.Previous.Freighter.withRelay(e.ExporterRelay)
+		e.Frigate = options.Previous.Frigate.withRelay(e.ExporterRelay)
+	} else if !settings.Env.IsDisabledTradeRouting {
 
 		wg.Add(1)
 		go func() {
@@ -294,10 +371,13 @@ func (e *Exporter) Export(options ExportOptions) *Exporter {
 	logus.Log.Info("getting ammo")
 
 	e.Ammos = e.GetAmmo(e.Tractors)
+	e.MissingFields = e.GetMissingFields()
 	logus.Log.Info("waiting for graph to finish")
 
 	wg.Wait()
 
+	e.HideOverridden()
+
 	logus.Log.Info("getting pob to bases")
 	// TODO refactor. all my ram allocated problems are majorly here.
 	BasesFromPobs := e.PoBsToBases(e.PoBs)
@@ -305,10 +385,12 @@ func (e *Exporter) Export(options ExportOptions) *Exporter {
 	e.TradeBases, e.Commodities = e.TradePaths(TradeBases, e.Commodities)
 	e.MiningOperations, e.Commodities = e.TradePaths(e.MiningOperations, e.Commodities)
 	e.TravelBases = e.TradeBases
+	e.base_factions = getBaseFactions(e.TradeBases)
 
 	e.EnhanceBasesWithIsTransportReachable(e.Bases, e.Transport, e.Freighter)
 	e.Bases = e.EnhanceBasesWithPobCrafts(e.Bases)
 	e.Bases = e.EnhanceBasesWithLoot(e.Bases)
+	e.LinkInfocards()
 	logus.Log.Info("finished exporting")
 
 	return e
@@ -111,6 +111,7 @@ func (e *Exporter) getMarketGoods() map[cfg.BaseUniNick]map[CommodityKey]*Market
 				} else {
 					good_to_add.PriceBaseBuysFor = ptr.Ptr(good_to_add.PriceBaseSellsFor)
 				}
+				e.overridePrice(good_to_add)
 				equipment := e.Mapped.Equip().CommoditiesMap[market_good_nickname]
 
 				for _, volume := range equipment.Volumes {
@@ -121,6 +122,7 @@ func (e *Exporter) getMarketGoods() map[cfg.BaseUniNick]map[CommodityKey]*Market
 				}
 
 			} else {
+				e.overridePrice(good_to_add)
 				MarketGoods[GetCommodityKey(market_good_nickname, good_to_add.ShipClass)] = good_to_add
 			}
 		}
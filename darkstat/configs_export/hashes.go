@@ -0,0 +1,68 @@
+package configs_export
+
+import (
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/initialworld/flhash"
+)
+
+type ResolvedHashEntry struct {
+	Nickname string `json:"nickname"  validate:"required"`
+	Kind     string `json:"kind"  validate:"required"`
+	Section  string `json:"section"  validate:"required"`
+}
+
+type ResolvedHash struct {
+	Query   string              `json:"query"  validate:"required"`
+	Int32   int32               `json:"int32"  validate:"required"`
+	Uint32  uint32              `json:"uint32"  validate:"required"`
+	Hex     string              `json:"hex"  validate:"required"`
+	Found   bool                `json:"found"  validate:"required"`
+	Error   *string             `json:"error,omitempty"`
+	Entries []ResolvedHashEntry `json:"entries"  validate:"required"`
+}
+
+// IsCollision is true when more than one nickname shares the hash
+func (r ResolvedHash) IsCollision() bool { return len(r.Entries) > 1 }
+
+/*
+ResolveHashes looks up nicknames for hashes written in any form from save files, logs or PoB data.
+Hashes which were not parsed or found are still returned, so answer matches query by index.
+*/
+func (e *Exporter) ResolveHashes(queries []string) []ResolvedHash {
+	result := make([]ResolvedHash, 0, len(queries))
+	for _, query := range queries {
+		resolved := ResolvedHash{Query: query, Entries: []ResolvedHashEntry{}}
+		hash, err := flhash.ParseHash(query)
+		if err != nil {
+			msg := err.Error()
+			resolved.Error = &msg
+			result = append(result, resolved)
+			continue
+		}
+		resolved.Int32 = int32(hash)
+		resolved.Uint32 = uint32(hash)
+		resolved.Hex = hash.ToHexStr()
+
+		if e.Mapped != nil && e.Mapped.Hashes != nil {
+			if entries, ok := e.Mapped.Hashes.Resolve(hash); ok {
+				resolved.Found = true
+				for _, entry := range entries {
+					resolved.Entries = append(resolved.Entries, ResolvedHashEntry{
+						Nickname: entry.Nickname,
+						Kind:     string(entry.Kind),
+						Section:  entry.Section,
+					})
+				}
+			}
+		}
+		result = append(result, resolved)
+	}
+	return result
+}
+
+// HashCollisions lists nicknames sharing same hash within loaded configs
+func (e *Exporter) HashCollisions() []flhash.HashCollision {
+	if e.Mapped == nil || e.Mapped.Hashes == nil {
+		return nil
+	}
+	return e.Mapped.Hashes.Collisions()
+}
@@ -0,0 +1,226 @@
+package configs_export
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/darklab8/go-utils/utils/ptr"
+)
+
+// InfocardPath is relative to site root, same path as static infocard pages are rendered to
+func InfocardPath(infocard_key InfocardKey) string {
+	return "infocards/info_" + strings.ToLower(string(infocard_key))
+}
+
+const min_linkable_name_len = 4
+
+type infocardLinker struct {
+	// lower cased name to infocard it links to. Empty key if name is ambiguous
+	targets map[string]InfocardKey
+	// lower cased first word to names starting from it, longest first
+	by_first_word map[string][]string
+}
+
+func newInfocardLinker() *infocardLinker {
+	return &infocardLinker{
+		targets:       make(map[string]InfocardKey),
+		by_first_word: make(map[string][]string),
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func firstWord(text string) string {
+	for index, r := range text {
+		if !isWordRune(r) {
+			return text[:index]
+		}
+	}
+	return text
+}
+
+func (l *infocardLinker) Add(name string, key InfocardKey) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if utf8.RuneCountInString(name) < min_linkable_name_len || firstWord(name) == "" {
+		return
+	}
+
+	if existing, ok := l.targets[name]; ok {
+		if existing != key {
+			l.targets[name] = ""
+		}
+		return
+	}
+	l.targets[name] = key
+	first_word := firstWord(name)
+	l.by_first_word[first_word] = append(l.by_first_word[first_word], name)
+}
+
+func (l *infocardLinker) finalize() {
+	for _, names := range l.by_first_word {
+		sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+	}
+}
+
+// match returns length of longest known name at start of text
+func (l *infocardLinker) match(text string) (int, InfocardKey) {
+	candidates, ok := l.by_first_word[strings.ToLower(firstWord(text))]
+	if !ok {
+		return 0, ""
+	}
+	for _, name := range candidates {
+		if len(name) > len(text) || !strings.EqualFold(text[:len(name)], name) {
+			continue
+		}
+		if next, _ := utf8.DecodeRuneInString(text[len(name):]); len(name) < len(text) && isWordRune(next) {
+			continue
+		}
+		return len(name), l.targets[name]
+	}
+	return 0, ""
+}
+
+/*
+Link turns first mention of every known name into link to its infocard.
+Infocard does not link to itself, and ambiguous names are left as plain text.
+*/
+func (l *infocardLinker) Link(own_key InfocardKey, info Infocard) Infocard {
+	linked := make(map[InfocardKey]bool)
+	result := make(Infocard, 0, len(info))
+
+	for _, line := range info {
+		new_line := InfocardLine{Justify: line.Justify}
+		for _, phrase := range line.Phrases {
+			if phrase.Link != nil {
+				new_line.Phrases = append(new_line.Phrases, phrase)
+				continue
+			}
+
+			text := phrase.Phrase
+			plain_start := 0
+			prev_is_word := false
+			for index := 0; index < len(text); {
+				r, size := utf8.DecodeRuneInString(text[index:])
+				if !prev_is_word && isWordRune(r) {
+					if length, key := l.match(text[index:]); length > 0 && key != "" && key != own_key && !linked[key] {
+						linked[key] = true
+						if plain_start < index {
+							plain := phrase
+							plain.Phrase = text[plain_start:index]
+							new_line.Phrases = append(new_line.Phrases, plain)
+						}
+						link := phrase
+						link.Phrase = text[index : index+length]
+						link.Link = ptr.Ptr(InfocardPath(key))
+						new_line.Phrases = append(new_line.Phrases, link)
+
+						index += length
+						plain_start = index
+						prev_is_word = true
+						continue
+					}
+				}
+				prev_is_word = isWordRune(r)
+				index += size
+			}
+
+			if plain_start == 0 {
+				new_line.Phrases = append(new_line.Phrases, phrase)
+			} else if plain_start < len(text) {
+				plain := phrase
+				plain.Phrase = text[plain_start:]
+				new_line.Phrases = append(new_line.Phrases, plain)
+			}
+		}
+		result = append(result, new_line)
+	}
+	return result
+}
+
+// exportSystemInfocards makes systems linkable from other infocards
+func (e *Exporter) exportSystemInfocards(linker *infocardLinker) {
+	for _, system := range e.Mapped.Universe.Systems {
+		nickname := system.Nickname.Get()
+		ids_info, ok := system.Ids_info.GetValue()
+		if !ok {
+			continue
+		}
+		if _, ok := e.Mapped.Infocards.Infocards[ids_info]; !ok {
+			continue
+		}
+		e.exportInfocards(InfocardKey(nickname), ids_info)
+		if strid_name, ok := system.StridName.GetValue(); ok {
+			linker.Add(e.GetInfocardName(strid_name, nickname), InfocardKey(nickname))
+		}
+	}
+}
+
+/*
+LinkInfocards auto links names of bases, systems and items, which are mentioned in infocards.
+It runs after all infocards are exported.
+*/
+func (e *Exporter) LinkInfocards() {
+	linker := newInfocardLinker()
+	e.exportSystemInfocards(linker)
+
+	add := func(name string, nickname string) {
+		key := InfocardKey(nickname)
+		if _, ok := e.Infocards[key]; !ok {
+			return
+		}
+		linker.Add(name, key)
+		linker.Add(nickname, key)
+	}
+
+	for _, base := range e.Bases {
+		add(base.Name, string(base.Nickname))
+	}
+	for _, item := range e.Commodities {
+		add(item.Name, string(item.Infocard))
+	}
+	for _, item := range e.Guns {
+		add(item.Name, item.Nickname)
+	}
+	for _, item := range e.Missiles {
+		add(item.Name, item.Nickname)
+	}
+	for _, item := range e.Mines {
+		add(item.Name, item.Nickname)
+	}
+	for _, item := range e.Shields {
+		add(item.Name, item.Nickname)
+	}
+	for _, item := range e.Thrusters {
+		add(item.Name, item.Nickname)
+	}
+	for _, item := range e.Ships {
+		add(item.Name, item.Nickname)
+	}
+	for _, item := range e.Tractors {
+		add(item.Name, string(item.Nickname))
+	}
+	for _, item := range e.Engines {
+		add(item.Name, item.Nickname)
+	}
+	for _, item := range e.CMs {
+		add(item.Name, item.Nickname)
+	}
+	for _, item := range e.Scanners {
+		add(item.Name, item.Nickname)
+	}
+	for _, item := range e.Ammos {
+		add(item.Name, item.Nickname)
+	}
+	for _, item := range e.Cloaks {
+		add(item.Name, item.Nickname)
+	}
+	linker.finalize()
+
+	for key, info := range e.Infocards {
+		e.Infocards[key] = linker.Link(key, info)
+	}
+}
@@ -0,0 +1,37 @@
+package configs_export
+
+import (
+	"testing"
+
+	"github.com/darklab8/go-utils/utils/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfocardLinker(t *testing.T) {
+	linker := newInfocardLinker()
+	linker.Add("Planet Manhattan", "li01_01_base")
+	linker.Add("Manhattan", "li01_01_base")
+	linker.Add("New York", "li01")
+	linker.Add("Liberty", "li_lf_grp")
+	linker.Add("Liberty", "li_n_grp") // ambiguous, not linked
+	linker.Add("Pit", "pit_base")     // too short
+	linker.finalize()
+
+	info := Infocard{
+		{Phrases: []InfocardPhrase{{Phrase: "Planet Manhattan in New York, Liberty.", Bold: true}}},
+		{Phrases: []InfocardPhrase{{Phrase: "Manhattanite visits Manhattan again"}}},
+	}
+
+	linked := linker.Link("li02_01_base", info)
+	assert.Equal(t, []InfocardPhrase{
+		{Phrase: "Planet Manhattan", Bold: true, Link: ptr.Ptr("infocards/info_li01_01_base")},
+		{Phrase: " in ", Bold: true},
+		{Phrase: "New York", Bold: true, Link: ptr.Ptr("infocards/info_li01")},
+		{Phrase: ", Liberty.", Bold: true},
+	}, linked[0].Phrases)
+	// only first mention is linked
+	assert.Equal(t, []InfocardPhrase{{Phrase: "Manhattanite visits Manhattan again"}}, linked[1].Phrases)
+
+	self_linked := linker.Link("li01", Infocard{{Phrases: []InfocardPhrase{{Phrase: "New York"}}}})
+	assert.Nil(t, self_linked[0].Phrases[0].Link)
+}
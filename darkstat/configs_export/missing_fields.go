@@ -0,0 +1,91 @@
+package configs_export
+
+import (
+	"reflect"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
+	"github.com/darklab8/fl-darkstat/configs/configs_settings/logus"
+	"github.com/darklab8/go-typelog/typelog"
+)
+
+// MissingFields lists values of mapped entity, which were expected in configs but not found
+type MissingFields struct {
+	Category string                `json:"category"  validate:"required"`
+	Nickname string                `json:"nickname"  validate:"required"`
+	Fields   []semantic.FieldError `json:"fields"  validate:"required"`
+}
+
+// all mapped entities have Nickname *semantic.String
+func mappedNickname(item any) string {
+	value := reflect.ValueOf(item)
+	if value.Kind() == reflect.Pointer {
+		value = value.Elem()
+	}
+	if nickname, ok := value.FieldByName("Nickname").Interface().(*semantic.String); ok && nickname != nil {
+		result, _ := nickname.GetValue()
+		return result
+	}
+	return ""
+}
+
+/*
+optional_fields are read by exporter with GetValue, which tolerates their absence.
+They are not reported as missing, even if not marked optional in mapping.
+*/
+var optional_fields = map[string][]string{
+	"guns": {"BurstAmmo", "BurstReload", "DispersionAngle", "FlashParticleName", "HPGunType", "IsAutoTurret", "Mass", "NumBarrels", "TurnRate", "Volume"},
+	"munitions": {"AmmoLimitAmountInCatridge", "AmmoLimitMaxCatridges", "ArmorPen", "ConstEffect", "ExplosionArch", "HitPts", "HullDamage",
+		"IdsInfo", "IdsName", "LifeTime", "Mass", "MaxAngularVelocity", "Motor", "MunitionHitEffect", "RequiredAmmo",
+		"SeekerFovDeg", "SeekerRange", "SeekerType", "Volume", "WeaponType"},
+	"mines": {"AmmoLimitAmountInCatridge", "AmmoLimitMaxCatridges"},
+	"shields": {"ConstPowerDraw", "HitPts", "HpType", "Lootable", "Mass", "MaxCapacity", "OfflineRebuildTime", "RebuildPowerDraw",
+		"RegenerationRate", "ShieldType", "Toughness"},
+	"thrusters":        {"Mass"},
+	"engines":          {"CruiseChargeTime", "CruiseSpeed", "FlameEffect", "HpType", "LinearDrag", "Mass", "MaxForce", "TrailEffect"},
+	"counter_measures": {"AmmoLimitAmountInCatridge", "AmmoLimitMaxCatridges", "IdsInfo"},
+	"scanners":         {"Mass"},
+	"tractors":         {"IdsInfo", "IdsName", "Mass"},
+	"cloaks":           {"CloakInTime", "CloakOutTime", "HitPts", "IdsInfo", "IdsName", "PowerUsage", "Volume"},
+	"ships": {"ArmorMult", "IdsInfo", "IdsInfo1", "IdsInfo2", "IdsInfo3", "IdsName", "LinearDrag", "Nanobots", "ShipClass",
+		"StrafeForce", "Type"},
+	"goods":   {"Price"},
+	"systems": {"NavMapScale", "StridName"},
+}
+
+func collectMissingFields[T any](category string, items []T) []MissingFields {
+	var result []MissingFields
+	for _, item := range items {
+		if fields := semantic.Validate(item, optional_fields[category]...); len(fields) > 0 {
+			result = append(result, MissingFields{Category: category, Nickname: mappedNickname(item), Fields: fields})
+		}
+	}
+	return result
+}
+
+/*
+GetMissingFields checks semantic values of mapped entities,
+so incomplete mod configs are visible instead of being silently exported as zero values.
+*/
+func (e *Exporter) GetMissingFields() []MissingFields {
+	var result []MissingFields
+	equip := e.Mapped.Equip()
+	result = append(result, collectMissingFields("guns", equip.Guns)...)
+	result = append(result, collectMissingFields("munitions", equip.Munitions)...)
+	result = append(result, collectMissingFields("mines", equip.Mines)...)
+	result = append(result, collectMissingFields("shields", equip.ShieldGens)...)
+	result = append(result, collectMissingFields("thrusters", equip.Thrusters)...)
+	result = append(result, collectMissingFields("engines", equip.Engines)...)
+	result = append(result, collectMissingFields("counter_measures", equip.CounterMeasure)...)
+	result = append(result, collectMissingFields("scanners", equip.Scanners)...)
+	result = append(result, collectMissingFields("tractors", equip.Tractors)...)
+	result = append(result, collectMissingFields("cloaks", equip.Cloaks)...)
+	result = append(result, collectMissingFields("ships", e.Mapped.Shiparch.Ships)...)
+	result = append(result, collectMissingFields("goods", e.Mapped.Goods.Goods)...)
+	result = append(result, collectMissingFields("bases", e.Mapped.Universe.Bases)...)
+	result = append(result, collectMissingFields("systems", e.Mapped.Universe.Systems)...)
+
+	if len(result) > 0 {
+		logus.Log.Warn("some entities miss fields in configs", typelog.Int("entities", len(result)))
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package configs_export
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/equipment_mapped/equip_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/iniload"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/semantic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingFields(t *testing.T) {
+	content := `
+[Gun]
+nickname = li_gun01_mark01
+ids_name = 263238
+ids_info = 264238
+hit_pts = 1000
+power_usage = 10
+refire_delay = 0.5
+muzzle_velocity = 750
+toughness = 1
+lootable = true
+projectile_archetype = li_gun01_mark01_ammo
+hp_gun_type = hp_gun_special_1
+
+[Gun]
+nickname = li_gun01_mark02
+hit_pts = 1000
+power_usage = 10
+refire_delay = 0.5
+toughness = 1
+lootable = true
+projectile_archetype = li_gun01_mark02_ammo
+`
+	memory_file := file.NewMemoryFile(strings.Split(content, "\n"))
+	equip := equip_mapped.Read([]*iniload.IniLoader{iniload.NewLoader(memory_file).Scan()})
+
+	// optional fields, like ids_name or hp_gun_type, are not reported
+	missing := collectMissingFields("guns", equip.Guns)
+	if assert.Len(t, missing, 1) {
+		assert.Equal(t, "guns", missing[0].Category)
+		assert.Equal(t, "li_gun01_mark02", missing[0].Nickname)
+		var fields []string
+		for _, field := range missing[0].Fields {
+			fields = append(fields, field.Field)
+			assert.Contains(t, field.Error, semantic.ErrMissingKey.Error())
+		}
+		assert.Equal(t, []string{"MuzzleVelosity"}, fields)
+	}
+}
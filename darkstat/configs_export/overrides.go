@@ -0,0 +1,104 @@
+package configs_export
+
+import (
+	"math"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/overrides"
+	"github.com/darklab8/go-utils/utils/ptr"
+)
+
+/*
+overridePrice applies manual prices of overrides.fl_configs.yml to good sold at base.
+If only sell price is overridden, buy price follows it in the same proportion as before,
+which for vanilla means staying equal to sell price.
+*/
+func (e *Exporter) overridePrice(good *MarketGood) {
+	price, ok := e.Mapped.Overrides.GetPrice(string(good.BaseNickname), good.Nickname)
+	if !ok {
+		return
+	}
+	if price.BaseSellsFor != nil {
+		if price.BaseBuysFor == nil && good.PriceBaseBuysFor != nil && good.PriceBaseSellsFor > 0 {
+			good.PriceBaseBuysFor = ptr.Ptr(int(math.Round(
+				float64(*good.PriceBaseBuysFor) * float64(*price.BaseSellsFor) / float64(good.PriceBaseSellsFor))))
+		}
+		good.PriceBaseSellsFor = *price.BaseSellsFor
+	}
+	if price.BaseBuysFor != nil {
+		good.PriceBaseBuysFor = ptr.Ptr(*price.BaseBuysFor)
+	}
+}
+
+type itemSoldAtBases interface {
+	GetNickname() string
+	GetBases() map[cfg.BaseUniNick]*MarketGood
+}
+
+func withoutHiddenItems[T itemSoldAtBases](items []T, o overrides.Overrides) []T {
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		if o.IsHiddenItem(item.GetNickname()) {
+			continue
+		}
+		bases := item.GetBases()
+		for base_nickname := range bases {
+			if o.IsHiddenBase(string(base_nickname)) {
+				delete(bases, base_nickname)
+			}
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func withoutHiddenBases(bases []*Base, o overrides.Overrides) []*Base {
+	result := make([]*Base, 0, len(bases))
+	for _, base := range bases {
+		if o.IsHiddenBase(string(base.Nickname)) {
+			continue
+		}
+		for key, good := range base.MarketGoodsPerNick {
+			if o.IsHiddenItem(good.Nickname) {
+				delete(base.MarketGoodsPerNick, key)
+			}
+		}
+		result = append(result, base)
+	}
+	return result
+}
+
+/*
+HideOverridden removes bases and items listed as hidden in overrides.fl_configs.yml from output.
+Hidden bases still take part in trade graph, so paths going through them stay the same.
+*/
+func (e *Exporter) HideOverridden() {
+	o := e.Mapped.Overrides
+	if len(o.HiddenBases) == 0 && len(o.HiddenItems) == 0 {
+		return
+	}
+
+	e.Bases = withoutHiddenBases(e.Bases, o)
+	e.MiningOperations = withoutHiddenBases(e.MiningOperations, o)
+	pobs := make([]*PoB, 0, len(e.PoBs))
+	for _, pob := range e.PoBs {
+		if !o.IsHiddenBase(pob.Nickname) {
+			pobs = append(pobs, pob)
+		}
+	}
+	e.PoBs = pobs
+
+	e.Commodities = withoutHiddenItems(e.Commodities, o)
+	e.Guns = withoutHiddenItems(e.Guns, o)
+	e.Missiles = withoutHiddenItems(e.Missiles, o)
+	e.Mines = withoutHiddenItems(e.Mines, o)
+	e.Shields = withoutHiddenItems(e.Shields, o)
+	e.Thrusters = withoutHiddenItems(e.Thrusters, o)
+	e.Ships = withoutHiddenItems(e.Ships, o)
+	e.Tractors = withoutHiddenItems(e.Tractors, o)
+	e.Cloaks = withoutHiddenItems(e.Cloaks, o)
+	e.Engines = withoutHiddenItems(e.Engines, o)
+	e.CMs = withoutHiddenItems(e.CMs, o)
+	e.Scanners = withoutHiddenItems(e.Scanners, o)
+	e.Ammos = withoutHiddenItems(e.Ammos, o)
+}
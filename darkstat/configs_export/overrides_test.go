@@ -0,0 +1,71 @@
+package configs_export
+
+import (
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
+	"github.com/darklab8/fl-darkstat/configs/overrides"
+	"github.com/darklab8/go-utils/utils/ptr"
+	"github.com/darklab8/go-utils/utils/utils_os"
+	"github.com/stretchr/testify/assert"
+)
+
+func fixtureOverridesExporter() *Exporter {
+	mapped := configs_mapped.NewMappedConfigs()
+	mapped.Overrides = overrides.Read(file.NewFile(utils_os.GetCurrrentTestFolder().Join(overrides.FILENAME)))
+	return &Exporter{Mapped: mapped, ExporterRelay: &ExporterRelay{Mapped: mapped}}
+}
+
+func TestOverridePrice(t *testing.T) {
+	e := fixtureOverridesExporter()
+
+	gold := &MarketGood{GoodInfo: GoodInfo{Nickname: "commodity_gold"}, BaseInfo: BaseInfo{BaseNickname: "li01_01_base"}, PriceBaseSellsFor: 500}
+	e.overridePrice(gold)
+	assert.Equal(t, 700, gold.PriceBaseSellsFor)
+	assert.Equal(t, 650, gold.GetPriceBaseBuysFor())
+
+	silver := &MarketGood{GoodInfo: GoodInfo{Nickname: "commodity_silver"}, BaseInfo: BaseInfo{BaseNickname: "li01_01_base"}, PriceBaseSellsFor: 300}
+	e.overridePrice(silver)
+	assert.Equal(t, 300, silver.PriceBaseSellsFor)
+	assert.Nil(t, silver.PriceBaseBuysFor)
+
+	// only sell price is overridden, buy price follows it
+	boron := &MarketGood{GoodInfo: GoodInfo{Nickname: "commodity_boron"}, BaseInfo: BaseInfo{BaseNickname: "li01_01_base"}, PriceBaseSellsFor: 200, PriceBaseBuysFor: ptr.Ptr(200)}
+	e.overridePrice(boron)
+	assert.Equal(t, 400, boron.PriceBaseSellsFor)
+	assert.Equal(t, 400, boron.GetPriceBaseBuysFor())
+
+	boron = &MarketGood{GoodInfo: GoodInfo{Nickname: "commodity_boron"}, BaseInfo: BaseInfo{BaseNickname: "li01_01_base"}, PriceBaseSellsFor: 200, PriceBaseBuysFor: ptr.Ptr(150)}
+	e.overridePrice(boron)
+	assert.Equal(t, 400, boron.PriceBaseSellsFor)
+	assert.Equal(t, 300, boron.GetPriceBaseBuysFor())
+}
+
+func TestHideOverridden(t *testing.T) {
+	e := fixtureOverridesExporter()
+	e.Bases = []*Base{
+		{Nickname: "li01_01_base", MarketGoodsPerNick: map[CommodityKey]*MarketGood{
+			GetCommodityKey("li_gun01_mark01", -1): {GoodInfo: GoodInfo{Nickname: "li_gun01_mark01"}},
+			GetCommodityKey("li_gun01_mark02", -1): {GoodInfo: GoodInfo{Nickname: "li_gun01_mark02"}},
+		}},
+		{Nickname: "li01_test_base"},
+	}
+	e.Guns = []Gun{
+		{Nickname: "li_gun01_mark01"},
+		{Nickname: "li_gun01_mark02", Bases: map[cfg.BaseUniNick]*MarketGood{
+			"li01_01_base":   {},
+			"li01_test_base": {},
+		}},
+	}
+
+	e.HideOverridden()
+
+	assert.Len(t, e.Bases, 1)
+	assert.Equal(t, cfg.BaseUniNick("li01_01_base"), e.Bases[0].Nickname)
+	assert.Len(t, e.Bases[0].MarketGoodsPerNick, 1)
+	assert.Len(t, e.Guns, 1)
+	assert.Equal(t, "li_gun01_mark02", e.Guns[0].Nickname)
+	assert.Len(t, e.Guns[0].Bases, 1)
+}
@@ -126,6 +126,9 @@ func (e *Exporter) PoBsToBases(pobs []*PoB) []*Base {
 		if pob.FactionName != nil {
 			base.FactionName = *pob.FactionName
 		}
+		if pob.FactionNick != nil {
+			base.FactionNickname = *pob.FactionNick
+		}
 		bases = append(bases, base)
 
 		for _, pob_good := range pob.ShopItems {
@@ -133,6 +136,8 @@ func (e *Exporter) PoBsToBases(pobs []*PoB) []*Base {
 				GoodInfo:             e.GetGoodInfo(pob_good.Nickname),
 				IsServerSideOverride: true,
 				ShipClass:            -1,
+				StockBaseSells:       ptr.Ptr(pob_good.StockBaseSells()),
+				StockBaseBuys:        ptr.Ptr(pob_good.StockBaseBuys()),
 			}
 			if pob_good.BaseBuys() {
 				market_good.PriceBaseBuysFor = ptr.Ptr(pob_good.SellPrice)
@@ -257,6 +262,28 @@ func NewHashesCategories(Mapped *configs_mapped.MappedConfigs) HashesByCat {
 	}
 }
 
+/*
+WithRefreshedPoBs returns copy of relay with player owned bases fetched anew.
+Current relay is left untouched, so it keeps being served while the copy is built.
+*/
+func (e *ExporterRelay) WithRefreshedPoBs() *ExporterRelay {
+	mapped := *e.Mapped
+	discovery := *mapped.Discovery
+	discovery.PlayerOwnedBases = discovery.PlayerOwnedBases.Reread()
+	mapped.Discovery = &discovery
+
+	relay := *e
+	relay.Mapped = &mapped
+	// PoB infocards are written into it
+	relay.Infocards = make(Infocards, len(e.Infocards))
+	for key, infocard := range e.Infocards {
+		relay.Infocards[key] = infocard
+	}
+	relay.PoBs = relay.GetPoBs()
+	relay.PoBGoods = relay.GetPoBGoods(relay.PoBs)
+	return &relay
+}
+
 func (e *ExporterRelay) GetPoBs() []*PoB {
 	var pobs []*PoB
 
@@ -0,0 +1,102 @@
+package configs_export
+
+import (
+	"errors"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+)
+
+var ErrDiscoveryIDNotFound = errors.New("discovery id is not found")
+
+/*
+RepProfile is pilot reputation per faction, entered manually or taken from Discovery ID rephacks.
+Factions missing in profile are treated as neutral.
+Nil profile is allowed to dock everywhere and buy everything.
+Profile is applied per request by trade loops, trade routes and graph paths APIs.
+Trade routes of bases and commodities are exported without it.
+*/
+type RepProfile struct {
+	Reps         map[cfg.FactionNick]float64
+	base_faction map[cfg.BaseUniNick]cfg.FactionNick
+}
+
+// getBaseFactions indexes owner factions of bases at export, to check docking without scanning bases per request
+func getBaseFactions(bases ...[]*Base) map[cfg.BaseUniNick]cfg.FactionNick {
+	result := make(map[cfg.BaseUniNick]cfg.FactionNick)
+	for _, bases := range bases {
+		for _, base := range bases {
+			if base.FactionNickname != "" {
+				result[base.Nickname] = cfg.FactionNick(base.FactionNickname)
+			}
+		}
+	}
+	return result
+}
+
+func (e *Exporter) newRepProfile(reps map[cfg.FactionNick]float64) *RepProfile {
+	return &RepProfile{Reps: reps, base_faction: e.base_factions}
+}
+
+// NewRepProfile makes profile from manually entered reputations
+func (e *Exporter) NewRepProfile(reps map[string]float64) *RepProfile {
+	result := make(map[cfg.FactionNick]float64, len(reps))
+	for faction_nick, rep := range reps {
+		result[cfg.FactionNick(faction_nick)] = rep
+	}
+	return e.newRepProfile(result)
+}
+
+// NewIDRepProfile makes profile from playercntl_rephacks of Discovery ID, including inherited and default ones
+func (e *Exporter) NewIDRepProfile(id cfg.TractorID) (*RepProfile, error) {
+	tractor, ok := e.TractorsByID[id]
+	if !ok {
+		return nil, ErrDiscoveryIDNotFound
+	}
+	reps := make(map[cfg.FactionNick]float64, len(tractor.Rephacks))
+	for faction_nick, rephack := range tractor.Rephacks {
+		reps[faction_nick] = rephack.Reputation
+	}
+	return e.newRepProfile(reps), nil
+}
+
+/*
+GetRepProfile picks profile from Discovery ID if it is given, or from manual reputations.
+Returns nil profile if neither is given.
+*/
+func (e *Exporter) GetRepProfile(discovery_id *string, reps map[string]float64) (*RepProfile, error) {
+	if discovery_id != nil && *discovery_id != "" {
+		return e.NewIDRepProfile(cfg.TractorID(*discovery_id))
+	}
+	if len(reps) > 0 {
+		return e.NewRepProfile(reps), nil
+	}
+	return nil, nil
+}
+
+func (r *RepProfile) GetRep(base_nickname cfg.BaseUniNick) float64 {
+	return r.Reps[r.base_faction[base_nickname]]
+}
+
+// CanDock is false for bases of factions hostile to pilot. Bases without owner faction, like ore fields, are always open
+func (r *RepProfile) CanDock(base_nickname cfg.BaseUniNick) bool {
+	if r == nil {
+		return true
+	}
+	if _, ok := r.base_faction[base_nickname]; !ok {
+		return true
+	}
+	return r.GetRep(base_nickname) > HostileReputation
+}
+
+// CanBuy checks if base sells good to pilot with its reputation
+func (r *RepProfile) CanBuy(good *MarketGood) bool {
+	if r == nil {
+		return true
+	}
+	return r.CanDock(good.BaseNickname) && r.GetRep(good.BaseNickname) >= good.RepRequired
+}
+
+// CanTrade checks if pilot can buy good at start of trade route and dock to sell it at the end
+func (r *RepProfile) CanTrade(t *TradeRoute) bool {
+	return r.CanBuy(t.BuyingGood) && r.CanDock(t.SellingGood.BaseNickname)
+}
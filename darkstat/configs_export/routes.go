@@ -1,8 +1,12 @@
 package configs_export
 
 import (
+	"math"
+	"strings"
+
 	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/universe_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/universe_mapped/systems_mapped"
 	"github.com/darklab8/fl-darkstat/darkstat/configs_export/trades"
 )
 
@@ -105,6 +109,156 @@ func (t *Route) GetPaths() []PathWithNavmap {
 	return results
 }
 
+type SegmentType string
+
+const (
+	SegmentCruise    SegmentType = "cruise"
+	SegmentTradelane SegmentType = "tradelane"
+	SegmentJumpGate  SegmentType = "jump_gate"
+	SegmentJumpHole  SegmentType = "jump_hole"
+	SegmentDock      SegmentType = "dock"
+)
+
+type RouteWaypoint struct {
+	Nickname       string       `json:"nickname" validate:"required"`
+	Name           string       `json:"name" validate:"required"`
+	SystemNickname string       `json:"system_nickname"`
+	SystemName     string       `json:"system_name"`
+	SectorCoord    string       `json:"sector_coord"`
+	Pos            cfg.Vector   `json:"pos"`
+	Segment        SegmentType  `json:"segment,omitempty"` // how waypoint is reached from previous one. Empty for starting point
+	Time           cfg.SecondsI `json:"time"`              // seconds spent on the segment
+}
+
+/*
+GetWaypoints returns turn by turn route, from starting object to destination base.
+Tradelane rings flown through in one go are merged into single tradelane segment.
+Returns nil if destination is not reachable.
+*/
+func (t *Route) GetWaypoints() []RouteWaypoint {
+	if t.is_disabled || t.g == nil {
+		return nil
+	}
+	paths := trades.GetVertexPath(t.g.Graph, t.g.Parents, t.from_base_nickname, t.to_base_nickname)
+	if paths == nil {
+		return nil
+	}
+
+	waypoints := []RouteWaypoint{t.getWaypoint(t.from_base_nickname)}
+	times := []cfg.Seconds{0}
+	for i, path := range paths {
+		prev := waypoints[len(waypoints)-1]
+		waypoint := t.getWaypoint(string(t.g.Graph.NicknameByIndex[path.NextNode]))
+		waypoint.Segment = t.getSegment(prev.Nickname, waypoint.Nickname)
+		time := t.g.Graph.GetTimeForDist(float64(path.Dist))
+
+		if i == len(paths)-1 {
+			waypoint.Segment = SegmentDock
+			time += float64(trades.BaseDockingDelay)
+		}
+
+		if waypoint.Segment == SegmentTradelane && prev.Segment == SegmentTradelane {
+			waypoints[len(waypoints)-1] = waypoint
+			times[len(times)-1] += time
+			continue
+		}
+		waypoints = append(waypoints, waypoint)
+		times = append(times, time)
+	}
+	for i := range waypoints {
+		waypoints[i].Time = cfg.SecondsI(math.Round(times[i]))
+	}
+	return waypoints
+}
+
+func (t *Route) getSegment(from string, to string) SegmentType {
+	systems := t.g.e.Mapped.Systems
+	if jumphole, ok := systems.JumpholesByNick[from]; ok && jumphole.GotoHole.Get() == to {
+		if strings.Contains(jumphole.Archetype.Get(), "gate") {
+			return SegmentJumpGate
+		}
+		return SegmentJumpHole
+	}
+
+	if ring, ok := systems.TradelanesByNick[from]; ok {
+		if isChainedRing(ring, to, true) || isChainedRing(ring, to, false) {
+			return SegmentTradelane
+		}
+	}
+	return SegmentCruise
+}
+
+// isChainedRing checks if nickname is one of rings further in the same tradelane
+func isChainedRing(ring *systems_mapped.TradeLaneRing, nickname string, forward bool) bool {
+	current := ring
+	for range ring.System.Tradelanes {
+		var next_nickname string
+		var ok bool
+		if forward {
+			next_nickname, ok = current.NextRing.GetValue()
+		} else {
+			next_nickname, ok = current.PrevRing.GetValue()
+		}
+		if !ok {
+			return false
+		}
+		if next_nickname == nickname {
+			return true
+		}
+		if current, ok = ring.System.TradelaneByNick[next_nickname]; !ok {
+			return false
+		}
+	}
+	return false
+}
+
+func (t *Route) getWaypoint(nickname string) RouteWaypoint {
+	waypoint := RouteWaypoint{
+		Nickname: nickname,
+		Name:     t.GetNameByIdsName(t.g.Graph.GetIdsName(nickname)),
+	}
+
+	var system *systems_mapped.System
+	systems := t.g.e.Mapped.Systems
+	if base, ok := systems.BasesByDockWith[nickname]; ok {
+		system, waypoint.Pos = base.System, base.Pos.Get()
+	} else if jumphole, ok := systems.JumpholesByNick[nickname]; ok {
+		system, waypoint.Pos = jumphole.System, jumphole.Pos.Get()
+	} else if ring, ok := systems.TradelanesByNick[nickname]; ok {
+		system, waypoint.Pos = ring.System, ring.Pos.Get()
+	}
+
+	if system != nil {
+		waypoint.SystemNickname = system.Nickname
+		if system_uni, ok := t.g.e.Mapped.Universe.SystemMap[universe_mapped.SystemNickname(system.Nickname)]; ok {
+			waypoint.SystemName = t.g.e.GetInfocardName(system_uni.StridName.Get(), system_uni.Nickname.Get())
+			waypoint.SectorCoord = VectorToSectorCoord(system_uni, waypoint.Pos)
+		}
+		return waypoint
+	}
+
+	// player owned bases are not part of system configs
+	for _, pob := range t.g.e.PoBs {
+		if pob.Nickname != nickname {
+			continue
+		}
+		waypoint.Name = pob.Name
+		if pob.SystemNick != nil {
+			waypoint.SystemNickname = *pob.SystemNick
+		}
+		if pob.SystemName != nil {
+			waypoint.SystemName = *pob.SystemName
+		}
+		if pob.SectorCoord != nil {
+			waypoint.SectorCoord = *pob.SectorCoord
+		}
+		if pob.BasePos != nil {
+			waypoint.Pos = *pob.BasePos
+		}
+	}
+	return waypoint
+}
+
 func (t *Route) GetNameByIdsName(ids_name int) string {
 	return string(t.g.e.Mapped.Infocards.Infonames[ids_name])
 }
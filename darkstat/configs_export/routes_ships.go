@@ -0,0 +1,135 @@
+package configs_export
+
+import (
+	"sync"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export/trades"
+	"github.com/darklab8/fl-darkstat/darkstat/settings/logus"
+	"github.com/darklab8/go-typelog/typelog"
+)
+
+// Ship types which dock only with capital docking spheres and can't use freighter only jump holes
+var capital_ship_types = map[string]bool{
+	"transport": true,
+	"gunboat":   true,
+	"cruiser":   true,
+	"capital":   true,
+	"mining":    true,
+}
+
+// ShipProfile is everything about ship what affects its travel time
+type ShipProfile struct {
+	CruiseSpeed        int
+	WithFreighterPaths trades.WithFreighterPaths
+}
+
+func (e *Exporter) GetShipProfile(ship Ship) ShipProfile {
+	profile := ShipProfile{
+		CruiseSpeed:        ship.CruiseSpeed,
+		WithFreighterPaths: trades.WithFreighterPaths(!capital_ship_types[ship.Type]),
+	}
+	if profile.CruiseSpeed <= 0 {
+		profile.CruiseSpeed = e.ship_speeds.AvgTransportCruiseSpeed
+	}
+	if e.Mapped.Discovery == nil {
+		// docking restrictions are applied only for Discovery
+		profile.WithFreighterPaths = true
+	}
+	return profile
+}
+
+func (e *Exporter) GetShipProfileByNickname(nickname string) (ShipProfile, bool) {
+	for _, ship := range e.Ships {
+		if ship.Nickname == nickname {
+			return e.GetShipProfile(ship), true
+		}
+	}
+	return ShipProfile{}, false
+}
+
+/*
+newGraphMapped keeps parts of mapped configs, which graphs for ship profiles are built of.
+Clean() frees them from mapped configs after export, while ship graphs are built later on request.
+*/
+func newGraphMapped(mapped *configs_mapped.MappedConfigs) *configs_mapped.MappedConfigs {
+	if mapped.Systems == nil {
+		return nil
+	}
+	systems := *mapped.Systems
+	return &configs_mapped.MappedConfigs{
+		Systems:      &systems,
+		InitialWorld: mapped.InitialWorld,
+		Solararch:    mapped.Solararch,
+		Discovery:    mapped.Discovery,
+		FLSR:         mapped.FLSR,
+		Overrides:    mapped.Overrides,
+	}
+}
+
+type shipGraph struct {
+	graph    *trades.GameGraph
+	dijkstra *trades.DijkstraAPSP
+}
+
+// shipGraphs caches graphs per ship profile. All pairs are not calculated for them, only paths from queried source.
+type shipGraphs struct {
+	mu     sync.Mutex
+	graphs map[ShipProfile]*shipGraph
+}
+
+func newShipGraphs() *shipGraphs {
+	return &shipGraphs{graphs: make(map[ShipProfile]*shipGraph)}
+}
+
+func (e *Exporter) getShipGraph(profile ShipProfile) *shipGraph {
+	if e.ship_graphs != nil {
+		e.ship_graphs.mu.Lock()
+		defer e.ship_graphs.mu.Unlock()
+		if cached, ok := e.ship_graphs.graphs[profile]; ok {
+			return cached
+		}
+	}
+
+	logus.Log.Info("mapping configs to graph for ship profile", typelog.Any("profile", profile))
+	mapped := e.graph_mapped
+	if mapped == nil {
+		mapped = e.Mapped
+	}
+	graph := trades.MapConfigsToFGraph(mapped, profile.CruiseSpeed, profile.WithFreighterPaths, e.graph_bases, e.graph_options)
+	result := &shipGraph{
+		graph:    graph,
+		dijkstra: trades.NewDijkstraApspFromGraph(graph),
+	}
+	graph.WipeMatrix()
+
+	if e.ship_graphs != nil {
+		e.ship_graphs.graphs[profile] = result
+	}
+	return result
+}
+
+/*
+GetShipGraph returns graph results for travelling as a ship with profile from source.
+Graphs of ship classes are reused if profile matches them, otherwise only paths from source are calculated.
+*/
+func (e *Exporter) GetShipGraph(profile ShipProfile, source string) *GraphResults {
+	for _, g := range []*GraphResults{e.Transport, e.Frigate, e.Freighter} {
+		if g != nil && int(g.Graph.AvgCruiseSpeed) == profile.CruiseSpeed && g.Graph.CanVisitFreightersOnlyJHs == profile.WithFreighterPaths {
+			return g
+		}
+	}
+
+	ship_graph := e.getShipGraph(profile)
+	vertices := len(ship_graph.graph.IndexByNick)
+	result := &GraphResults{
+		e:       e.ExporterRelay,
+		Graph:   ship_graph.graph,
+		Time:    make([][]trades.Intg, vertices),
+		Parents: make([][]trades.Parent, vertices),
+	}
+	if source_index, ok := ship_graph.graph.IndexByNick[trades.VertexName(source)]; ok {
+		result.Time[source_index], result.Parents[source_index] = ship_graph.dijkstra.ShortestPaths(source_index)
+	}
+	return result
+}
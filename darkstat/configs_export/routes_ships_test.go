@@ -0,0 +1,57 @@
+package configs_export
+
+import (
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export/trades"
+	"github.com/darklab8/go-utils/utils/ptr"
+	"github.com/darklab8/go-utils/utils/utils_os"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShipGraph(t *testing.T) {
+	mapped := configs_mapped.NewMappedConfigs().Read(utils_os.GetCurrrentTestFolder().Join("mod"))
+	e := NewExporter(mapped)
+	e.graph_options = trades.MappingOptions{TradeRoutesDetailedTradeLane: ptr.Ptr(false)}
+	e.Transport = NewGraphResults(e, trades.VanillaSpeeds.AvgTransportCruiseSpeed, trades.WithFreighterPaths(true), nil, e.graph_options)
+	e.Ships = []Ship{
+		{Nickname: "li_elite", Type: "fighter", CruiseSpeed: 700},
+		{Nickname: "li_freighter", Type: "freighter", CruiseSpeed: 350},
+	}
+
+	profile, ok := e.GetShipProfileByNickname("li_elite")
+	assert.True(t, ok)
+	assert.Equal(t, ShipProfile{CruiseSpeed: 700, WithFreighterPaths: true}, profile)
+	_, ok = e.GetShipProfileByNickname("not_existing_ship")
+	assert.False(t, ok)
+
+	elite := e.GetShipGraph(profile, "li01_01_base")
+	elite_time := trades.GetTimeMs2(elite.Graph, elite.Time, "li01_01_base", "li02_01_base")
+	transport_time := trades.GetTimeMs2(e.Transport.Graph, e.Transport.Time, "li01_01_base", "li02_01_base")
+	assert.Less(t, elite_time, transport_time)
+	assert.Equal(t, "li02_01_base", NewRoute(elite, "li01_01_base", "li02_01_base").GetWaypoints()[5].Nickname)
+
+	assert.Same(t, e.getShipGraph(profile), e.getShipGraph(profile), "graph is cached per profile")
+
+	freighter, _ := e.GetShipProfileByNickname("li_freighter")
+	assert.Same(t, e.Transport, e.GetShipGraph(freighter, "li01_01_base"), "graph of ship class is reused")
+}
+
+func TestShipGraphAfterClean(t *testing.T) {
+	mapped := configs_mapped.NewMappedConfigs().Read(utils_os.GetCurrrentTestFolder().Join("mod"))
+	e := NewExporter(mapped)
+	e.graph_options = trades.MappingOptions{TradeRoutesDetailedTradeLane: ptr.Ptr(false)}
+	e.Transport = NewGraphResults(e, trades.VanillaSpeeds.AvgTransportCruiseSpeed, trades.WithFreighterPaths(true), nil, e.graph_options)
+	e.Ships = []Ship{{Nickname: "li_elite", Type: "fighter", CruiseSpeed: 700}}
+
+	// web mode frees mapped configs after export, while ship graphs are built on request
+	mapped.Clean()
+
+	profile, _ := e.GetShipProfileByNickname("li_elite")
+	elite := e.GetShipGraph(profile, "li01_01_base")
+	assert.Less(t, trades.GetTimeMs2(elite.Graph, elite.Time, "li01_01_base", "li02_01_base"), trades.INFthreshold)
+	waypoint := NewRoute(elite, "li01_01_base", "li02_01_base").GetWaypoints()[5]
+	assert.Equal(t, "li02_01_base", waypoint.Nickname)
+	assert.Equal(t, "li02", waypoint.SystemNickname)
+}
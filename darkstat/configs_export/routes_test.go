@@ -0,0 +1,40 @@
+package configs_export
+
+import (
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export/trades"
+	"github.com/darklab8/go-utils/utils/ptr"
+	"github.com/darklab8/go-utils/utils/utils_os"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteWaypoints(t *testing.T) {
+	mapped := configs_mapped.NewMappedConfigs().Read(utils_os.GetCurrrentTestFolder().Join("mod"))
+	e := &Exporter{Mapped: mapped, ExporterRelay: &ExporterRelay{Mapped: mapped}}
+
+	for _, detailed_tradelane := range []bool{false, true} {
+		g := NewGraphResults(e, trades.VanillaSpeeds.AvgTransportCruiseSpeed, trades.WithFreighterPaths(false), nil,
+			trades.MappingOptions{TradeRoutesDetailedTradeLane: ptr.Ptr(detailed_tradelane)})
+
+		waypoints := NewRoute(g, "li01_01_base", "li02_01_base").GetWaypoints()
+
+		var nicknames []string
+		var segments []SegmentType
+		for _, waypoint := range waypoints {
+			nicknames = append(nicknames, waypoint.Nickname)
+			segments = append(segments, waypoint.Segment)
+		}
+		assert.Equal(t, []string{"li01_01_base", "li01_trade_lane_ring_1", "li01_trade_lane_ring_3", "li01_to_li02", "li02_to_li01", "li02_01_base"}, nicknames)
+		assert.Equal(t, []SegmentType{"", SegmentCruise, SegmentTradelane, SegmentCruise, SegmentJumpGate, SegmentDock}, segments)
+
+		assert.Equal(t, "li02", waypoints[len(waypoints)-1].SystemNickname)
+		assert.Equal(t, 3500.0, waypoints[len(waypoints)-1].Pos.Z)
+		// 3500 distance at 350 speed, with delays of leaving jump gate and docking at base
+		assert.Equal(t, 10+trades.JumpHoleDelaySec+trades.BaseDockingDelay, waypoints[len(waypoints)-1].Time)
+		assert.Equal(t, 0, waypoints[4].Time)
+
+		assert.Nil(t, NewRoute(g, "li01_01_base", "not_existing_base").GetWaypoints())
+	}
+}
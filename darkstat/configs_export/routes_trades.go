@@ -1,6 +1,13 @@
 package configs_export
 
-import "github.com/darklab8/fl-darkstat/configs/cfg"
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export/trades"
+)
 
 type TradeRoute struct {
 	Route       *Route
@@ -40,6 +47,93 @@ func (t *TradeRoute) GetProffitPerTime() float64 {
 	return t.GetProffitPerV() / t.Route.GetTimeS()
 }
 
+// TradeCapacity is what pilot brings into a trade run
+type TradeCapacity struct {
+	HoldSize  int           `json:"hold_size"`
+	Credits   int           `json:"credits"`
+	ShipClass cfg.ShipClass `json:"ship_class"` // Discovery specific. Commodity volumes depend on it. -1 if not known
+}
+
+type TradeLimit string
+
+const (
+	TradeLimitHold    TradeLimit = "hold"
+	TradeLimitCredits TradeLimit = "credits"
+	TradeLimitStock   TradeLimit = "stock" // PoB has not enough goods to sell or space to buy them
+)
+
+// TradeRun is single trip of route with real amount of cargo
+type TradeRun struct {
+	Units         int        `json:"units"`
+	Volume        float64    `json:"volume"` // of one unit for ship class
+	Profit        int        `json:"profit"`
+	ProfitPerHour float64    `json:"profit_per_hour"`
+	LimitedBy     TradeLimit `json:"limited_by"`
+}
+
+// getCommodityVolumes indexes volumes of commodities per ship class at export, as mapped equipment is freed after it
+func getCommodityVolumes(commodities []*Commodity) map[string]map[cfg.ShipClass]float64 {
+	volumes := make(map[string]map[cfg.ShipClass]float64)
+	for _, commodity := range commodities {
+		if _, ok := volumes[commodity.Nickname]; !ok {
+			volumes[commodity.Nickname] = make(map[cfg.ShipClass]float64)
+		}
+		volumes[commodity.Nickname][commodity.ShipClass] = commodity.Volume
+	}
+	return volumes
+}
+
+/*
+GetCommodityVolume returns volume of commodity unit for ship class.
+Discovery has different volumes per ship class, with -1 being default one.
+*/
+func (e *Exporter) GetCommodityVolume(nickname string, ship_class cfg.ShipClass) (float64, bool) {
+	volumes, ok := e.commodity_volumes[nickname]
+	if !ok {
+		return 0, false
+	}
+	if volume, ok := volumes[ship_class]; ok {
+		return volume, true
+	}
+	volume, ok := volumes[-1]
+	return volume, ok
+}
+
+/*
+GetTradeRun evaluates route for how much cargo fits into hold and how much pilot can afford,
+instead of per unit of volume profits.
+Stock of PoBs limits units too, from both buying and selling side.
+*/
+func (e *Exporter) GetTradeRun(t *TradeRoute, capacity TradeCapacity) TradeRun {
+	var run TradeRun
+	if t.Route.is_disabled || t.BuyingGood.PriceBaseSellsFor <= 0 || t.Route.GetTimeMs() >= trades.INFthreshold {
+		return run
+	}
+
+	run.Volume = t.Commodity.Volume
+	if volume, ok := e.GetCommodityVolume(t.Commodity.Nickname, capacity.ShipClass); ok {
+		run.Volume = volume
+	}
+	if run.Volume <= 0 {
+		return run
+	}
+
+	run.Units, run.LimitedBy = int(math.Floor(float64(capacity.HoldSize)/run.Volume)), TradeLimitHold
+	if affordable := capacity.Credits / t.BuyingGood.PriceBaseSellsFor; affordable < run.Units {
+		run.Units, run.LimitedBy = affordable, TradeLimitCredits
+	}
+	if stock := t.BuyingGood.StockBaseSells; stock != nil && *stock < run.Units {
+		run.Units, run.LimitedBy = *stock, TradeLimitStock
+	}
+	if stock := t.SellingGood.StockBaseBuys; stock != nil && *stock < run.Units {
+		run.Units, run.LimitedBy = *stock, TradeLimitStock
+	}
+
+	run.Profit = run.Units * (t.SellingGood.GetPriceBaseBuysFor() - t.BuyingGood.PriceBaseSellsFor)
+	run.ProfitPerHour = float64(run.Profit) / t.Route.GetTimeS() * 3600
+	return run
+}
+
 type baseAllTradeRoutes struct {
 	TradeRoutes        []*ComboTradeRoute
 	BestTransportRoute *TradeRoute
@@ -160,3 +254,119 @@ func (e *Exporter) TradePaths(
 
 	return bases, commodities
 }
+
+var (
+	ErrTradeRoutesNoQuery    = errors.New("base or commodity is required")
+	ErrTradeRoutesNoCapacity = errors.New("hold size and credits are required")
+)
+
+type TradeRoutesInput struct {
+	From      *string       `json:"from,omitempty" example:"li01_01_base"`        // base to buy cargo at
+	Commodity *string       `json:"commodity,omitempty" example:"commodity_gold"` // commodity nickname to trade
+	RouteType string        `json:"route_type" example:"transport"`               // transport, frigate or freighter. Default is transport
+	Capacity  TradeCapacity `json:"capacity" validate:"required"`
+	Limit     int           `json:"limit" example:"20"` // amount of best routes to return. Default is 20
+
+	// Optional reputation profile. Routes from or to hostile bases and goods with unmet required reputation are skipped
+	DiscoveryID *string            `json:"discovery_id,omitempty" example:"dsy_license_srp_28"` // Discovery ID nickname, to use its rephacks
+	Reputations map[string]float64 `json:"reputations,omitempty"`                               // manual reputation per faction nickname, used if discovery id is not set
+}
+
+// TradeRouteRun is trade route with real amount of cargo pilot can carry through it
+type TradeRouteRun struct {
+	CommodityNickname string          `json:"commodity_nickname" validate:"required"`
+	CommodityName     string          `json:"commodity_name" validate:"required"`
+	FromBaseNickname  cfg.BaseUniNick `json:"from_base_nickname" validate:"required"`
+	FromBaseName      string          `json:"from_base_name" validate:"required"`
+	ToBaseNickname    cfg.BaseUniNick `json:"to_base_nickname" validate:"required"`
+	ToBaseName        string          `json:"to_base_name" validate:"required"`
+	BuyPrice          int             `json:"buy_price" validate:"required"`
+	SellPrice         int             `json:"sell_price" validate:"required"`
+	Time              cfg.SecondsI    `json:"time" validate:"required"` // seconds to fly from base to base
+	ProfitPerVolume   float64         `json:"profit_per_volume" validate:"required"`
+	Run               TradeRun        `json:"run" validate:"required"`
+}
+
+/*
+GetTradeRoutes returns trade routes from base or of commodity, evaluated for cargo hold and credits of pilot.
+Routes are filtered by reputation profile if it is given, and ranked by profit per hour.
+*/
+func (e *Exporter) GetTradeRoutes(input TradeRoutesInput) ([]TradeRouteRun, error) {
+	if input.From == nil && input.Commodity == nil {
+		return nil, ErrTradeRoutesNoQuery
+	}
+	if input.Capacity.HoldSize <= 0 || input.Capacity.Credits <= 0 {
+		return nil, ErrTradeRoutesNoCapacity
+	}
+	if input.Limit <= 0 {
+		input.Limit = 20
+	}
+	rep, err := e.GetRepProfile(input.DiscoveryID, input.Reputations)
+	if err != nil {
+		return nil, err
+	}
+
+	// commodities are duplicated per Discovery ship class. Picking one matching capacity, or default one
+	commodities := make(map[string]*Commodity)
+	for _, commodity := range e.Commodities {
+		if input.Commodity != nil && commodity.Nickname != *input.Commodity {
+			continue
+		}
+		if picked, ok := commodities[commodity.Nickname]; ok && picked.ShipClass == input.Capacity.ShipClass {
+			continue
+		}
+		if commodity.ShipClass == input.Capacity.ShipClass || commodity.ShipClass == -1 {
+			commodities[commodity.Nickname] = commodity
+		}
+	}
+
+	var routes []TradeRouteRun
+	for _, commodity := range commodities {
+		for _, combo_route := range commodity.TradeRoutes {
+			var route *TradeRoute
+			switch input.RouteType {
+			case "frigate":
+				route = combo_route.Frigate
+			case "freighter":
+				route = combo_route.Freighter
+			default:
+				route = combo_route.Transport
+			}
+			if route.Route.is_disabled {
+				continue
+			}
+			if input.From != nil && string(route.BuyingGood.BaseNickname) != *input.From {
+				continue
+			}
+			if !rep.CanTrade(route) {
+				continue
+			}
+
+			run := e.GetTradeRun(route, input.Capacity)
+			if run.Units <= 0 {
+				continue
+			}
+			routes = append(routes, TradeRouteRun{
+				CommodityNickname: commodity.Nickname,
+				CommodityName:     commodity.Name,
+				FromBaseNickname:  route.BuyingGood.BaseNickname,
+				FromBaseName:      route.BuyingGood.BaseName,
+				ToBaseNickname:    route.SellingGood.BaseNickname,
+				ToBaseName:        route.SellingGood.BaseName,
+				BuyPrice:          route.BuyingGood.PriceBaseSellsFor,
+				SellPrice:         route.SellingGood.GetPriceBaseBuysFor(),
+				Time:              cfg.SecondsI(math.Round(route.Route.GetTimeS())),
+				ProfitPerVolume:   route.GetProffitPerV(),
+				Run:               run,
+			})
+		}
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Run.ProfitPerHour > routes[j].Run.ProfitPerHour
+	})
+	if len(routes) > input.Limit {
+		routes = routes[:input.Limit]
+	}
+	return routes, nil
+}
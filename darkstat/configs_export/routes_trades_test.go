@@ -5,9 +5,12 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/darklab8/fl-darkstat/configs/cfg"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
 	"github.com/darklab8/fl-darkstat/darkstat/configs_export/trades"
 	"github.com/darklab8/go-utils/utils/ptr"
+	"github.com/darklab8/go-utils/utils/utils_os"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGetTrades(t *testing.T) {
@@ -63,3 +66,112 @@ func TestGetTrades(t *testing.T) {
 
 	fmt.Println()
 }
+
+func TestTradeRun(t *testing.T) {
+	mapped := configs_mapped.NewMappedConfigs().Read(utils_os.GetCurrrentTestFolder().Join("mod"))
+	e := NewExporter(mapped)
+	g := NewGraphResults(e, trades.VanillaSpeeds.AvgTransportCruiseSpeed, trades.WithFreighterPaths(true), nil,
+		trades.MappingOptions{TradeRoutesDetailedTradeLane: ptr.Ptr(false)})
+
+	commodity := &Commodity{Nickname: "commodity_gold", Volume: 2, ShipClass: -1}
+	buying := newTestTradeGood("commodity_gold", "li01_01_base", 10, 8)
+	selling := newTestTradeGood("commodity_gold", "li02_01_base", 0, 30)
+	route := NewTradeRoute(g, buying, selling, commodity)
+
+	run := e.GetTradeRun(route, TradeCapacity{HoldSize: 100, Credits: 10000, ShipClass: -1})
+	assert.Equal(t, TradeRun{Units: 50, Volume: 2, Profit: 1000, ProfitPerHour: 1000 / route.Route.GetTimeS() * 3600, LimitedBy: TradeLimitHold}, run)
+
+	run = e.GetTradeRun(route, TradeCapacity{HoldSize: 100, Credits: 205, ShipClass: -1})
+	assert.Equal(t, 20, run.Units)
+	assert.Equal(t, TradeLimitCredits, run.LimitedBy)
+
+	// destination is PoB, which can take only few more units before max stock
+	selling.StockBaseBuys = ptr.Ptr(7)
+	run = e.GetTradeRun(route, TradeCapacity{HoldSize: 100, Credits: 10000, ShipClass: -1})
+	assert.Equal(t, 7, run.Units)
+	assert.Equal(t, 140, run.Profit)
+	assert.Equal(t, TradeLimitStock, run.LimitedBy)
+
+	// Discovery volume of commodity for ship class
+	selling.StockBaseBuys = nil
+	e.commodity_volumes = getCommodityVolumes([]*Commodity{commodity, {Nickname: "commodity_gold", Volume: 5, ShipClass: 2}})
+	run = e.GetTradeRun(route, TradeCapacity{HoldSize: 100, Credits: 10000, ShipClass: 2})
+	assert.Equal(t, 20, run.Units)
+	assert.Equal(t, 5.0, run.Volume)
+	run = e.GetTradeRun(route, TradeCapacity{HoldSize: 100, Credits: 10000, ShipClass: 3})
+	assert.Equal(t, 50, run.Units)
+
+	unreachable := NewTradeRoute(g, buying, newTestTradeGood("commodity_gold", "not_existing_base", 0, 30), commodity)
+	assert.Equal(t, 0, e.GetTradeRun(unreachable, TradeCapacity{HoldSize: 100, Credits: 10000, ShipClass: -1}).Units)
+}
+
+func TestGetTradeRoutes(t *testing.T) {
+	mapped := configs_mapped.NewMappedConfigs().Read(utils_os.GetCurrrentTestFolder().Join("mod"))
+	e := NewExporter(mapped)
+	g := NewGraphResults(e, trades.VanillaSpeeds.AvgTransportCruiseSpeed, trades.WithFreighterPaths(true), nil,
+		trades.MappingOptions{TradeRoutesDetailedTradeLane: ptr.Ptr(false)})
+
+	gold := &Commodity{Nickname: "commodity_gold", Name: "Gold", Volume: 2, ShipClass: -1}
+	gold_pob := newTestTradeGood("commodity_gold", "li02_01_base", 0, 30)
+	silver := &Commodity{Nickname: "commodity_silver", Name: "Silver", Volume: 1, ShipClass: -1}
+	for _, trade := range []struct {
+		commodity *Commodity
+		buying    *MarketGood
+		selling   *MarketGood
+	}{
+		{gold, newTestTradeGood("commodity_gold", "li01_01_base", 10, 8), gold_pob},
+		{silver, newTestTradeGood("commodity_silver", "li01_01_base", 5, 4), newTestTradeGood("commodity_silver", "li02_01_base", 0, 6)},
+	} {
+		route := NewTradeRoute(g, trade.buying, trade.selling, trade.commodity)
+		trade.commodity.TradeRoutes = append(trade.commodity.TradeRoutes, &ComboTradeRoute{Transport: route, Frigate: route, Freighter: route})
+	}
+	e.Commodities = []*Commodity{gold, silver}
+	capacity := TradeCapacity{HoldSize: 100, Credits: 10000, ShipClass: -1}
+
+	_, err := e.GetTradeRoutes(TradeRoutesInput{Capacity: capacity})
+	assert.ErrorIs(t, err, ErrTradeRoutesNoQuery)
+	_, err = e.GetTradeRoutes(TradeRoutesInput{From: ptr.Ptr("li01_01_base")})
+	assert.ErrorIs(t, err, ErrTradeRoutesNoCapacity)
+
+	routes, err := e.GetTradeRoutes(TradeRoutesInput{From: ptr.Ptr("li01_01_base"), Capacity: capacity})
+	assert.NoError(t, err)
+	if assert.Len(t, routes, 2) {
+		assert.Equal(t, "commodity_gold", routes[0].CommodityNickname)
+		assert.Equal(t, TradeRun{Units: 50, Volume: 2, Profit: 1000, ProfitPerHour: 1000 / NewRoute(g, "li01_01_base", "li02_01_base").GetTimeS() * 3600, LimitedBy: TradeLimitHold}, routes[0].Run)
+		assert.Equal(t, 100, routes[1].Run.Units)
+		assert.Equal(t, 100, routes[1].Run.Profit)
+	}
+
+	// selling PoB has space only for few units, so gold is limited by its stock and becomes worse than silver
+	gold_pob.StockBaseBuys = ptr.Ptr(3)
+	routes, err = e.GetTradeRoutes(TradeRoutesInput{From: ptr.Ptr("li01_01_base"), Capacity: capacity})
+	assert.NoError(t, err)
+	if assert.Len(t, routes, 2) {
+		assert.Equal(t, "commodity_silver", routes[0].CommodityNickname)
+		assert.Equal(t, "commodity_gold", routes[1].CommodityNickname)
+		assert.Equal(t, 3, routes[1].Run.Units)
+		assert.Equal(t, 60, routes[1].Run.Profit)
+		assert.Equal(t, TradeLimitStock, routes[1].Run.LimitedBy)
+	}
+
+	routes, err = e.GetTradeRoutes(TradeRoutesInput{Commodity: ptr.Ptr("commodity_gold"), Capacity: capacity})
+	assert.NoError(t, err)
+	assert.Len(t, routes, 1)
+
+	// only silver is sold to pilot with low reputation, and hostile destination closes both routes
+	e.base_factions = map[cfg.BaseUniNick]cfg.FactionNick{"li01_01_base": "li_p_grp", "li02_01_base": "li_n_grp"}
+	gold.TradeRoutes[0].Transport.BuyingGood.RepRequired = 0.5
+	routes, err = e.GetTradeRoutes(TradeRoutesInput{From: ptr.Ptr("li01_01_base"), Capacity: capacity,
+		Reputations: map[string]float64{"li_p_grp": 0.2}})
+	assert.NoError(t, err)
+	if assert.Len(t, routes, 1) {
+		assert.Equal(t, "commodity_silver", routes[0].CommodityNickname)
+	}
+	routes, err = e.GetTradeRoutes(TradeRoutesInput{From: ptr.Ptr("li01_01_base"), Capacity: capacity,
+		Reputations: map[string]float64{"li_p_grp": 0.6, "li_n_grp": -0.9}})
+	assert.NoError(t, err)
+	assert.Empty(t, routes)
+
+	_, err = e.GetTradeRoutes(TradeRoutesInput{From: ptr.Ptr("li01_01_base"), Capacity: capacity, DiscoveryID: ptr.Ptr("not_existing_id")})
+	assert.ErrorIs(t, err, ErrDiscoveryIDNotFound)
+}
@@ -0,0 +1,297 @@
+package configs_export
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/initialworld/flhash"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/universe_mapped"
+	"github.com/darklab8/fl-darkstat/configs/filesave"
+)
+
+// Player with reputation at or below it is shot on sight and is denied docking
+const HostileReputation = -0.6
+
+type SaveGameItem struct {
+	Hash     string `json:"hash"  validate:"required"`
+	Nickname string `json:"nickname"  validate:"required"` // empty if hash is not found in configs
+	Name     string `json:"name"  validate:"required"`
+	Category string `json:"category"  validate:"required"`
+}
+
+type SaveGameEquipment struct {
+	SaveGameItem
+	Hardpoint string  `json:"hardpoint"  validate:"required"`
+	Health    float64 `json:"health"  validate:"required"`
+}
+
+type SaveGameCargo struct {
+	SaveGameItem
+	Quantity int     `json:"quantity"  validate:"required"`
+	Health   float64 `json:"health"  validate:"required"`
+}
+
+type SaveGameReputation struct {
+	FactionNickname string  `json:"faction_nickname"  validate:"required"`
+	FactionName     string  `json:"faction_name"  validate:"required"`
+	Rep             float64 `json:"rep"  validate:"required"`
+}
+
+type SaveGameGood struct {
+	Nickname      string  `json:"nickname"  validate:"required"`
+	Name          string  `json:"name"  validate:"required"`
+	Category      string  `json:"category"  validate:"required"`
+	Price         int     `json:"price"  validate:"required"`
+	RepRequired   float64 `json:"rep_required"  validate:"required"`
+	LevelRequired int     `json:"level_required"  validate:"required"`
+}
+
+type SaveGameBase struct {
+	Nickname        cfg.BaseUniNick `json:"nickname"  validate:"required"`
+	Name            string          `json:"name"  validate:"required"`
+	System          string          `json:"system_name"  validate:"required"`
+	FactionNickname string          `json:"faction_nickname"  validate:"required"`
+	FactionName     string          `json:"faction_name"  validate:"required"`
+	Rep             float64         `json:"rep"  validate:"required"`
+	CanDock         bool            `json:"can_dock"  validate:"required"`
+	BuyableGoods    []SaveGameGood  `json:"buyable_goods"  validate:"required"`
+	LockedGoods     int             `json:"locked_goods"  validate:"required"` // sold at base, but not to player with such rep or rank
+}
+
+type SaveGame struct {
+	Name        string  `json:"name"  validate:"required"`
+	Description string  `json:"description"  validate:"required"`
+	Rank        int     `json:"rank"  validate:"required"`
+	Money       int     `json:"money"  validate:"required"`
+	TimePlayed  float64 `json:"time_played_secs"  validate:"required"`
+
+	SystemNickname  string `json:"system_nickname"  validate:"required"`
+	SystemName      string `json:"system_name"  validate:"required"`
+	BaseNickname    string `json:"base_nickname"  validate:"required"` // empty if player is in space
+	BaseName        string `json:"base_name"  validate:"required"`
+	SectorCoord     string `json:"sector_coord"  validate:"required"`
+	ReputationGroup string `json:"reputation_group"  validate:"required"`
+
+	Ship           SaveGameItem         `json:"ship"  validate:"required"`
+	Equipment      []SaveGameEquipment  `json:"equipment"  validate:"required"`
+	Cargo          []SaveGameCargo      `json:"cargo"  validate:"required"`
+	Reputations    []SaveGameReputation `json:"reputations"  validate:"required"`
+	VisitedSystems []SaveGameItem       `json:"visited_systems"  validate:"required"`
+	VisitedBases   []SaveGameItem       `json:"visited_bases"  validate:"required"`
+	Bases          []SaveGameBase       `json:"bases"  validate:"required"`
+}
+
+type savegameNames struct {
+	by_nickname map[string]SaveGameItem
+}
+
+func (n *savegameNames) add(nickname string, name string, category string) {
+	nickname = strings.ToLower(nickname)
+	if _, ok := n.by_nickname[nickname]; ok {
+		return
+	}
+	n.by_nickname[nickname] = SaveGameItem{Nickname: nickname, Name: name, Category: category}
+}
+
+func (e *Exporter) savegameNames() *savegameNames {
+	names := &savegameNames{by_nickname: make(map[string]SaveGameItem)}
+	for _, item := range e.Ships {
+		names.add(item.Nickname, item.Name, "ship")
+	}
+	for _, item := range e.Guns {
+		names.add(item.Nickname, item.Name, "gun")
+	}
+	for _, item := range e.Missiles {
+		names.add(item.Nickname, item.Name, "missile")
+	}
+	for _, item := range e.Mines {
+		names.add(item.Nickname, item.Name, "mine")
+	}
+	for _, item := range e.Shields {
+		names.add(item.Nickname, item.Name, "shield")
+	}
+	for _, item := range e.Thrusters {
+		names.add(item.Nickname, item.Name, "thruster")
+	}
+	for _, item := range e.Engines {
+		names.add(item.Nickname, item.Name, "engine")
+	}
+	for _, item := range e.CMs {
+		names.add(item.Nickname, item.Name, "countermeasure")
+	}
+	for _, item := range e.Scanners {
+		names.add(item.Nickname, item.Name, "scanner")
+	}
+	for _, item := range e.Ammos {
+		names.add(item.Nickname, item.Name, "ammo")
+	}
+	for _, item := range e.Cloaks {
+		names.add(item.Nickname, item.Name, "cloak")
+	}
+	for _, item := range e.Tractors {
+		names.add(string(item.Nickname), item.Name, "tractor")
+	}
+	for _, item := range e.Commodities {
+		names.add(item.Nickname, item.Name, "commodity")
+	}
+	for _, base := range e.Bases {
+		names.add(string(base.Nickname), base.Name, "base")
+	}
+	for _, system := range e.Mapped.Universe.Systems {
+		names.add(system.Nickname.Get(), e.GetInfocardName(system.StridName.Get(), system.Nickname.Get()), "system")
+	}
+	return names
+}
+
+// resolve finds nickname of hash, and its name if it is something exported
+func (n *savegameNames) resolve(e *Exporter, hash flhash.HashCode) SaveGameItem {
+	item := SaveGameItem{Hash: hash.ToUintStr()}
+	if e.Mapped.Hashes == nil {
+		return item
+	}
+	entries, ok := e.Mapped.Hashes.Resolve(hash)
+	if !ok {
+		return item
+	}
+	for _, entry := range entries {
+		if entry.Kind != flhash.HashKindNickname {
+			continue
+		}
+		if named, ok := n.by_nickname[strings.ToLower(entry.Nickname)]; ok {
+			named.Hash = item.Hash
+			return named
+		}
+		if item.Nickname == "" {
+			item.Nickname = entry.Nickname
+			item.Category = strings.Trim(entry.Section, "[]")
+		}
+	}
+	return item
+}
+
+func (n *savegameNames) name(nickname string) string {
+	if named, ok := n.by_nickname[strings.ToLower(nickname)]; ok {
+		return named.Name
+	}
+	return ""
+}
+
+/*
+ReadSaveGame resolves hashes of player save against game data,
+and finds out where player can dock and what to buy with its reputation and rank.
+Factions missing in save are treated as neutral.
+*/
+func (e *Exporter) ReadSaveGame(player *filesave.Player) *SaveGame {
+	names := e.savegameNames()
+
+	save := &SaveGame{
+		Name:            player.Name,
+		Description:     player.Description,
+		Rank:            player.Rank,
+		Money:           player.Money,
+		TimePlayed:      player.TimePlayed.Seconds(),
+		SystemNickname:  player.System,
+		SystemName:      names.name(player.System),
+		BaseNickname:    player.Base,
+		BaseName:        names.name(player.Base),
+		ReputationGroup: player.ReputationGroup,
+		Ship:            names.resolve(e, player.Ship),
+		Equipment:       make([]SaveGameEquipment, 0, len(player.Equipment)),
+		Cargo:           make([]SaveGameCargo, 0, len(player.Cargo)),
+		Reputations:     make([]SaveGameReputation, 0, len(player.Reputations)),
+		VisitedSystems:  make([]SaveGameItem, 0, len(player.SystemsVisited)),
+		VisitedBases:    make([]SaveGameItem, 0, len(player.BasesVisited)),
+		Bases:           make([]SaveGameBase, 0),
+	}
+	if player.Position != nil {
+		if system, ok := e.Mapped.Universe.SystemMap[universe_mapped.SystemNickname(player.System)]; ok {
+			save.SectorCoord = VectorToSectorCoord(system, *player.Position)
+		}
+	}
+
+	for _, equip := range player.Equipment {
+		save.Equipment = append(save.Equipment, SaveGameEquipment{
+			SaveGameItem: names.resolve(e, equip.ID),
+			Hardpoint:    equip.Hardpoint,
+			Health:       equip.Health,
+		})
+	}
+	for _, cargo := range player.Cargo {
+		save.Cargo = append(save.Cargo, SaveGameCargo{
+			SaveGameItem: names.resolve(e, cargo.ID),
+			Quantity:     cargo.Quantity,
+			Health:       cargo.Health,
+		})
+	}
+	for _, system := range player.SystemsVisited {
+		save.VisitedSystems = append(save.VisitedSystems, names.resolve(e, system))
+	}
+	for _, base := range player.BasesVisited {
+		save.VisitedBases = append(save.VisitedBases, names.resolve(e, base))
+	}
+
+	faction_names := make(map[string]string)
+	for _, faction := range e.Factions {
+		faction_names[faction.Nickname] = faction.Name
+	}
+	for nickname, rep := range player.Reputations {
+		save.Reputations = append(save.Reputations, SaveGameReputation{
+			FactionNickname: nickname,
+			FactionName:     faction_names[nickname],
+			Rep:             rep,
+		})
+	}
+	sort.Slice(save.Reputations, func(i, j int) bool {
+		return save.Reputations[i].FactionNickname < save.Reputations[j].FactionNickname
+	})
+
+	for _, base := range e.Bases {
+		if base.IsPob || !e.useful_bases_by_nick[base.Nickname] {
+			continue
+		}
+		save.Bases = append(save.Bases, savegameBase(base, player))
+	}
+	sort.Slice(save.Bases, func(i, j int) bool { return save.Bases[i].Name < save.Bases[j].Name })
+	return save
+}
+
+func savegameBase(base *Base, player *filesave.Player) SaveGameBase {
+	rep := player.Reputations[base.FactionNickname]
+	result := SaveGameBase{
+		Nickname:        base.Nickname,
+		Name:            base.Name,
+		System:          base.System,
+		FactionNickname: base.FactionNickname,
+		FactionName:     base.FactionName,
+		Rep:             rep,
+		CanDock:         rep > HostileReputation,
+		BuyableGoods:    make([]SaveGameGood, 0),
+	}
+	if !result.CanDock {
+		return result
+	}
+
+	// market goods are duplicated per ship class at Discovery
+	seen := make(map[string]bool)
+	for _, good := range base.MarketGoodsPerNick {
+		if !good.BaseSells || seen[good.Nickname] {
+			continue
+		}
+		seen[good.Nickname] = true
+		if rep < good.RepRequired || player.Rank < good.LevelRequired {
+			result.LockedGoods++
+			continue
+		}
+		result.BuyableGoods = append(result.BuyableGoods, SaveGameGood{
+			Nickname:      good.Nickname,
+			Name:          good.Name,
+			Category:      good.Category,
+			Price:         good.PriceBaseSellsFor,
+			RepRequired:   good.RepRequired,
+			LevelRequired: good.LevelRequired,
+		})
+	}
+	sort.Slice(result.BuyableGoods, func(i, j int) bool { return result.BuyableGoods[i].Name < result.BuyableGoods[j].Name })
+	return result
+}
@@ -0,0 +1,33 @@
+package configs_export
+
+import (
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/filesave"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveGameBase(t *testing.T) {
+	base := &Base{
+		Nickname:        "li01_01_base",
+		FactionNickname: "li_n_grp",
+		MarketGoodsPerNick: map[CommodityKey]*MarketGood{
+			"gun":         {GoodInfo: GoodInfo{Nickname: "gun", Name: "Gun"}, BaseSells: true, RepRequired: 0.2},
+			"elite_gun":   {GoodInfo: GoodInfo{Nickname: "elite_gun", Name: "Elite Gun"}, BaseSells: true, RepRequired: 0.9},
+			"ranked_gun":  {GoodInfo: GoodInfo{Nickname: "ranked_gun", Name: "Ranked Gun"}, BaseSells: true, LevelRequired: 20},
+			"sold_to_npc": {GoodInfo: GoodInfo{Nickname: "sold_to_npc", Name: "Ore"}, BaseSells: false},
+		},
+	}
+
+	friendly := savegameBase(base, &filesave.Player{Rank: 5, Reputations: map[string]float64{"li_n_grp": 0.5}})
+	assert.True(t, friendly.CanDock)
+	assert.Equal(t, []SaveGameGood{{Nickname: "gun", Name: "Gun", RepRequired: 0.2}}, friendly.BuyableGoods)
+	assert.Equal(t, 2, friendly.LockedGoods)
+
+	hostile := savegameBase(base, &filesave.Player{Rank: 5, Reputations: map[string]float64{"li_n_grp": -0.7}})
+	assert.False(t, hostile.CanDock)
+	assert.Empty(t, hostile.BuyableGoods)
+
+	neutral := savegameBase(base, &filesave.Player{Rank: 5, Reputations: map[string]float64{}})
+	assert.True(t, neutral.CanDock)
+}
@@ -0,0 +1,295 @@
+package configs_export
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export/trades"
+)
+
+const (
+	TradeLoopMinLegs = 2
+	TradeLoopMaxLegs = 5
+	// Only best legs from every base are continued into chains, to keep search fast
+	tradeLoopLegsPerBase = 8
+)
+
+var (
+	ErrTradeLoopBaseNotFound = errors.New("starting base is not found")
+	ErrTradeLoopShipNotFound = errors.New("ship is not found")
+	ErrTradeLoopNoHold       = errors.New("hold size is required if ship is not given")
+	ErrTradeLoopNoBudget     = errors.New("budget is required")
+	ErrTradeLoopNoRouting    = errors.New("trade routing is disabled")
+	ErrTradeLoopHostileBase  = errors.New("starting base is hostile for reputation")
+)
+
+type TradeLoopInput struct {
+	From      string  `json:"from" example:"li01_01_base" validate:"required"` // base to buy first cargo at
+	HoldSize  int     `json:"hold_size" example:"3000"`                        // cargo hold of ship. Taken from ship if not set
+	Budget    int     `json:"budget" example:"500000" validate:"required"`     // credits available to buy first cargo
+	MaxLegs   int     `json:"max_legs" example:"3"`                            // max trades in chain, from 2 to 5. Default is 3
+	ShipClass string  `json:"ship_class" example:"transport"`                  // transport, frigate or freighter. Default is transport
+	Ship      *string `json:"ship,omitempty" example:"bw_freighter"`           // optional ship nickname. Its speed, docking abilities and hold are used instead of ship class
+	Limit     int     `json:"limit" example:"20"`                              // amount of best chains to return. Default is 20
+
+	// Optional reputation profile. Hostile bases and goods with unmet required reputation are skipped
+	DiscoveryID *string            `json:"discovery_id,omitempty" example:"dsy_license_srp_28"` // Discovery ID nickname, to use its rephacks
+	Reputations map[string]float64 `json:"reputations,omitempty"`                               // manual reputation per faction nickname, used if discovery id is not set
+}
+
+type TradeLoopLeg struct {
+	CommodityNickname string          `json:"commodity_nickname" validate:"required"`
+	CommodityName     string          `json:"commodity_name" validate:"required"`
+	FromBaseNickname  cfg.BaseUniNick `json:"from_base_nickname" validate:"required"`
+	FromBaseName      string          `json:"from_base_name" validate:"required"`
+	ToBaseNickname    cfg.BaseUniNick `json:"to_base_nickname" validate:"required"`
+	ToBaseName        string          `json:"to_base_name" validate:"required"`
+	BuyPrice          int             `json:"buy_price" validate:"required"`
+	SellPrice         int             `json:"sell_price" validate:"required"`
+	Amount            int             `json:"amount" validate:"required"`
+	Profit            int             `json:"profit" validate:"required"`
+	Time              cfg.SecondsI    `json:"time" validate:"required"`       // seconds to fly from base to base
+	LimitedBy         TradeLimit      `json:"limited_by" validate:"required"` // hold, credits or PoB stock
+}
+
+type TradeLoop struct {
+	Legs          []TradeLoopLeg `json:"legs" validate:"required"`
+	Profit        int            `json:"profit" validate:"required"`
+	Time          cfg.SecondsI   `json:"time" validate:"required"` // seconds for all legs
+	ProfitPerHour int            `json:"profit_per_hour" validate:"required"`
+	FinalBudget   int            `json:"final_budget" validate:"required"`
+	IsLoop        bool           `json:"is_loop" validate:"required"` // chain ends at starting base
+}
+
+// tradeLoopCandidate is possible leg from base, before knowing how much cargo can be afforded
+type tradeLoopCandidate struct {
+	route *TradeRoute
+	time  cfg.Seconds
+}
+
+func (c tradeLoopCandidate) profitPerVolumeTime() float64 {
+	return c.route.GetProffitPerV() / c.time
+}
+
+type tradeLoopPlanner struct {
+	e           *Exporter
+	hold_size   int
+	ship_class  cfg.ShipClass
+	rep         *RepProfile
+	get_graph   func(source string) *GraphResults
+	commodities map[CommodityKey]*Commodity
+	bases       map[cfg.BaseUniNick]*Base
+	candidates  map[cfg.BaseUniNick][]tradeLoopCandidate
+}
+
+func (e *Exporter) newTradeLoopPlanner(input TradeLoopInput) (*tradeLoopPlanner, error) {
+	p := &tradeLoopPlanner{
+		e:           e,
+		hold_size:   input.HoldSize,
+		ship_class:  -1,
+		commodities: make(map[CommodityKey]*Commodity),
+		bases:       make(map[cfg.BaseUniNick]*Base),
+		candidates:  make(map[cfg.BaseUniNick][]tradeLoopCandidate),
+	}
+
+	if input.Ship != nil {
+		var ship *Ship
+		for i := range e.Ships {
+			if e.Ships[i].Nickname == *input.Ship {
+				ship = &e.Ships[i]
+			}
+		}
+		if ship == nil {
+			return nil, ErrTradeLoopShipNotFound
+		}
+		if p.hold_size <= 0 {
+			p.hold_size = ship.HoldSize
+		}
+		p.ship_class = cfg.ShipClass(ship.Class)
+		profile := e.GetShipProfile(*ship)
+		graphs := make(map[string]*GraphResults)
+		p.get_graph = func(source string) *GraphResults {
+			if g, ok := graphs[source]; ok {
+				return g
+			}
+			graphs[source] = e.GetShipGraph(profile, source)
+			return graphs[source]
+		}
+	} else {
+		var g *GraphResults
+		switch input.ShipClass {
+		case "frigate":
+			g = e.Frigate
+		case "freighter":
+			g = e.Freighter
+		default:
+			g = e.Transport
+		}
+		if g == nil {
+			return nil, ErrTradeLoopNoRouting
+		}
+		p.get_graph = func(source string) *GraphResults { return g }
+	}
+	if p.hold_size <= 0 {
+		return nil, ErrTradeLoopNoHold
+	}
+	rep, err := e.GetRepProfile(input.DiscoveryID, input.Reputations)
+	if err != nil {
+		return nil, err
+	}
+	if !rep.CanDock(cfg.BaseUniNick(input.From)) {
+		return nil, ErrTradeLoopHostileBase
+	}
+	p.rep = rep
+
+	for _, commodity := range e.Commodities {
+		p.commodities[GetCommodityKey(commodity.Nickname, commodity.ShipClass)] = commodity
+	}
+	for _, base := range e.TradeBases {
+		p.bases[base.Nickname] = base
+	}
+	for _, base := range e.MiningOperations {
+		p.bases[base.Nickname] = base
+	}
+	return p, nil
+}
+
+// getCommodity returns commodity with volume for ship class, if mod has volumes per ship class
+func (p *tradeLoopPlanner) getCommodity(nickname string) *Commodity {
+	if commodity, ok := p.commodities[GetCommodityKey(nickname, p.ship_class)]; ok {
+		return commodity
+	}
+	return p.commodities[GetCommodityKey(nickname, -1)]
+}
+
+func (p *tradeLoopPlanner) getCandidates(base_nickname cfg.BaseUniNick) []tradeLoopCandidate {
+	if candidates, ok := p.candidates[base_nickname]; ok {
+		return candidates
+	}
+
+	var candidates []tradeLoopCandidate
+	g := p.get_graph(string(base_nickname))
+	if base, ok := p.bases[base_nickname]; ok && g != nil {
+		for _, good := range base.MarketGoodsPerNick {
+			if good.Category != "commodity" || !good.BaseSells || good.PriceBaseSellsFor <= 0 {
+				continue
+			}
+			commodity := p.getCommodity(good.Nickname)
+			if commodity == nil || commodity.ShipClass != good.ShipClass || commodity.Volume <= 0 {
+				continue
+			}
+
+			for _, selling := range commodity.Bases {
+				if selling.BaseNickname == base_nickname || selling.GetPriceBaseBuysFor() <= good.PriceBaseSellsFor {
+					continue
+				}
+				route := NewTradeRoute(g, good, selling, commodity)
+				if !p.rep.CanTrade(route) || route.Route.GetTimeMs() >= trades.INFthreshold {
+					continue
+				}
+				candidates = append(candidates, tradeLoopCandidate{route: route, time: route.Route.GetTimeS()})
+			}
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].profitPerVolumeTime() > candidates[j].profitPerVolumeTime()
+	})
+	if len(candidates) > tradeLoopLegsPerBase {
+		candidates = candidates[:tradeLoopLegsPerBase]
+	}
+	p.candidates[base_nickname] = candidates
+	return candidates
+}
+
+func (p *tradeLoopPlanner) newLeg(candidate tradeLoopCandidate, budget int) (TradeLoopLeg, bool) {
+	route := candidate.route
+	run := p.e.GetTradeRun(route, TradeCapacity{HoldSize: p.hold_size, Credits: budget, ShipClass: p.ship_class})
+	if run.Units <= 0 {
+		return TradeLoopLeg{}, false
+	}
+
+	return TradeLoopLeg{
+		CommodityNickname: route.Commodity.Nickname,
+		CommodityName:     route.Commodity.Name,
+		FromBaseNickname:  route.BuyingGood.BaseNickname,
+		FromBaseName:      route.BuyingGood.BaseName,
+		ToBaseNickname:    route.SellingGood.BaseNickname,
+		ToBaseName:        route.SellingGood.BaseName,
+		BuyPrice:          route.BuyingGood.PriceBaseSellsFor,
+		SellPrice:         route.SellingGood.GetPriceBaseBuysFor(),
+		Amount:            run.Units,
+		Profit:            run.Profit,
+		Time:              cfg.SecondsI(math.Round(candidate.time)),
+		LimitedBy:         run.LimitedBy,
+	}, true
+}
+
+func newTradeLoop(legs []TradeLoopLeg, start_budget int) TradeLoop {
+	loop := TradeLoop{Legs: legs, FinalBudget: start_budget}
+	for _, leg := range legs {
+		loop.Profit += leg.Profit
+		loop.Time += leg.Time
+	}
+	loop.FinalBudget += loop.Profit
+	if loop.Time > 0 {
+		loop.ProfitPerHour = int(float64(loop.Profit) / float64(loop.Time) * 3600)
+	}
+	loop.IsLoop = legs[len(legs)-1].ToBaseNickname == legs[0].FromBaseNickname
+	return loop
+}
+
+/*
+PlanTradeLoops searches chains of trades, where every sell base becomes next buy base.
+Profit of every trade is added to budget for the next one.
+Chains are ranked by profit per hour.
+*/
+func (e *Exporter) PlanTradeLoops(input TradeLoopInput) ([]TradeLoop, error) {
+	if input.Budget <= 0 {
+		return nil, ErrTradeLoopNoBudget
+	}
+	if input.MaxLegs == 0 {
+		input.MaxLegs = 3
+	}
+	input.MaxLegs = max(TradeLoopMinLegs, min(input.MaxLegs, TradeLoopMaxLegs))
+	if input.Limit <= 0 {
+		input.Limit = 20
+	}
+
+	p, err := e.newTradeLoopPlanner(input)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.bases[cfg.BaseUniNick(input.From)]; !ok {
+		return nil, ErrTradeLoopBaseNotFound
+	}
+
+	var loops []TradeLoop
+	var search func(base_nickname cfg.BaseUniNick, budget int, legs []TradeLoopLeg)
+	search = func(base_nickname cfg.BaseUniNick, budget int, legs []TradeLoopLeg) {
+		for _, candidate := range p.getCandidates(base_nickname) {
+			leg, ok := p.newLeg(candidate, budget)
+			if !ok {
+				continue
+			}
+			chain := append(legs[:len(legs):len(legs)], leg)
+			if len(chain) >= TradeLoopMinLegs {
+				loops = append(loops, newTradeLoop(chain, input.Budget))
+			}
+			if len(chain) < input.MaxLegs {
+				search(leg.ToBaseNickname, budget+leg.Profit, chain)
+			}
+		}
+	}
+	search(cfg.BaseUniNick(input.From), input.Budget, nil)
+
+	sort.Slice(loops, func(i, j int) bool {
+		return loops[i].ProfitPerHour > loops[j].ProfitPerHour
+	})
+	if len(loops) > input.Limit {
+		loops = loops[:input.Limit]
+	}
+	return loops, nil
+}
@@ -0,0 +1,146 @@
+package configs_export
+
+import (
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/cfg"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export/trades"
+	"github.com/darklab8/go-utils/utils/ptr"
+	"github.com/darklab8/go-utils/utils/utils_os"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTradeGood(nickname string, base_nickname cfg.BaseUniNick, sells_for int, buys_for int) *MarketGood {
+	return &MarketGood{
+		GoodInfo:          GoodInfo{Nickname: nickname, Name: nickname, Category: "commodity"},
+		BaseInfo:          BaseInfo{BaseNickname: base_nickname, BaseName: string(base_nickname)},
+		PriceBaseSellsFor: sells_for,
+		PriceBaseBuysFor:  ptr.Ptr(buys_for),
+		BaseSells:         sells_for > 0,
+		ShipClass:         -1,
+	}
+}
+
+func TestPlanTradeLoops(t *testing.T) {
+	mapped := configs_mapped.NewMappedConfigs().Read(utils_os.GetCurrrentTestFolder().Join("mod"))
+	e := NewExporter(mapped)
+	e.graph_options = trades.MappingOptions{TradeRoutesDetailedTradeLane: ptr.Ptr(false)}
+	e.Transport = NewGraphResults(e, trades.VanillaSpeeds.AvgTransportCruiseSpeed, trades.WithFreighterPaths(true), nil, e.graph_options)
+
+	gold_li01 := newTestTradeGood("commodity_gold", "li01_01_base", 10, 8)
+	gold_li02 := newTestTradeGood("commodity_gold", "li02_01_base", 0, 30)
+	silver_li01 := newTestTradeGood("commodity_silver", "li01_01_base", 0, 25)
+	silver_li02 := newTestTradeGood("commodity_silver", "li02_01_base", 5, 4)
+	e.Commodities = []*Commodity{
+		{Nickname: "commodity_gold", Name: "Gold", Volume: 1, ShipClass: -1,
+			Bases: map[cfg.BaseUniNick]*MarketGood{"li01_01_base": gold_li01, "li02_01_base": gold_li02}},
+		{Nickname: "commodity_silver", Name: "Silver", Volume: 1, ShipClass: -1,
+			Bases: map[cfg.BaseUniNick]*MarketGood{"li01_01_base": silver_li01, "li02_01_base": silver_li02}},
+	}
+	e.TradeBases = []*Base{
+		{Nickname: "li01_01_base", MarketGoodsPerNick: map[CommodityKey]*MarketGood{
+			GetCommodityKey("commodity_gold", -1): gold_li01, GetCommodityKey("commodity_silver", -1): silver_li01}},
+		{Nickname: "li02_01_base", MarketGoodsPerNick: map[CommodityKey]*MarketGood{
+			GetCommodityKey("commodity_gold", -1): gold_li02, GetCommodityKey("commodity_silver", -1): silver_li02}},
+	}
+	e.commodity_volumes = getCommodityVolumes(e.Commodities)
+
+	// web mode frees mapped configs after export, before requests are served
+	mapped.Clean()
+
+	loops, err := e.PlanTradeLoops(TradeLoopInput{From: "li01_01_base", HoldSize: 100, Budget: 500})
+	assert.Nil(t, err)
+	assert.Len(t, loops, 2)
+
+	var round_trip TradeLoop
+	for _, loop := range loops {
+		if len(loop.Legs) == 2 {
+			round_trip = loop
+		}
+	}
+	assert.True(t, round_trip.IsLoop)
+	// budget allows only 50 gold, but profit of it pays for full hold of silver
+	assert.Equal(t, 50, round_trip.Legs[0].Amount)
+	assert.Equal(t, 100, round_trip.Legs[1].Amount)
+	assert.Equal(t, 3000, round_trip.Profit)
+	assert.Equal(t, 3500, round_trip.FinalBudget)
+	assert.Equal(t, round_trip.Legs[0].Time+round_trip.Legs[1].Time, round_trip.Time)
+	assert.GreaterOrEqual(t, loops[0].ProfitPerHour, loops[1].ProfitPerHour)
+
+	loops, err = e.PlanTradeLoops(TradeLoopInput{From: "li01_01_base", HoldSize: 100, Budget: 500, MaxLegs: 2})
+	assert.Nil(t, err)
+	assert.Len(t, loops, 1)
+
+	_, err = e.PlanTradeLoops(TradeLoopInput{From: "not_existing_base", HoldSize: 100, Budget: 500})
+	assert.ErrorIs(t, err, ErrTradeLoopBaseNotFound)
+	_, err = e.PlanTradeLoops(TradeLoopInput{From: "li01_01_base", Budget: 500})
+	assert.ErrorIs(t, err, ErrTradeLoopNoHold)
+	_, err = e.PlanTradeLoops(TradeLoopInput{From: "li01_01_base", HoldSize: 100, Budget: 500, Ship: ptr.Ptr("not_existing_ship")})
+	assert.ErrorIs(t, err, ErrTradeLoopShipNotFound)
+
+	_, err = e.PlanTradeLoops(TradeLoopInput{From: "li01_01_base", HoldSize: 100, Budget: 500, DiscoveryID: ptr.Ptr("not_existing_id")})
+	assert.ErrorIs(t, err, ErrDiscoveryIDNotFound)
+}
+
+func TestPlanTradeLoopsWithReputation(t *testing.T) {
+	mapped := configs_mapped.NewMappedConfigs().Read(utils_os.GetCurrrentTestFolder().Join("mod"))
+	e := NewExporter(mapped)
+	e.Transport = NewGraphResults(e, trades.VanillaSpeeds.AvgTransportCruiseSpeed, trades.WithFreighterPaths(true), nil,
+		trades.MappingOptions{TradeRoutesDetailedTradeLane: ptr.Ptr(false)})
+
+	gold_li01 := newTestTradeGood("commodity_gold", "li01_01_base", 10, 8)
+	gold_li01.RepRequired = 0.5
+	gold_li02 := newTestTradeGood("commodity_gold", "li02_01_base", 0, 30)
+	silver_li01 := newTestTradeGood("commodity_silver", "li01_01_base", 0, 25)
+	silver_li02 := newTestTradeGood("commodity_silver", "li02_01_base", 5, 4)
+	e.Commodities = []*Commodity{
+		{Nickname: "commodity_gold", Name: "Gold", Volume: 1, ShipClass: -1,
+			Bases: map[cfg.BaseUniNick]*MarketGood{"li01_01_base": gold_li01, "li02_01_base": gold_li02}},
+		{Nickname: "commodity_silver", Name: "Silver", Volume: 1, ShipClass: -1,
+			Bases: map[cfg.BaseUniNick]*MarketGood{"li01_01_base": silver_li01, "li02_01_base": silver_li02}},
+	}
+	e.TradeBases = []*Base{
+		{Nickname: "li01_01_base", FactionNickname: "li_p_grp", MarketGoodsPerNick: map[CommodityKey]*MarketGood{
+			GetCommodityKey("commodity_gold", -1): gold_li01, GetCommodityKey("commodity_silver", -1): silver_li01}},
+		{Nickname: "li02_01_base", FactionNickname: "li_n_grp", MarketGoodsPerNick: map[CommodityKey]*MarketGood{
+			GetCommodityKey("commodity_gold", -1): gold_li02, GetCommodityKey("commodity_silver", -1): silver_li02}},
+	}
+	e.base_factions = getBaseFactions(e.TradeBases)
+	e.Ships = []Ship{{Nickname: "li_elite", Type: "fighter", CruiseSpeed: 700, HoldSize: 100}}
+	mapped.Clean()
+
+	loops, err := e.PlanTradeLoops(TradeLoopInput{From: "li01_01_base", HoldSize: 100, Budget: 500,
+		Reputations: map[string]float64{"li_p_grp": 0.6}})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, loops)
+
+	// gold requires more reputation than pilot has, so first trade is not possible
+	loops, err = e.PlanTradeLoops(TradeLoopInput{From: "li01_01_base", HoldSize: 100, Budget: 500,
+		Reputations: map[string]float64{"li_p_grp": 0.2}})
+	assert.Nil(t, err)
+	assert.Empty(t, loops)
+
+	// selling base is hostile
+	loops, err = e.PlanTradeLoops(TradeLoopInput{From: "li01_01_base", HoldSize: 100, Budget: 500,
+		Reputations: map[string]float64{"li_p_grp": 0.6, "li_n_grp": -0.9}})
+	assert.Nil(t, err)
+	assert.Empty(t, loops)
+
+	// ship graph is built after configs are cleaned
+	loops, err = e.PlanTradeLoops(TradeLoopInput{From: "li01_01_base", Budget: 500, Ship: ptr.Ptr("li_elite"),
+		Reputations: map[string]float64{"li_p_grp": 0.6}})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, loops)
+
+	_, err = e.PlanTradeLoops(TradeLoopInput{From: "li02_01_base", HoldSize: 100, Budget: 500,
+		Reputations: map[string]float64{"li_n_grp": -0.9}})
+	assert.ErrorIs(t, err, ErrTradeLoopHostileBase)
+
+	rep := e.NewRepProfile(map[string]float64{"li_n_grp": -0.9})
+	assert.False(t, rep.CanDock("li02_01_base"))
+	assert.True(t, rep.CanDock("li01_01_base"))
+	assert.True(t, rep.CanDock("zone_not_owned_field"))
+	var no_rep *RepProfile
+	assert.True(t, no_rep.CanBuy(gold_li01))
+}
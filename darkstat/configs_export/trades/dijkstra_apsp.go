@@ -170,6 +170,11 @@ func (g *DijkstraAPSP) dijkstra(source Intg) ([]Intg, []Parent) {
 	return distance, parents
 }
 
+// ShortestPaths calculates paths from single source, for graphs too rarely queried to calculate all pairs
+func (g *DijkstraAPSP) ShortestPaths(source Intg) ([]Intg, []Parent) {
+	return g.dijkstra(source)
+}
+
 type DijkstraResult struct {
 	source         Intg
 	dist_result    []Intg
@@ -3,6 +3,8 @@ package trades
 import (
 	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 // // Driver Code
@@ -71,3 +73,28 @@ func TestDijkstraAPSPWithGraph(t *testing.T) {
 	fmt.Println("a -> b = ", GetTimeMs2(graph, dist, "a", "b"), "path=", GetPath(graph, parents, dist, "a", "b"))
 	fmt.Println("a -> d = ", GetTimeMs2(graph, dist, "a", "d"), "path=", GetPath(graph, parents, dist, "a", "d"))
 }
+
+func TestGetVertexPath(t *testing.T) {
+	graph := NewGameGraph(DiscoverySpeeds.AvgTransportCruiseSpeed, WithFreighterPaths(true))
+	graph.SetEdge("a", "b", 5)
+	graph.SetEdge("a", "d", 10)
+	graph.SetEdge("b", "c", 3)
+	graph.SetEdge("c", "d", 1)
+	graph.SetIstRadelane("b")
+	graph.SetIstRadelane("c")
+	johnson := NewDijkstraApspFromGraph(graph)
+	_, parents := johnson.DijkstraApsp()
+
+	paths := GetVertexPath(graph, parents, "a", "d")
+	var visited []VertexName
+	var total Intg
+	for _, path := range paths {
+		visited = append(visited, graph.NicknameByIndex[path.NextNode])
+		total += path.Dist
+	}
+	assert.Equal(t, []VertexName{"b", "c", "d"}, visited)
+	assert.Equal(t, Intg(9), total)
+
+	assert.Nil(t, GetVertexPath(graph, parents, "d", "a"))
+	assert.Len(t, GetVertexPath(graph, parents, "a", "a"), 0)
+}
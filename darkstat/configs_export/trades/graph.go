@@ -208,3 +208,32 @@ func (graph *GameGraph) GetPaths(parents [][]Parent, dist [][]Intg, source_key s
 
 	return detailed_paths
 }
+
+func (graph *GameGraph) GetIdsName(key string) int {
+	return graph.idsNamesByNick[VertexName(key)]
+}
+
+/*
+GetVertexPath returns every vertex of path from source to target, tradelane rings included.
+Dist of each path is time to get from Node to NextNode.
+Returns nil if target is not reachable.
+*/
+func GetVertexPath(graph *GameGraph, parents [][]Parent, source_key string, target_key string) []Path {
+	source, found_source := graph.IndexByNick[VertexName(source_key)]
+	target, found_target := graph.IndexByNick[VertexName(target_key)]
+	if !found_source || !found_target {
+		return nil
+	}
+
+	var paths []Path
+	for u := target; u != source; {
+		parent := parents[source][u]
+		if parent.node == NO_PARENT || len(paths) > len(graph.IndexByNick) {
+			return nil
+		}
+		paths = append(paths, Path{Node: parent.node, NextNode: u, Dist: parent.weight})
+		u = parent.node
+	}
+	ReverseSlice(paths)
+	return paths
+}
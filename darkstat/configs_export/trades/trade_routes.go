@@ -8,6 +8,7 @@ import (
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/initialworld/flhash"
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/data_mapped/universe_mapped/systems_mapped"
+	"github.com/darklab8/fl-darkstat/configs/overrides"
 	"github.com/darklab8/fl-darkstat/darkstat/settings"
 	"github.com/darklab8/go-utils/utils/ptr"
 )
@@ -54,16 +55,24 @@ var VanillaSpeeds ShipSpeeds = ShipSpeeds{
 	AvgFreighterCruiseSpeed: 350,
 }
 
+// Mod defaults. Mods can set their own with cruise_speeds of overrides.fl_configs.yml
 var DiscoverySpeeds ShipSpeeds = ShipSpeeds{
-	AvgTransportCruiseSpeed: 350, // TODO You should grab those speeds from some ship example
-	AvgFrigateCruiseSpeed:   500, // TODO You should grab those speeds from some ship example
-	AvgFreighterCruiseSpeed: 500, // TODO You should grab those speeds from some ship example
+	AvgTransportCruiseSpeed: 350,
+	AvgFrigateCruiseSpeed:   500,
+	AvgFreighterCruiseSpeed: 500,
 }
 
-var FLSRSpeeds ShipSpeeds = ShipSpeeds{
-	AvgTransportCruiseSpeed: 500, // TODO You should grab those speeds from some ship example
-	AvgFrigateCruiseSpeed:   500, // TODO You should grab those speeds from some ship example
-	AvgFreighterCruiseSpeed: 500, // TODO You should grab those speeds from some ship example
+func (s ShipSpeeds) WithOverrides(speeds overrides.CruiseSpeeds) ShipSpeeds {
+	if speeds.Transport != nil {
+		s.AvgTransportCruiseSpeed = *speeds.Transport
+	}
+	if speeds.Frigate != nil {
+		s.AvgFrigateCruiseSpeed = *speeds.Frigate
+	}
+	if speeds.Freighter != nil {
+		s.AvgFreighterCruiseSpeed = *speeds.Freighter
+	}
+	return s
 }
 
 const (
@@ -169,6 +178,9 @@ func MapConfigsToFGraph(
 			if _, ok := mapped.InitialWorld.LockedGates[flhash.HashNickname(object_nickname)]; ok {
 				continue
 			}
+			if mapped.Overrides.IsLockedJump(object_nickname) {
+				continue
+			}
 
 			// get all objects with same Base?
 			// Check if any of them has docking sphere medium
@@ -235,6 +247,9 @@ func MapConfigsToFGraph(
 			if _, ok := mapped.InitialWorld.LockedGates[hash_id]; ok {
 				continue
 			}
+			if mapped.Overrides.IsLockedJump(object.nickname) {
+				continue
+			}
 
 			if strings.Contains(jh_archetype, "invisible") {
 				continue
@@ -282,6 +297,7 @@ func MapConfigsToFGraph(
 				pos:      tradelane.Pos.Get(),
 			}
 			graph.SetIstRadelane(object.nickname)
+			graph.SetIdsName(object.nickname, tradelane.IdsName.Get())
 
 			next_tradelane, next_exists := tradelane.NextRing.GetValue()
 			prev_tradelane, prev_exists := tradelane.PrevRing.GetValue()
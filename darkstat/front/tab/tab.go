@@ -3,13 +3,56 @@ package tab
 import (
 	"strings"
 
+	"github.com/a-h/templ"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/infocard_mapped/infocard"
+
 	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
 )
 
 type ShowEmpty bool
 
 func InfocardURL(infocard_key configs_export.InfocardKey) string {
-	return "infocards/info_" + strings.ToLower(string(infocard_key))
+	return configs_export.InfocardPath(infocard_key)
+}
+
+func InfocardPhraseClass(phrase configs_export.InfocardPhrase) string {
+	var classes []string
+	if phrase.Bold {
+		classes = append(classes, "bold")
+	}
+	if phrase.Italic {
+		classes = append(classes, "italic")
+	}
+	if phrase.Underline {
+		classes = append(classes, "underline")
+	}
+	return strings.Join(classes, " ")
+}
+
+func InfocardJustifyClass(line configs_export.InfocardLine) string {
+	switch line.Justify {
+	case infocard.JustifyCenter:
+		return "just_center"
+	case infocard.JustifyRight:
+		return "just_right"
+	}
+	return ""
+}
+
+// InfocardLinkURL prefixes site root only to links into darkstat, external links are kept as they are
+func InfocardLinkURL(site_root string, link string) templ.SafeURL {
+	if strings.Contains(link, "://") || strings.HasPrefix(link, "//") {
+		return templ.SafeURL(link)
+	}
+	return templ.SafeURL(site_root + link)
+}
+
+// InfocardPhraseStyle renders color from infocard RDL markup
+func InfocardPhraseStyle(phrase configs_export.InfocardPhrase) templ.Attributes {
+	if phrase.Color == nil || !infocard.IsRDLColor(*phrase.Color) {
+		return templ.Attributes{}
+	}
+	return templ.Attributes{"style": "color:" + *phrase.Color + ";"}
 }
 
 func GetFirstLine(infocards configs_export.Infocards, infokey configs_export.InfocardKey) string {
@@ -0,0 +1,21 @@
+package tab
+
+import (
+	"testing"
+
+	"github.com/a-h/templ"
+	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
+	"github.com/darklab8/go-utils/utils/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfocardLinkURL(t *testing.T) {
+	assert.Equal(t, templ.SafeURL("/fl-darkstat/infocards/info_li01"), InfocardLinkURL("/fl-darkstat/", "infocards/info_li01"))
+	assert.Equal(t, templ.SafeURL("https://discoverygc.com/wiki2/Mining"), InfocardLinkURL("/fl-darkstat/", "https://discoverygc.com/wiki2/Mining"))
+}
+
+func TestInfocardPhraseStyle(t *testing.T) {
+	assert.Equal(t, templ.Attributes{"style": "color:#FF0000;"}, InfocardPhraseStyle(configs_export.InfocardPhrase{Color: ptr.Ptr("#FF0000")}))
+	assert.Equal(t, templ.Attributes{}, InfocardPhraseStyle(configs_export.InfocardPhrase{Color: ptr.Ptr("red;background:url(x)")}))
+	assert.Equal(t, templ.Attributes{}, InfocardPhraseStyle(configs_export.InfocardPhrase{}))
+}
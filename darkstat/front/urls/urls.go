@@ -36,4 +36,6 @@ const (
 	Scanners        utils_types.FilePath = "scanners.html"
 	PoBs            utils_types.FilePath = "pobs.html"
 	PoBGoods        utils_types.FilePath = "pob_goods.html"
+	SaveGame        utils_types.FilePath = "savegame.html"
+	TradeLoops      utils_types.FilePath = "trade_loops.html"
 )
@@ -77,6 +77,22 @@ func (l *Router) Link() *builder.Builder {
 				urls.Docs,
 				front.DocsT(tab.ShowEmpty(false), shared),
 			),
+			builder.NewComponent(
+				urls.SaveGame,
+				front.SaveGameT(tab.ShowEmpty(false), shared),
+			),
+			builder.NewComponent(
+				tab.AllItemsUrl(urls.SaveGame),
+				front.SaveGameT(tab.ShowEmpty(true), shared),
+			),
+			builder.NewComponent(
+				urls.TradeLoops,
+				front.TradeLoopsT(configs.TradeBases, configs.Tractors, tab.ShowEmpty(false), shared),
+			),
+			builder.NewComponent(
+				tab.AllItemsUrl(urls.TradeLoops),
+				front.TradeLoopsT(configs.TradeBases, configs.Tractors, tab.ShowEmpty(true), shared),
+			),
 			builder.NewComponent(
 				urls.Index,
 				front.Index(types.ThemeLight, shared),
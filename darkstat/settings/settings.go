@@ -11,6 +11,7 @@ import (
 
 	"github.com/darklab8/go-utils/utils/enverant"
 	"github.com/darklab8/go-utils/utils/utils_settings"
+	"github.com/darklab8/go-utils/utils/utils_types"
 )
 
 //go:embed version.txt
@@ -44,6 +45,11 @@ type DarkstatEnvVars struct {
 	IsMemProfilerEnabled bool
 
 	IsStaticSiteGenerator bool
+
+	DiffBaselineFolder utils_types.FilePath // previous game version, which /api/diff compares current one against
+
+	WatchSecs  int    // polling interval of game folder for changed configs, 0 disables reloading them
+	AdminToken string // bearer token of /admin routes, they are disabled when it is empty
 }
 
 func IsApiActive() bool {
@@ -79,6 +85,11 @@ func init() {
 
 		IsCPUProfilerEnabled: env.GetBoolOr("IS_CPU_PROFILER_ENABLED", false),
 		IsMemProfilerEnabled: env.GetBoolOr("IS_MEM_PROFILER_ENABLED", false),
+
+		DiffBaselineFolder: utils_types.FilePath(env.GetStrOr("DARKSTAT_DIFF_BASELINE", "")),
+
+		WatchSecs:  env.GetIntOr("DARKSTAT_WATCH_SECS", 0),
+		AdminToken: env.GetStrOr("DARKSTAT_ADMIN_TOKEN", ""),
 	}
 
 	fmt.Sprintln("conf=", Env)
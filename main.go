@@ -2,14 +2,18 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"net/http"
 	"os"
 	"os/signal"
 	"runtime"
 	"runtime/debug"
 	"runtime/pprof"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -17,7 +21,14 @@ import (
 	_ "net/http/pprof"
 
 	"github.com/darklab8/fl-darkstat/configs/configs_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/exe_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/infocard_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/parserutils/filefind/file"
 	"github.com/darklab8/fl-darkstat/configs/configs_settings"
+	"github.com/darklab8/fl-darkstat/configs/lint"
+	"github.com/darklab8/fl-darkstat/configs/patch"
+	"github.com/darklab8/fl-darkstat/configs/schema"
 	"github.com/darklab8/fl-darkstat/darkapis/darkgrpc"
 	"github.com/darklab8/fl-darkstat/darkapis/darkhttp"
 	"github.com/darklab8/fl-darkstat/darkapis/darkrpc"
@@ -26,6 +37,7 @@ import (
 	"github.com/darklab8/fl-darkstat/darkrelay/relayrouter"
 	"github.com/darklab8/fl-darkstat/darkstat/appdata"
 	"github.com/darklab8/fl-darkstat/darkstat/configs_export"
+	"github.com/darklab8/fl-darkstat/darkstat/front"
 	"github.com/darklab8/fl-darkstat/darkstat/router"
 	"github.com/darklab8/fl-darkstat/darkstat/settings"
 	"github.com/darklab8/fl-darkstat/darkstat/settings/logus"
@@ -34,6 +46,7 @@ import (
 	"github.com/darklab8/go-utils/utils/ptr"
 	"github.com/darklab8/go-utils/utils/timeit"
 	"github.com/darklab8/go-utils/utils/utils_logus"
+	"github.com/darklab8/go-utils/utils/utils_types"
 )
 
 type Action string
@@ -47,8 +60,33 @@ const (
 	Relay   Action = "relay"
 	Health  Action = "health"
 	Configs Action = "configs"
+	Hashes  Action = "hashes"
+	Diff    Action = "diff"
+	Lint    Action = "lint"
+	Patch   Action = "patch"
+	Schema  Action = "schema"
+
+	InfocardsDLL Action = "infocards-dll"
 )
 
+// cli actions print their results to stdout, so it is kept clean of logs
+var cli_actions = map[Action]bool{
+	Hashes: true,
+	Diff:   true,
+	Lint:   true,
+	Patch:  true,
+	Schema: true,
+
+	InfocardsDLL: true,
+}
+
+// logsToStderr redirects all registered loggers, including ones of libraries, to stderr
+func logsToStderr() {
+	for _, logger := range typelog.RegisteredLoggers {
+		logger.OverrideOption(typelog.WithIoWriter(os.Stderr))
+	}
+}
+
 func GetRelayFs(app_data *appdata.AppDataRelay) *builder.Filesystem {
 	relay_router := relayrouter.NewRouter(app_data)
 	relay_builder := relay_router.Link()
@@ -64,7 +102,7 @@ func GetConfigsExport() *configs_export.Exporter {
 	freelancer_folder := configs_settings.Env.FreelancerFolder
 	mapped := configs_mapped.NewMappedConfigs()
 	logus.Log.Debug("scanning freelancer folder", utils_logus.FilePath(freelancer_folder))
-	mapped.Read(freelancer_folder)
+	mapped.Read(freelancer_folder, configs_mapped.WithEnvLayers())
 	timer_mapping.Close()
 
 	timer_export := timeit.NewTimerMain(timeit.WithMsg("read mapping"))
@@ -101,6 +139,166 @@ func main_configs() {
 	}
 }
 
+// go run . hashes 2339324873 -1955642423 0x8b6f43c9
+// Resolves hashes to nicknames. Without arguments prints hash collisions found in configs
+func main_hashes(queries []string) {
+	mapped := configs_mapped.NewMappedConfigs()
+	mapped.Read(configs_settings.Env.FreelancerFolder, configs_mapped.WithEnvLayers())
+	configs := configs_export.NewExporter(mapped)
+
+	if len(queries) == 0 {
+		collisions := configs.HashCollisions()
+		for _, collision := range collisions {
+			fmt.Printf("collision %s:", collision.Hash.ToHexStr())
+			for _, entry := range collision.Entries {
+				fmt.Printf(" %s(%s %s)", entry.Nickname, entry.Kind, entry.Section)
+			}
+			fmt.Println()
+		}
+		fmt.Println("hashes:", mapped.Hashes.Len(), "collisions:", len(collisions))
+		return
+	}
+
+	for _, resolved := range configs.ResolveHashes(queries) {
+		if resolved.Error != nil {
+			fmt.Printf("%s\tinvalid hash: %s\n", resolved.Query, *resolved.Error)
+			continue
+		}
+		if !resolved.Found {
+			fmt.Printf("%s\t%s\tnot found\n", resolved.Query, resolved.Hex)
+			continue
+		}
+		for _, entry := range resolved.Entries {
+			fmt.Printf("%s\t%s\t%s\t%s\t%s\n", resolved.Query, resolved.Hex, entry.Nickname, entry.Kind, entry.Section)
+		}
+		if resolved.IsCollision() {
+			fmt.Printf("%s\t%s\tcollision of %d nicknames\n", resolved.Query, resolved.Hex, len(resolved.Entries))
+		}
+	}
+}
+
+// go run . diff path/to/old_freelancer path/to/new_freelancer markdown changelog.md
+// Compares two game versions (folders or archives) entity by entity.
+// Format is json, markdown or html, output is written to stdout if file is not given
+func main_diff(args []string) {
+	if len(args) < 2 {
+		logus.Log.Panic("expected old and new game folders. Usage: diff old_folder new_folder [json|markdown|html] [output_file]")
+	}
+	var format_arg string
+	if len(args) >= 3 {
+		format_arg = args[2]
+	}
+	format, err := configs_export.ParseDiffFormat(format_arg)
+	logus.Log.CheckPanic(err, "invalid diff format")
+
+	old := configs_export.ExportFolder(utils_types.FilePath(args[0]))
+	new := configs_export.ExportFolder(utils_types.FilePath(args[1]))
+	changes := configs_export.Diff(old, new)
+
+	var output io.Writer = os.Stdout
+	if len(args) >= 4 {
+		file, err := os.Create(args[3])
+		logus.Log.CheckPanic(err, "failed to create output file")
+		defer file.Close()
+		output = file
+	}
+	err = front.WriteChangeSet(context.Background(), output, changes, format)
+	logus.Log.CheckPanic(err, "failed to write changeset")
+}
+
+// go run . lint [json]
+// Checks cross references of configs in FREELANCER_FOLDER. Exits with code 1 if errors are found
+func main_lint(args []string) {
+	mapped := configs_mapped.NewMappedConfigs()
+	mapped.Read(configs_settings.Env.FreelancerFolder, configs_mapped.WithEnvLayers())
+	report := lint.Lint(mapped)
+
+	if len(args) >= 1 && args[0] == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(report)
+	} else {
+		for _, finding := range report.Findings {
+			fmt.Println(finding)
+		}
+		fmt.Println("errors:", report.Count(lint.SeverityError), "warnings:", report.Count(lint.SeverityWarning))
+	}
+
+	if report.HasErrors() {
+		os.Exit(1)
+	}
+}
+
+// go run . patch patch.yml [write]
+// Applies patch to configs in FREELANCER_FOLDER and prints diff of changed files. Files are written only with write argument
+func main_patch(args []string) {
+	if len(args) < 1 {
+		fmt.Println("usage: patch patch.yml [write]")
+		os.Exit(1)
+	}
+	patch_to_apply, err := patch.ReadFile(utils_types.FilePath(args[0]))
+	logus.Log.CheckFatal(err, "failed to read patch")
+
+	mapped := configs_mapped.NewMappedConfigs()
+	mapped.Read(configs_settings.Env.FreelancerFolder, configs_mapped.WithEnvLayers())
+
+	is_dry_run := !(len(args) >= 2 && args[1] == "write")
+	result, err := patch_to_apply.Run(mapped, configs_mapped.IsDruRun(is_dry_run))
+	logus.Log.CheckFatal(err, "failed to apply patch")
+	fmt.Print(result.String())
+}
+
+// go run . schema [output_folder]
+// Generates JSON Schema of ini sections known to darkstat. Prints single bundled document if folder is not given
+func main_schema(args []string) {
+	mapped := configs_mapped.NewMappedConfigs()
+	mapped.Read(configs_settings.Env.FreelancerFolder, configs_mapped.WithEnvLayers())
+	schemas := schema.Generate(mapped)
+
+	if len(args) >= 1 {
+		err := schemas.WriteFolder(utils_types.FilePath(args[0]))
+		logus.Log.CheckFatal(err, "failed to write schemas")
+		return
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(schemas.Bundle())
+	logus.Log.CheckFatal(err, "failed to encode schemas")
+}
+
+// go run . infocards-dll infocards.txt output.dll [dll_index]
+// Compiles infocards of text format into resource dll. Index of dll in freelancer.ini [Resources] is taken from smallest id if not given
+func main_infocards_dll(args []string) {
+	if len(args) < 2 {
+		fmt.Println("usage: infocards-dll infocards.txt output.dll [dll_index]")
+		os.Exit(1)
+	}
+	config, err := infocard_mapped.ReadFromTextFile(file.NewFile(utils_types.FilePath(args[0])))
+	logus.Log.CheckFatal(err, "failed to read infocards")
+	if len(config.Infonames) == 0 && len(config.Infocards) == 0 {
+		logus.Log.Fatal("no infocards are found", utils_logus.FilePath(utils_types.FilePath(args[0])))
+	}
+
+	var dll_index int
+	if len(args) >= 3 {
+		dll_index, err = strconv.Atoi(args[2])
+		logus.Log.CheckFatal(err, "dll index is not a number")
+	} else {
+		min_id := math.MaxInt
+		for ids_id := range config.Infonames {
+			min_id = min(min_id, ids_id)
+		}
+		for ids_id := range config.Infocards {
+			min_id = min(min_id, ids_id)
+		}
+		dll_index = min_id / exe_mapped.DLLIdsPerFile
+	}
+
+	err = exe_mapped.WriteDLLFile(config, dll_index*exe_mapped.DLLIdsPerFile, args[1])
+	logus.Log.CheckFatal(err, "failed to write dll")
+	fmt.Println("written", len(config.Infonames), "infonames and", len(config.Infocards), "infocards to", args[1])
+}
+
 // @title Darkstat API
 // @version 1.0
 // @description Darkstat API exposed info in json format.
@@ -144,7 +342,16 @@ func main() {
 		docs.SwaggerInfo.Schemes = []string{"https"}
 	}
 
-	fmt.Println("freelancer folder=", settings.Env.FreelancerFolder, settings.Env)
+	var action string
+	argsWithoutProg := os.Args[1:]
+	if len(argsWithoutProg) >= 1 {
+		action = argsWithoutProg[0]
+	}
+	if cli_actions[Action(action)] {
+		logsToStderr()
+	}
+
+	fmt.Fprintln(os.Stderr, "freelancer folder=", settings.Env.FreelancerFolder, settings.Env)
 	defer func() {
 		if r := recover(); r != nil {
 			logus.Log.Error("Program crashed. Sleeping 10 seconds before exit", typelog.Any("recover", r))
@@ -156,17 +363,15 @@ func main() {
 		}
 	}()
 
-	var action string
-	argsWithoutProg := os.Args[1:]
-	if len(argsWithoutProg) == 1 {
-		action = argsWithoutProg[0]
-	}
-	fmt.Println("act:", action)
+	fmt.Fprintln(os.Stderr, "act:", action)
 
 	web_darkstat := func() func() {
 		app_data := appdata.NewAppData()
 		relay_data := appdata.NewRelayData(app_data)
-		app_data.Configs.Mapped.Clean()
+		if settings.Env.WatchSecs == 0 {
+			// reloaded configs reuse unchanged parts of current ones, so they are kept
+			app_data.Configs.Mapped.Clean()
+		}
 
 		stat_router := router.NewRouter(app_data)
 		stat_builder := stat_router.Link()
@@ -178,12 +383,27 @@ func main() {
 		app_data.Unlock()
 		runtime.GC()
 
+		// data is rebuilt in background, and servers switch to it only once it is ready
+		reloader := appdata.NewReloader(app_data, func(fresh *appdata.AppData) func() {
+			if settings.Env.WatchSecs == 0 {
+				fresh.Configs.Mapped.Clean()
+			}
+			fresh_fs := router.NewRouter(fresh).Link().BuildAll(true, nil)
+			fresh_relay_fs := GetRelayFs(appdata.NewRelayData(fresh))
+			return func() {
+				relay_data.Swap(app_data)
+				stat_fs.Files = fresh_fs.Files
+				relay_fs.Files = fresh_relay_fs.Files
+			}
+		})
+
 		web_server := darkhttp.RegisterApiRoutes(web.NewWeb(
 			[]*builder.Filesystem{stat_fs, relay_fs},
 			web.WithMutexableData(app_data),
 			web.WithSiteRoot(settings.Env.SiteRoot),
 			web.WithAppData(app_data),
-		), app_data)
+			web.WithTokenAuthPaths(darkhttp.AdminReloadRoute),
+		), app_data, darkhttp.WithReloader(reloader))
 		web_closer := web_server.Serve(web.WebServeOpts{SockAddress: web.DarkstatHttpSock})
 
 		if app_data.Configs.IsDiscovery {
@@ -196,18 +416,23 @@ func main() {
 							}
 						}()
 						time.Sleep(time.Second * time.Duration(settings.Env.RelayLoopSecs))
+
+						// served data is not changed in place, so new one is built aside without holding the lock
+						app_data.RLock()
+						current := *relay_data
+						app_data.RUnlock()
+						fresh := current.WithRefreshedPoBs()
+						fresh_fs := GetRelayFs(fresh)
+
 						app_data.Lock()
-						defer app_data.Unlock()
-
-						// TODO minimize usage of data here.
-						relay_data.Configs.Mapped.Discovery.PlayerOwnedBases.Refresh()
-						relay_data.Configs.PoBs = relay_data.Configs.GetPoBs()
-						relay_data.Configs.PoBGoods = relay_data.Configs.GetPoBGoods(app_data.Configs.PoBs)
-						relay_fs2 := GetRelayFs(relay_data)
-						for key, _ := range relay_fs.Files {
-							delete(relay_fs.Files, key)
+						if relay_data.Configs != current.Configs {
+							app_data.Unlock()
+							logus.Log.Info("app data was reloaded meanwhile, dropping refreshed PoBs")
+							return
 						}
-						relay_fs.Files = relay_fs2.Files
+						app_data.SwapRelay(relay_data, fresh)
+						relay_fs.Files = fresh_fs.Files
+						app_data.Unlock()
 						logus.Log.Info("refreshed content")
 						runtime.GC()
 					}()
@@ -215,6 +440,23 @@ func main() {
 			}()
 		}
 
+		if settings.Env.WatchSecs > 0 {
+			folders := append([]utils_types.FilePath{settings.Env.FreelancerFolder}, settings.Env.FreelancerLayers...)
+			watcher := filefind.NewWatcher(time.Second*time.Duration(settings.Env.WatchSecs), folders...)
+			go watcher.Run(context.Background(), func(changed []utils_types.FilePath) {
+				logus.Log.CheckError(reloader.ReloadChanged(changed...), "failed to reload changed configs")
+			})
+		}
+
+		reload_signals := make(chan os.Signal, 1)
+		signal.Notify(reload_signals, syscall.SIGHUP)
+		go func() {
+			for range reload_signals {
+				logus.Log.Info("received SIGHUP, reloading app data")
+				logus.Log.CheckWarn(reloader.StartReloadAll(), "failed to start reload")
+			}
+		}()
+
 		relay_server := web.NewWeb(
 			[]*builder.Filesystem{relay_fs},
 			web.WithMutexableData(app_data),
@@ -266,6 +508,18 @@ func main() {
 		fmt.Println("service is healthy")
 	case Configs:
 		main_configs()
+	case Hashes:
+		main_hashes(argsWithoutProg[1:])
+	case Diff:
+		main_diff(argsWithoutProg[1:])
+	case Lint:
+		main_lint(argsWithoutProg[1:])
+	case Patch:
+		main_patch(argsWithoutProg[1:])
+	case Schema:
+		main_schema(argsWithoutProg[1:])
+	case InfocardsDLL:
+		main_infocards_dll(argsWithoutProg[1:])
 	default:
 
 		closer := web_darkstat()
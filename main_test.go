@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/exe_mapped"
+	"github.com/darklab8/fl-darkstat/configs/configs_mapped/freelancer_mapped/infocard_mapped/infocard"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfocardsDLL(t *testing.T) {
+	folder := t.TempDir()
+	src := filepath.Join(folder, "infocards.txt")
+	out := filepath.Join(folder, "mod_infocards.dll")
+	err := os.WriteFile(src, []byte("131073\nNAME\nPlanet Manhattan\n\n"+
+		"131074\nINFOCARD\n<RDL><PUSH/><TEXT>Manhattan</TEXT><PARA/><POP/></RDL>\n\n"), 0644)
+	assert.Nil(t, err)
+
+	main_infocards_dll([]string{src, out})
+
+	data, err := os.ReadFile(out)
+	assert.Nil(t, err)
+	read_back := infocard.NewConfig()
+	exe_mapped.ParseDLL(data, read_back, 2*exe_mapped.DLLIdsPerFile)
+	assert.Equal(t, map[int]infocard.Infoname{131073: "Planet Manhattan"}, read_back.Infonames)
+	if assert.Contains(t, read_back.Infocards, 131074) {
+		lines, err := read_back.Infocards[131074].XmlToText()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"Manhattan", ""}, lines)
+	}
+}